@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/chris910512/travel-chat/internal/config"
+	"github.com/chris910512/travel-chat/internal/infrastructure/database"
+)
+
+const usage = `Usage: go run ./cmd/migrate <command>
+
+Commands:
+  up          적용되지 않은 마이그레이션 모두 적용
+  down [n]    최근 마이그레이션 n개 되돌리기 (기본값: 1)
+  status      마이그레이션 적용 상태 출력
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+	}
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 데이터베이스 설정 로드 (환경변수 > .env > CONFIG_FILE YAML > 기본값)
+	dbConfig, err := config.LoadDatabase()
+	if err != nil {
+		log.Fatal("Invalid database configuration: ", err)
+	}
+
+	// 데이터베이스 연결 (DB_DRIVER: postgres | sqlite)
+	db, err := database.NewDB(dbConfig)
+	if err != nil {
+		log.Fatal("Failed to connect to database:", err)
+	}
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		log.Fatal("Failed to load migrations:", err)
+	}
+
+	switch flag.Arg(0) {
+	case "up":
+		applied, err := migrator.Up()
+		if err != nil {
+			log.Fatalf("Migration failed after %d applied: %v", applied, err)
+		}
+		log.Printf("Applied %d migration(s)", applied)
+
+	case "down":
+		steps := 1
+		if flag.NArg() > 1 {
+			steps, err = strconv.Atoi(flag.Arg(1))
+			if err != nil || steps < 1 {
+				log.Fatalf("Invalid step count: %s", flag.Arg(1))
+			}
+		}
+		rolledBack, err := migrator.Down(steps)
+		if err != nil {
+			log.Fatalf("Rollback failed after %d rolled back: %v", rolledBack, err)
+		}
+		log.Printf("Rolled back %d migration(s)", rolledBack)
+
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatal("Failed to read migration status:", err)
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.AppliedAt != nil {
+				state = "applied " + s.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-40s %s\n", s.Version, s.Name, state)
+		}
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
@@ -1,83 +1,211 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
+	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
 
+	"github.com/chris910512/travel-chat/internal/config"
+	"github.com/chris910512/travel-chat/internal/delivery/chathub"
 	"github.com/chris910512/travel-chat/internal/delivery/grpc/server"
 	"github.com/chris910512/travel-chat/internal/delivery/http/handler"
 	"github.com/chris910512/travel-chat/internal/delivery/http/router"
+	"github.com/chris910512/travel-chat/internal/delivery/websocket"
 	"github.com/chris910512/travel-chat/internal/infrastructure/database"
 	"github.com/chris910512/travel-chat/internal/infrastructure/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/authz"
+	"github.com/chris910512/travel-chat/internal/pkg/imaging"
 	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/mailer"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/moderation"
+	"github.com/chris910512/travel-chat/internal/pkg/presence"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"github.com/chris910512/travel-chat/internal/pkg/storage"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
 	"github.com/chris910512/travel-chat/internal/usecase"
-	"github.com/joho/godotenv"
+	"github.com/chris910512/travel-chat/internal/worker"
 )
 
 func main() {
-	// 환경변수 로드
-	if err := godotenv.Load(); err != nil {
-		log.Println("No .env file found")
+	// 설정 로드 (환경변수 > .env > CONFIG_FILE YAML > 기본값, 잘못된 값이 있으면 종료)
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Invalid configuration:\n%v", err)
+	}
+
+	// 구조화 로그 (LOG_LEVEL, LOG_FORMAT) - 표준 log 패키지 출력도 같은 핸들러로 전달됨
+	appLogger, err := logger.New(os.Stdout, cfg.Log)
+	if err != nil {
+		log.Fatalf("Invalid log configuration: %v", err)
+	}
+	slog.SetDefault(appLogger)
+
+	// 분산 추적 (TRACING_EXPORTER: none | stdout | file, W3C traceparent 전파)
+	shutdownTracing, err := tracing.Setup(cfg.Tracing)
+	if err != nil {
+		fatal("failed to set up tracing", err)
 	}
 
-	// 데이터베이스 연결
-	db, err := database.NewPostgresDB()
+	// 데이터베이스 연결 (DB_DRIVER: postgres | sqlite)
+	db, err := database.NewDB(cfg.Database)
 	if err != nil {
-		log.Fatal("Failed to connect to database:", err)
+		fatal("failed to connect to database", err)
 	}
 
-	// 마이그레이션 실행
-	if err := database.RunMigrations(db); err != nil {
-		log.Fatal("Failed to migrate database:", err)
+	// 스키마 버전 확인 (마이그레이션은 go run ./cmd/migrate up 으로 적용)
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		fatal("failed to load migrations", err)
+	}
+	if err := migrator.CheckCurrent(); err != nil {
+		fatal("database schema check failed (run `go run ./cmd/migrate up`)", err)
 	}
 
 	// JWT 서비스 초기화
-	jwtSecret := os.Getenv("JWT_SECRET_KEY")
-	if jwtSecret == "" {
-		log.Fatal("JWT_SECRET_KEY environment variable is required")
+	jwtService := jwt.NewJWTService(cfg.JWT.SecretKey, cfg.JWT.Issuer, cfg.JWT.AccessTokenTTL, cfg.JWT.RefreshTokenTTL)
+
+	// 역할 기반 권한 검사 (ADMIN_USER_IDS의 사용자는 저장된 역할과 관계없이 관리자)
+	authorizer := authz.NewAuthorizer(cfg.Auth.AdminUserIDs)
+
+	// 메일 발송 (MAIL_DRIVER: console | file, 비밀번호 재설정 등)
+	mailSender, err := mailer.New(cfg.Mail)
+	if err != nil {
+		fatal("failed to set up mailer", err)
 	}
 
-	jwtIssuer := os.Getenv("JWT_ISSUER")
-	if jwtIssuer == "" {
-		jwtIssuer = "travel-chat-api"
+	// 메시지 전송 전 검사 (길이, 링크, 전체 채팅방 연락처, 욕설)
+	moderator, err := moderation.New(cfg.Moderation)
+	if err != nil {
+		fatal("failed to set up message moderation", err)
+	}
+
+	// 업로드 파일 저장소 (STORAGE_DRIVER: local | s3)
+	blobStore, err := storage.New(cfg.Storage)
+	if err != nil {
+		fatal("failed to set up blob storage", err)
 	}
 
-	jwtService := jwt.NewJWTService(jwtSecret, jwtIssuer)
+	// 요청 수 제한 (서버 한 대 기준 메모리 버킷)
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), cfg.RateLimit)
 
 	// 의존성 주입 (Dependency Injection)
 	// Repository 계층
 	userRepo := repository.NewUserRepository(db)
+	chatRoomRepo := repository.NewChatRoomRepository(db)
+	messageRepo := repository.NewMessageRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	loginHistoryRepo := repository.NewLoginHistoryRepository(db)
+	passwordResetRepo := repository.NewPasswordResetTokenRepository(db)
+	verificationRepo := repository.NewEmailVerificationTokenRepository(db)
+	blockRepo := repository.NewUserBlockRepository(db)
+	reportRepo := repository.NewUserReportRepository(db)
+	blobRepo := repository.NewBlobRepository(db)
+
+	// 채팅방별 Hub 관리자 (WebSocket/gRPC 스트림으로 메시지 실시간 전달)
+	hubManager := chathub.NewManager()
+
+	// 접속 상태 Registry (상태 전환 기준)
+	presenceRegistry := presence.NewRegistry(presence.Config{
+		AwayAfter:    cfg.Presence.AwayAfter,
+		OfflineAfter: cfg.Presence.OfflineAfter,
+	})
 
 	// Usecase 계층 (JWT 서비스 주입)
-	userUsecase := usecase.NewUserUsecase(userRepo, jwtService)
+	userUsecase := usecase.NewUserUsecase(userRepo, refreshTokenRepo, loginHistoryRepo, passwordResetRepo, verificationRepo, blockRepo, jwtService, authorizer, mailSender, usecase.UserConfig{
+		LockoutThreshold:   cfg.Auth.LockoutThreshold,
+		LockoutDuration:    cfg.Auth.LockoutDuration,
+		MaxLockoutDuration: cfg.Auth.MaxLockoutDuration,
+		PasswordResetTTL:   cfg.Auth.PasswordResetTTL,
+		PasswordResetURL:   cfg.Auth.PasswordResetURL,
+
+		EmailVerificationTTL: cfg.Auth.EmailVerificationTTL,
+		EmailVerificationURL: cfg.Auth.EmailVerificationURL,
+	})
+	chatUsecase := usecase.NewChatUsecase(userRepo, chatRoomRepo, messageRepo, blockRepo, blobRepo, moderator, hubManager, usecase.ChatConfig{
+		PublicMessageRetention:  cfg.Message.PublicRetention,
+		PrivateMessageRetention: cfg.Message.PrivateRetention,
+	})
+	matchUsecase := usecase.NewMatchUsecase(userRepo, blockRepo)
+	presenceUsecase := usecase.NewPresenceUsecase(presenceRegistry, userRepo, chatRoomRepo, hubManager)
+	adminUsecase := usecase.NewAdminUsecase(userRepo, refreshTokenRepo, chatRoomRepo, messageRepo, reportRepo, authorizer, hubManager, hubManager)
+	safetyUsecase := usecase.NewSafetyUsecase(userRepo, messageRepo, blockRepo, reportRepo, hubManager)
+	mediaUsecase := usecase.NewMediaUsecase(userRepo, blobRepo, blobStore, imaging.NewProcessor(cfg.Image.Config), usecase.MediaConfig{
+		UploadTTL: cfg.Image.UploadTTL,
+	})
 
 	// HTTP Handler 계층
 	userHandler := handler.NewUserHandler(userUsecase)
+	chatHandler := handler.NewChatHandler(chatUsecase)
+	matchHandler := handler.NewMatchHandler(matchUsecase)
+	presenceHandler := handler.NewPresenceHandler(presenceUsecase)
+	adminHandler := handler.NewAdminHandler(adminUsecase)
+	safetyHandler := handler.NewSafetyHandler(safetyUsecase)
+	mediaHandler := handler.NewMediaHandler(mediaUsecase, cfg.Image.MaxBytes)
 
-	// 포트 설정
-	httpPort := os.Getenv("SERVER_PORT")
-	if httpPort == "" {
-		httpPort = "8080"
-	}
-
-	grpcPort := os.Getenv("GRPC_PORT")
-	if grpcPort == "" {
-		grpcPort = "9090"
-	}
+	// WebSocket Handler 계층
+	chatWSHandler := websocket.NewChatHandler(hubManager, chatUsecase, userUsecase, presenceUsecase, jwtService, limiter)
 
-	gatewayPort := os.Getenv("GATEWAY_PORT")
-	if gatewayPort == "" {
-		gatewayPort = "8081"
-	}
+	// 포트 설정
+	httpPort := cfg.Server.HTTPPort
+	grpcPort := cfg.Server.GRPCPort
+	gatewayPort := cfg.Server.GatewayPort
 
 	// HTTP 라우터 설정
-	httpRouter := router.SetupRoutes(userHandler, jwtService)
+	httpRouter := router.SetupRoutes(userHandler, chatHandler, matchHandler, presenceHandler, adminHandler, safetyHandler, mediaHandler, chatWSHandler, jwtService, limiter, cfg.Server.TrustedProxies)
 
 	// gRPC 서버 설정
-	grpcServer := server.NewGRPCServer(userUsecase, jwtService, grpcPort, gatewayPort)
+	grpcServer := server.NewGRPCServer(userUsecase, chatUsecase, matchUsecase, presenceUsecase, adminUsecase, safetyUsecase, mediaUsecase, hubManager, jwtService, limiter, grpcPort, gatewayPort)
+
+	// 만료 메시지 정리 작업 설정
+	janitorConfig := worker.DefaultMessageJanitorConfig()
+	janitorConfig.Interval = cfg.Message.CleanupInterval
+	janitorConfig.BatchSize = cfg.Message.CleanupBatchSize
+
+	// 만료 파일 정리 작업 설정 (메시지 정리와 같은 주기)
+	blobJanitorConfig := worker.DefaultBlobJanitorConfig()
+	blobJanitorConfig.Interval = cfg.Message.CleanupInterval
+
+	// 접속 상태 정리 작업 설정
+	sweeperConfig := worker.PresenceSweeperConfig{Interval: cfg.Presence.FlushInterval}
+
+	// 백그라운드 작업 시작 (종료 시 ctx 취소)
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	messageJanitor := worker.NewMessageJanitor(messageRepo, janitorConfig)
+	messageJanitor.Start(workerCtx)
+	blobJanitor := worker.NewBlobJanitor(mediaUsecase, blobJanitorConfig)
+	blobJanitor.Start(workerCtx)
+	presenceSweeper := worker.NewPresenceSweeper(presenceUsecase, sweeperConfig)
+	presenceSweeper.Start(workerCtx)
+
+	httpServer := &http.Server{
+		Addr:    ":" + httpPort,
+		Handler: httpRouter,
+	}
+
+	// Prometheus 지표 서버 (API와 다른 내부 주소에서만 제공)
+	var metricsServer *http.Server
+	if cfg.Server.MetricsAddr != config.MetricsOff {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", metrics.Handler())
+		metricsServer = &http.Server{
+			Addr:    cfg.Server.MetricsAddr,
+			Handler: metricsMux,
+		}
+		go func() {
+			log.Printf("Metrics server starting on %s", cfg.Server.MetricsAddr)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("metrics server error", "error", err)
+			}
+		}()
+	}
 
 	// 서버들을 고루틴으로 동시 실행
 	var wg sync.WaitGroup
@@ -87,8 +215,8 @@ func main() {
 	go func() {
 		defer wg.Done()
 		log.Printf("HTTP server starting on port %s", httpPort)
-		if err := httpRouter.Run(":" + httpPort); err != nil {
-			log.Printf("HTTP server error: %v", err)
+		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("HTTP server error", "error", err)
 		}
 	}()
 
@@ -96,7 +224,7 @@ func main() {
 	go func() {
 		defer wg.Done()
 		if err := grpcServer.StartGRPCServer(); err != nil {
-			log.Printf("gRPC server error: %v", err)
+			slog.Error("gRPC server error", "error", err)
 		}
 	}()
 
@@ -104,7 +232,7 @@ func main() {
 	go func() {
 		defer wg.Done()
 		if err := grpcServer.StartGatewayServer(); err != nil {
-			log.Printf("gRPC Gateway server error: %v", err)
+			slog.Error("gRPC Gateway server error", "error", err)
 		}
 	}()
 
@@ -114,6 +242,7 @@ func main() {
 
 	log.Println("=== Travel Chat API Server Started ===")
 	log.Printf("HTTP Server: http://localhost:%s", httpPort)
+	log.Printf("WebSocket Chat: ws://localhost:%s/api/ws", httpPort)
 	log.Printf("gRPC Server: localhost:%s", grpcPort)
 	log.Printf("gRPC Gateway: http://localhost:%s", gatewayPort)
 	log.Println("Press Ctrl+C to exit")
@@ -122,8 +251,53 @@ func main() {
 	<-c
 	log.Println("Shutting down servers...")
 
+	// HTTP 서버 정지 (진행 중인 요청은 최대 SHUTDOWN_TIMEOUT 동안 대기)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("HTTP server shutdown error", "error", err)
+	}
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(shutdownCtx); err != nil {
+			slog.Error("metrics server shutdown error", "error", err)
+		}
+	}
+
+	// 채팅 Hub 정지
+	hubManager.Shutdown()
+
+	// 백그라운드 작업 정지
+	stopWorkers()
+waitWorkers:
+	for _, done := range []<-chan struct{}{messageJanitor.Done(), blobJanitor.Done(), presenceSweeper.Done()} {
+		select {
+		case <-done:
+		case <-shutdownCtx.Done():
+			slog.Warn("timed out waiting for background workers")
+			break waitWorkers
+		}
+	}
+
 	// gRPC 서버 정지
 	grpcServer.Stop()
 
+	// 남은 추적 스팬 내보내기
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancelFlush()
+	if err := shutdownTracing(flushCtx); err != nil {
+		slog.Error("tracing shutdown error", "error", err)
+	}
+
+	// 메일 파일 닫기
+	if err := mailSender.Close(); err != nil {
+		slog.Error("mailer close error", "error", err)
+	}
+
 	log.Println("Servers stopped")
 }
+
+// fatal - 시작 단계 오류를 error 레벨로 남기고 종료
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
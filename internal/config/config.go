@@ -0,0 +1,327 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/infrastructure/database"
+	"github.com/chris910512/travel-chat/internal/pkg/imaging"
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/mailer"
+	"github.com/chris910512/travel-chat/internal/pkg/moderation"
+	"github.com/chris910512/travel-chat/internal/pkg/presence"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"github.com/chris910512/travel-chat/internal/pkg/storage"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"github.com/chris910512/travel-chat/internal/usecase"
+	"github.com/chris910512/travel-chat/internal/worker"
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// 설정 파일 위치
+const (
+	DotEnvFile    = ".env"
+	ConfigFileEnv = "CONFIG_FILE" // YAML 설정 파일 경로 (선택)
+	MetricsOff    = "off"         // METRICS_ADDR에 지정하면 지표 서버를 띄우지 않음
+)
+
+// Config - 서버 전체 설정
+//
+// 우선순위: 환경변수 > .env > YAML 설정 파일(CONFIG_FILE) > 기본값
+type Config struct {
+	Server     ServerConfig      `yaml:"server"`
+	Database   database.Config   `yaml:"database"`
+	JWT        JWTConfig         `yaml:"jwt"`
+	Auth       AuthConfig        `yaml:"auth"`
+	Message    MessageConfig     `yaml:"message"`
+	Moderation moderation.Config `yaml:"moderation"`
+	Presence   PresenceConfig    `yaml:"presence"`
+	Log        logger.Config     `yaml:"log"`
+	Tracing    tracing.Config    `yaml:"tracing"`
+	RateLimit  ratelimit.Config  `yaml:"rate_limit"`
+	Mail       mailer.Config     `yaml:"mail"`
+	Storage    storage.Config    `yaml:"storage"`
+	Image      ImageConfig       `yaml:"image"`
+}
+
+// ServerConfig - 포트 및 종료 설정
+type ServerConfig struct {
+	HTTPPort        string        `yaml:"http_port"`
+	GRPCPort        string        `yaml:"grpc_port"`
+	GatewayPort     string        `yaml:"gateway_port"`
+	MetricsAddr     string        `yaml:"metrics_addr"`     // Prometheus 지표 전용 내부 주소 (API 포트와 분리, "off"면 끄기)
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"` // 진행 중인 요청 대기 시간
+	TrustedProxies  []string      `yaml:"trusted_proxies"`  // X-Forwarded-For를 믿을 프록시 IP/CIDR (비우면 접속 IP 사용)
+}
+
+// JWTConfig - 토큰 발급 설정
+type JWTConfig struct {
+	SecretKey       string        `yaml:"secret_key"`
+	Issuer          string        `yaml:"issuer"`
+	AccessTokenTTL  time.Duration `yaml:"access_token_ttl"`
+	RefreshTokenTTL time.Duration `yaml:"refresh_token_ttl"`
+}
+
+// AuthConfig - 권한 설정
+type AuthConfig struct {
+	AdminUserIDs       []uint        `yaml:"admin_user_ids"`       // 저장된 역할과 무관하게 항상 admin 역할인 사용자
+	LockoutThreshold   int           `yaml:"lockout_threshold"`    // 잠금이 시작되는 연속 로그인 실패 횟수
+	LockoutDuration    time.Duration `yaml:"lockout_duration"`     // 첫 잠금 시간 (실패가 이어지면 두 배씩)
+	MaxLockoutDuration time.Duration `yaml:"max_lockout_duration"` // 최대 잠금 시간
+	PasswordResetTTL   time.Duration `yaml:"password_reset_ttl"`   // 비밀번호 재설정 링크 유효 시간
+	PasswordResetURL   string        `yaml:"password_reset_url"`   // 재설정 메일 링크 (?token=... 이 붙음)
+
+	EmailVerificationTTL time.Duration `yaml:"email_verification_ttl"` // 이메일 인증 링크 유효 시간
+	EmailVerificationURL string        `yaml:"email_verification_url"` // 인증 메일 링크 (?token=... 이 붙음)
+}
+
+// MessageConfig - 메시지 보관 및 정리 작업 설정
+type MessageConfig struct {
+	PublicRetention  time.Duration `yaml:"public_retention"`  // 전체 채팅 메시지 보관 기간
+	PrivateRetention time.Duration `yaml:"private_retention"` // 1:1 채팅 메시지 보관 기간
+	CleanupInterval  time.Duration `yaml:"cleanup_interval"`
+	CleanupBatchSize int           `yaml:"cleanup_batch_size"`
+}
+
+// ImageConfig - 이미지 업로드 설정
+type ImageConfig struct {
+	imaging.Config `yaml:",inline"`
+	UploadTTL      time.Duration `yaml:"upload_ttl"` // 메시지에 첨부하지 않은 업로드의 보관 기간
+}
+
+// PresenceConfig - 접속 상태 설정
+type PresenceConfig struct {
+	AwayAfter     time.Duration `yaml:"away_after"`
+	OfflineAfter  time.Duration `yaml:"offline_after"`
+	FlushInterval time.Duration `yaml:"flush_interval"`
+}
+
+// Default - 기본 설정 (JWT 비밀키와 DB 접속 정보는 기본값 없음)
+func Default() *Config {
+	chatConfig := usecase.DefaultChatConfig()
+	userConfig := usecase.DefaultUserConfig()
+	janitorConfig := worker.DefaultMessageJanitorConfig()
+	presenceConfig := presence.DefaultConfig()
+
+	return &Config{
+		Server: ServerConfig{
+			HTTPPort:        "8080",
+			GRPCPort:        "9090",
+			GatewayPort:     "8081",
+			MetricsAddr:     "127.0.0.1:9100",
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Database: database.Config{
+			Driver:  database.DriverPostgres,
+			SSLMode: "require",
+		},
+		JWT: JWTConfig{
+			Issuer:          "travel-chat-api",
+			AccessTokenTTL:  jwt.DefaultAccessTokenTTL,
+			RefreshTokenTTL: jwt.DefaultRefreshTokenTTL,
+		},
+		Auth: AuthConfig{
+			LockoutThreshold:   userConfig.LockoutThreshold,
+			LockoutDuration:    userConfig.LockoutDuration,
+			MaxLockoutDuration: userConfig.MaxLockoutDuration,
+			PasswordResetTTL:   userConfig.PasswordResetTTL,
+			PasswordResetURL:   userConfig.PasswordResetURL,
+
+			EmailVerificationTTL: userConfig.EmailVerificationTTL,
+			EmailVerificationURL: userConfig.EmailVerificationURL,
+		},
+		Message: MessageConfig{
+			PublicRetention:  chatConfig.PublicMessageRetention,
+			PrivateRetention: chatConfig.PrivateMessageRetention,
+			CleanupInterval:  janitorConfig.Interval,
+			CleanupBatchSize: janitorConfig.BatchSize,
+		},
+		Moderation: moderation.DefaultConfig(),
+		Presence: PresenceConfig{
+			AwayAfter:     presenceConfig.AwayAfter,
+			OfflineAfter:  presenceConfig.OfflineAfter,
+			FlushInterval: worker.DefaultPresenceSweeperConfig().Interval,
+		},
+		Log:       logger.DefaultConfig(),
+		Tracing:   tracing.DefaultConfig(),
+		RateLimit: ratelimit.DefaultConfig(),
+		Mail:      mailer.DefaultConfig(),
+		Storage:   storage.DefaultConfig(),
+		Image: ImageConfig{
+			Config:    imaging.DefaultConfig(),
+			UploadTTL: usecase.DefaultMediaConfig().UploadTTL,
+		},
+	}
+}
+
+// Load - 기본값, YAML 설정 파일, .env, 환경변수 순으로 읽은 뒤 전체 설정 검증
+func Load() (*Config, error) {
+	cfg, err := load()
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// LoadDatabase - 데이터베이스 설정만 검증해서 반환 (마이그레이션 도구용)
+func LoadDatabase() (database.Config, error) {
+	cfg, err := load()
+	if err != nil {
+		return database.Config{}, err
+	}
+	if err := validateDatabase(cfg.Database); err != nil {
+		return database.Config{}, err
+	}
+	return cfg.Database, nil
+}
+
+func load() (*Config, error) {
+	// .env는 이미 설정된 환경변수를 덮어쓰지 않음
+	if _, err := os.Stat(DotEnvFile); err == nil {
+		if err := godotenv.Load(DotEnvFile); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", DotEnvFile, err)
+		}
+	}
+
+	cfg := Default()
+	if path := os.Getenv(ConfigFileEnv); path != "" {
+		if err := cfg.loadYAML(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadYAML - YAML 설정 파일 적용 (알 수 없는 키는 오류)
+func (c *Config) loadYAML(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open config file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil {
+		return fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate - 모든 설정 값 검증 (발견한 문제를 모두 모아서 반환)
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+
+	check(isPort(c.Server.HTTPPort), "SERVER_PORT must be a port number, got %q", c.Server.HTTPPort)
+	check(isPort(c.Server.GRPCPort), "GRPC_PORT must be a port number, got %q", c.Server.GRPCPort)
+	check(isPort(c.Server.GatewayPort), "GATEWAY_PORT must be a port number, got %q", c.Server.GatewayPort)
+	check(c.Server.MetricsAddr == MetricsOff || isHostPort(c.Server.MetricsAddr), "METRICS_ADDR must look like host:port or be off, got %q", c.Server.MetricsAddr)
+	check(c.Server.ShutdownTimeout > 0, "SHUTDOWN_TIMEOUT must be positive")
+	for _, proxy := range c.Server.TrustedProxies {
+		check(isIPOrCIDR(proxy), "TRUSTED_PROXIES must contain IP addresses or CIDRs, got %q", proxy)
+	}
+
+	if err := validateDatabase(c.Database); err != nil {
+		errs = append(errs, err)
+	}
+
+	check(c.JWT.SecretKey != "", "JWT_SECRET_KEY is required")
+	check(c.JWT.Issuer != "", "JWT_ISSUER must not be empty")
+	check(c.JWT.AccessTokenTTL > 0, "JWT_ACCESS_TOKEN_TTL must be positive")
+	check(c.JWT.RefreshTokenTTL > c.JWT.AccessTokenTTL, "JWT_REFRESH_TOKEN_TTL must be longer than JWT_ACCESS_TOKEN_TTL")
+
+	for _, id := range c.Auth.AdminUserIDs {
+		check(id != 0, "ADMIN_USER_IDS must not contain 0")
+	}
+	check(c.Auth.LockoutThreshold > 0, "AUTH_LOCKOUT_THRESHOLD must be positive")
+	check(c.Auth.LockoutDuration > 0, "AUTH_LOCKOUT_DURATION must be positive")
+	check(c.Auth.MaxLockoutDuration >= c.Auth.LockoutDuration, "AUTH_MAX_LOCKOUT_DURATION must not be shorter than AUTH_LOCKOUT_DURATION")
+	check(c.Auth.PasswordResetTTL > 0, "AUTH_PASSWORD_RESET_TTL must be positive")
+	check(isAbsoluteURL(c.Auth.PasswordResetURL), "AUTH_PASSWORD_RESET_URL must be an absolute URL, got %q", c.Auth.PasswordResetURL)
+	check(c.Auth.EmailVerificationTTL > 0, "AUTH_EMAIL_VERIFICATION_TTL must be positive")
+	check(isAbsoluteURL(c.Auth.EmailVerificationURL), "AUTH_EMAIL_VERIFICATION_URL must be an absolute URL, got %q", c.Auth.EmailVerificationURL)
+
+	check(c.Message.PublicRetention > 0, "MESSAGE_PUBLIC_RETENTION must be positive")
+	check(c.Message.PrivateRetention > 0, "MESSAGE_PRIVATE_RETENTION must be positive")
+	check(c.Message.CleanupInterval > 0, "MESSAGE_CLEANUP_INTERVAL must be positive")
+	check(c.Message.CleanupBatchSize > 0, "MESSAGE_CLEANUP_BATCH_SIZE must be positive")
+
+	if err := c.Moderation.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("moderation (MODERATION_*): %w", err))
+	}
+
+	check(c.Presence.AwayAfter > 0, "PRESENCE_AWAY_AFTER must be positive")
+	check(c.Presence.OfflineAfter > 0, "PRESENCE_OFFLINE_AFTER must be positive")
+	check(c.Presence.FlushInterval > 0, "PRESENCE_FLUSH_INTERVAL must be positive")
+
+	if err := c.Log.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("log (LOG_*): %w", err))
+	}
+	if err := c.Tracing.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("tracing (TRACING_*): %w", err))
+	}
+	if err := c.RateLimit.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("rate limit (RATE_LIMIT_*): %w", err))
+	}
+	if err := c.Mail.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("mail (MAIL_*): %w", err))
+	}
+	if err := c.Storage.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("storage (STORAGE_*): %w", err))
+	}
+	if err := c.Image.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("image (IMAGE_*): %w", err))
+	}
+	check(c.Image.UploadTTL > 0, "IMAGE_UPLOAD_TTL must be positive")
+
+	return errors.Join(errs...)
+}
+
+func validateDatabase(cfg database.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("database (DB_*): %w", err)
+	}
+	return nil
+}
+
+func isPort(value string) bool {
+	port, err := strconv.Atoi(value)
+	return err == nil && port > 0 && port <= 65535
+}
+
+func isHostPort(value string) bool {
+	_, port, err := net.SplitHostPort(value)
+	return err == nil && isPort(port)
+}
+
+func isIPOrCIDR(value string) bool {
+	if net.ParseIP(value) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(value)
+	return err == nil
+}
+
+func isAbsoluteURL(value string) bool {
+	parsed, err := url.Parse(value)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
@@ -0,0 +1,204 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// setupEnv - 저장소의 .env 영향을 받지 않도록 빈 디렉터리에서 최소 필수 값만 설정
+func setupEnv(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Chdir(dir)
+	unsetEnv(t, ConfigFileEnv)
+	t.Setenv("JWT_SECRET_KEY", "test-secret")
+	t.Setenv("DB_DRIVER", "sqlite")
+	t.Setenv("DB_DSN", ":memory:")
+	return dir
+}
+
+// unsetEnv - .env에서 지정할 수 있도록 비워두지 않고 제거 (t.Setenv가 테스트 후 복원)
+func unsetEnv(t *testing.T, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	setupEnv(t)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if cfg.Server.HTTPPort != "8080" || cfg.Server.MetricsAddr != "127.0.0.1:9100" || cfg.JWT.Issuer != "travel-chat-api" {
+		t.Errorf("unexpected defaults: %+v", cfg.Server)
+	}
+	if cfg.JWT.AccessTokenTTL != 15*time.Minute || cfg.JWT.RefreshTokenTTL != 7*24*time.Hour {
+		t.Errorf("unexpected token lifetimes: %+v", cfg.JWT)
+	}
+	if cfg.Message.PublicRetention != 6*time.Hour || cfg.Message.PrivateRetention != 24*time.Hour {
+		t.Errorf("unexpected retention: %+v", cfg.Message)
+	}
+}
+
+func TestLoadPrecedence(t *testing.T) {
+	dir := setupEnv(t)
+
+	yamlPath := filepath.Join(dir, "config.yaml")
+	writeFile(t, yamlPath, `
+server:
+  http_port: "7000"
+  grpc_port: "7001"
+jwt:
+  access_token_ttl: 5m
+auth:
+  admin_user_ids: [1, 2]
+message:
+  public_retention: 2h
+presence:
+  away_after: 1m
+image:
+  max_bytes: 1048576
+`)
+	unsetEnv(t, "GRPC_PORT")
+	writeFile(t, filepath.Join(dir, DotEnvFile), "CONFIG_FILE="+yamlPath+"\nGRPC_PORT=7101\nMESSAGE_PUBLIC_RETENTION=3h\n")
+	t.Setenv("MESSAGE_PUBLIC_RETENTION", "4h")
+	t.Setenv("DB_MAX_OPEN_CONNS", "12")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if cfg.Server.HTTPPort != "7000" {
+		t.Errorf("expected YAML http_port, got %q", cfg.Server.HTTPPort)
+	}
+	if cfg.Server.GRPCPort != "7101" {
+		t.Errorf("expected .env to override YAML grpc_port, got %q", cfg.Server.GRPCPort)
+	}
+	if cfg.Message.PublicRetention != 4*time.Hour {
+		t.Errorf("expected environment to override .env, got %v", cfg.Message.PublicRetention)
+	}
+	if cfg.JWT.AccessTokenTTL != 5*time.Minute || cfg.Presence.AwayAfter != time.Minute {
+		t.Errorf("expected YAML durations, got %v / %v", cfg.JWT.AccessTokenTTL, cfg.Presence.AwayAfter)
+	}
+	if len(cfg.Auth.AdminUserIDs) != 2 || cfg.Database.MaxOpenConns != 12 {
+		t.Errorf("unexpected admin ids / pool size: %v / %d", cfg.Auth.AdminUserIDs, cfg.Database.MaxOpenConns)
+	}
+	if cfg.Image.MaxBytes != 1<<20 || cfg.Image.MaxDimension != 2048 {
+		t.Errorf("expected YAML image limit on top of defaults, got %+v", cfg.Image)
+	}
+}
+
+func TestLoadReportsAllProblems(t *testing.T) {
+	setupEnv(t)
+	t.Setenv("JWT_SECRET_KEY", "")
+	t.Setenv("SERVER_PORT", "http")
+	t.Setenv("METRICS_ADDR", "9100")
+	t.Setenv("PRESENCE_AWAY_AFTER", "-1m")
+	t.Setenv("LOG_LEVEL", "verbose")
+	t.Setenv("AUTH_PASSWORD_RESET_URL", "/reset-password")
+	t.Setenv("MAIL_DRIVER", "smtp")
+	t.Setenv("MODERATION_CONTACT_ACTION", "hide")
+	t.Setenv("STORAGE_DRIVER", "ftp")
+	t.Setenv("IMAGE_THUMBNAIL_SIZE", "-1")
+
+	_, err := Load()
+	if err == nil {
+		t.Fatal("expected invalid configuration to fail")
+	}
+	for _, want := range []string{"JWT_SECRET_KEY", "SERVER_PORT", "METRICS_ADDR", "PRESENCE_AWAY_AFTER", "LOG_", "AUTH_PASSWORD_RESET_URL", "MAIL_", "MODERATION_", "STORAGE_", "IMAGE_"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to mention %s, got:\n%v", want, err)
+		}
+	}
+}
+
+func TestLoadRejectsMalformedValues(t *testing.T) {
+	dir := setupEnv(t)
+
+	t.Setenv("MESSAGE_CLEANUP_INTERVAL", "soon")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "MESSAGE_CLEANUP_INTERVAL") {
+		t.Errorf("expected malformed duration to be rejected, got %v", err)
+	}
+	t.Setenv("MESSAGE_CLEANUP_INTERVAL", "")
+
+	yamlPath := filepath.Join(dir, "config.yaml")
+	writeFile(t, yamlPath, "jwt:\n  secret: typo\n")
+	t.Setenv(ConfigFileEnv, yamlPath)
+	if _, err := Load(); err == nil {
+		t.Error("expected unknown YAML key to be rejected")
+	}
+}
+
+func TestLoadDatabaseIgnoresOtherSections(t *testing.T) {
+	setupEnv(t)
+	t.Setenv("JWT_SECRET_KEY", "")
+
+	dbConfig, err := LoadDatabase()
+	if err != nil {
+		t.Fatalf("LoadDatabase: %v", err)
+	}
+	if dbConfig.Driver != "sqlite" {
+		t.Errorf("expected sqlite driver, got %q", dbConfig.Driver)
+	}
+
+	t.Setenv("DB_DRIVER", "mysql")
+	if _, err := LoadDatabase(); err == nil {
+		t.Error("expected unsupported driver to be rejected")
+	}
+}
+
+func TestLoadRateLimit(t *testing.T) {
+	dir := setupEnv(t)
+
+	yamlPath := filepath.Join(dir, "config.yaml")
+	writeFile(t, yamlPath, `
+server:
+  trusted_proxies: ["10.0.0.0/8"]
+rate_limit:
+  login: 5/1m
+  message: 20/1m/5
+  upload: 10/1h
+`)
+	t.Setenv(ConfigFileEnv, yamlPath)
+	t.Setenv("RATE_LIMIT_API", "off")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if got := cfg.RateLimit.Login.String(); got != "5/1m0s" {
+		t.Errorf("expected YAML login limit, got %s", got)
+	}
+	if cfg.RateLimit.Message.Burst != 5 || cfg.RateLimit.API.Enabled() || cfg.RateLimit.Upload.String() != "10/1h0m0s" {
+		t.Errorf("unexpected limits: message=%s api=%s upload=%s", cfg.RateLimit.Message, cfg.RateLimit.API, cfg.RateLimit.Upload)
+	}
+	if len(cfg.Server.TrustedProxies) != 1 {
+		t.Errorf("expected trusted proxies from YAML, got %v", cfg.Server.TrustedProxies)
+	}
+
+	t.Setenv("RATE_LIMIT_LOGIN", "fast")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "RATE_LIMIT_LOGIN") {
+		t.Errorf("expected malformed limit to be rejected, got %v", err)
+	}
+	t.Setenv("RATE_LIMIT_LOGIN", "5/1m")
+
+	t.Setenv("TRUSTED_PROXIES", "10.0.0.1, proxy.local")
+	if _, err := Load(); err == nil || !strings.Contains(err.Error(), "TRUSTED_PROXIES") {
+		t.Errorf("expected host name proxy to be rejected, got %v", err)
+	}
+}
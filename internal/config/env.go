@@ -0,0 +1,228 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/pkg/authz"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+)
+
+// loadEnv - 설정된 환경변수로 값 덮어쓰기 (형식 오류는 모두 모아서 반환)
+func (c *Config) loadEnv() error {
+	env := &envReader{}
+
+	env.string("SERVER_PORT", &c.Server.HTTPPort)
+	env.string("GRPC_PORT", &c.Server.GRPCPort)
+	env.string("GATEWAY_PORT", &c.Server.GatewayPort)
+	env.string("METRICS_ADDR", &c.Server.MetricsAddr)
+	env.duration("SHUTDOWN_TIMEOUT", &c.Server.ShutdownTimeout)
+	env.list("TRUSTED_PROXIES", &c.Server.TrustedProxies)
+
+	env.string("DB_DRIVER", &c.Database.Driver)
+	env.string("DB_DSN", &c.Database.DSN)
+	env.string("DB_HOST", &c.Database.Host)
+	env.string("DB_PORT", &c.Database.Port)
+	env.string("DB_USER", &c.Database.User)
+	env.string("DB_PASSWORD", &c.Database.Password)
+	env.string("DB_NAME", &c.Database.Name)
+	env.string("DB_SSLMODE", &c.Database.SSLMode)
+	env.int("DB_MAX_OPEN_CONNS", &c.Database.MaxOpenConns)
+	env.int("DB_MAX_IDLE_CONNS", &c.Database.MaxIdleConns)
+	env.duration("DB_CONN_MAX_LIFETIME", &c.Database.ConnMaxLifetime)
+	env.duration("DB_CONN_MAX_IDLE_TIME", &c.Database.ConnMaxIdleTime)
+
+	env.string("JWT_SECRET_KEY", &c.JWT.SecretKey)
+	env.string("JWT_ISSUER", &c.JWT.Issuer)
+	env.duration("JWT_ACCESS_TOKEN_TTL", &c.JWT.AccessTokenTTL)
+	env.duration("JWT_REFRESH_TOKEN_TTL", &c.JWT.RefreshTokenTTL)
+
+	env.userIDs("ADMIN_USER_IDS", &c.Auth.AdminUserIDs)
+	env.int("AUTH_LOCKOUT_THRESHOLD", &c.Auth.LockoutThreshold)
+	env.duration("AUTH_LOCKOUT_DURATION", &c.Auth.LockoutDuration)
+	env.duration("AUTH_MAX_LOCKOUT_DURATION", &c.Auth.MaxLockoutDuration)
+	env.duration("AUTH_PASSWORD_RESET_TTL", &c.Auth.PasswordResetTTL)
+	env.string("AUTH_PASSWORD_RESET_URL", &c.Auth.PasswordResetURL)
+	env.duration("AUTH_EMAIL_VERIFICATION_TTL", &c.Auth.EmailVerificationTTL)
+	env.string("AUTH_EMAIL_VERIFICATION_URL", &c.Auth.EmailVerificationURL)
+
+	env.duration("MESSAGE_PUBLIC_RETENTION", &c.Message.PublicRetention)
+	env.duration("MESSAGE_PRIVATE_RETENTION", &c.Message.PrivateRetention)
+	env.duration("MESSAGE_CLEANUP_INTERVAL", &c.Message.CleanupInterval)
+	env.int("MESSAGE_CLEANUP_BATCH_SIZE", &c.Message.CleanupBatchSize)
+
+	env.int("MODERATION_MAX_LENGTH", &c.Moderation.MaxLength)
+	env.string("MODERATION_PROFANITY_ACTION", &c.Moderation.ProfanityAction)
+	env.list("MODERATION_PROFANITY_WORDS", &c.Moderation.ProfanityWords)
+	env.string("MODERATION_CONTACT_ACTION", &c.Moderation.ContactAction)
+	env.list("MODERATION_URL_ALLOWLIST", &c.Moderation.URLAllowlist)
+	env.list("MODERATION_URL_DENYLIST", &c.Moderation.URLDenylist)
+
+	env.duration("PRESENCE_AWAY_AFTER", &c.Presence.AwayAfter)
+	env.duration("PRESENCE_OFFLINE_AFTER", &c.Presence.OfflineAfter)
+	env.duration("PRESENCE_FLUSH_INTERVAL", &c.Presence.FlushInterval)
+
+	env.string("LOG_LEVEL", &c.Log.Level)
+	env.string("LOG_FORMAT", &c.Log.Format)
+
+	env.string("TRACING_EXPORTER", &c.Tracing.Exporter)
+	env.string("TRACING_FILE_PATH", &c.Tracing.FilePath)
+	env.string("TRACING_SERVICE_NAME", &c.Tracing.ServiceName)
+	env.float("TRACING_SAMPLE_RATIO", &c.Tracing.SampleRatio)
+
+	env.bool("RATE_LIMIT_ENABLED", &c.RateLimit.Enabled)
+	env.limit("RATE_LIMIT_LOGIN", &c.RateLimit.Login)
+	env.limit("RATE_LIMIT_REGISTER", &c.RateLimit.Register)
+	env.limit("RATE_LIMIT_API", &c.RateLimit.API)
+	env.limit("RATE_LIMIT_MESSAGE", &c.RateLimit.Message)
+	env.limit("RATE_LIMIT_UPLOAD", &c.RateLimit.Upload)
+
+	env.string("MAIL_DRIVER", &c.Mail.Driver)
+	env.string("MAIL_FILE_PATH", &c.Mail.FilePath)
+	env.string("MAIL_FROM", &c.Mail.From)
+
+	env.string("STORAGE_DRIVER", &c.Storage.Driver)
+	env.string("STORAGE_LOCAL_DIR", &c.Storage.LocalDir)
+	env.string("STORAGE_S3_ENDPOINT", &c.Storage.S3.Endpoint)
+	env.string("STORAGE_S3_REGION", &c.Storage.S3.Region)
+	env.string("STORAGE_S3_BUCKET", &c.Storage.S3.Bucket)
+	env.string("STORAGE_S3_ACCESS_KEY", &c.Storage.S3.AccessKey)
+	env.string("STORAGE_S3_SECRET_KEY", &c.Storage.S3.SecretKey)
+
+	env.int64("IMAGE_MAX_BYTES", &c.Image.MaxBytes)
+	env.int("IMAGE_MAX_DIMENSION", &c.Image.MaxDimension)
+	env.int("IMAGE_THUMBNAIL_SIZE", &c.Image.ThumbnailSize)
+	env.duration("IMAGE_UPLOAD_TTL", &c.Image.UploadTTL)
+
+	return errors.Join(env.errs...)
+}
+
+// envReader - 비어 있지 않은 환경변수만 파싱해서 적용
+type envReader struct {
+	errs []error
+}
+
+func (r *envReader) lookup(key string) (string, bool) {
+	value := os.Getenv(key)
+	return value, value != ""
+}
+
+func (r *envReader) fail(key, value string, err error) {
+	r.errs = append(r.errs, fmt.Errorf("invalid %s %q: %w", key, value, err))
+}
+
+func (r *envReader) string(key string, dst *string) {
+	if value, ok := r.lookup(key); ok {
+		*dst = value
+	}
+}
+
+func (r *envReader) int(key string, dst *int) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		r.fail(key, value, err)
+		return
+	}
+	*dst = parsed
+}
+
+func (r *envReader) int64(key string, dst *int64) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		r.fail(key, value, err)
+		return
+	}
+	*dst = parsed
+}
+
+func (r *envReader) bool(key string, dst *bool) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		r.fail(key, value, err)
+		return
+	}
+	*dst = parsed
+}
+
+// list - 쉼표로 구분된 값 목록
+func (r *envReader) list(key string, dst *[]string) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*dst = items
+}
+
+func (r *envReader) limit(key string, dst *ratelimit.Limit) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	parsed, err := ratelimit.ParseLimit(value)
+	if err != nil {
+		r.fail(key, value, err)
+		return
+	}
+	*dst = parsed
+}
+
+func (r *envReader) float(key string, dst *float64) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.fail(key, value, err)
+		return
+	}
+	*dst = parsed
+}
+
+func (r *envReader) duration(key string, dst *time.Duration) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		r.fail(key, value, err)
+		return
+	}
+	*dst = parsed
+}
+
+func (r *envReader) userIDs(key string, dst *[]uint) {
+	value, ok := r.lookup(key)
+	if !ok {
+		return
+	}
+	parsed, err := authz.ParseAdminIDs(value)
+	if err != nil {
+		r.fail(key, value, err)
+		return
+	}
+	*dst = parsed
+}
@@ -0,0 +1,32 @@
+package chathub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+)
+
+// ErrorMessage - 연결 수립 후 구독자에게 보여줄 에러 메시지
+func ErrorMessage(ctx context.Context, err error) string {
+	switch {
+	case usecaseErrors.IsEmptyMessage(err),
+		usecaseErrors.IsMessageRejected(err),
+		usecaseErrors.IsForbidden(err),
+		usecaseErrors.IsEmailNotVerified(err),
+		usecaseErrors.IsUserBlocked(err),
+		usecaseErrors.IsImageNotFound(err):
+		return err.Error()
+	default:
+		logger.FromContext(ctx).Error("failed to send chat message", "error", err)
+		return "메시지 전송에 실패했습니다"
+	}
+}
+
+// RateLimitedMessage - 메시지 전송 한도를 넘었을 때 구독자에게 보여줄 안내
+func RateLimitedMessage(retryAfter time.Duration) string {
+	return fmt.Sprintf("메시지를 너무 자주 보내고 있습니다. %d초 후 다시 시도해주세요", ratelimit.RetryAfterSeconds(retryAfter))
+}
@@ -0,0 +1,90 @@
+package chathub
+
+import (
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// 이벤트 타입
+const (
+	EventTypeMessage  = "message"  // 채팅 메시지
+	EventTypeJoin     = "join"     // 입장 알림
+	EventTypeLeave    = "leave"    // 퇴장 알림
+	EventTypeError    = "error"    // 에러 알림
+	EventTypePresence = "presence" // 접속 상태 변경 알림
+)
+
+// Event - 채팅방 구독자에게 전달되는 이벤트 (WebSocket에서는 JSON 그대로 전송)
+type Event struct {
+	Type        string             `json:"type"`
+	MessageID   uint               `json:"message_id,omitempty"`
+	ChatRoomID  uint               `json:"chat_room_id"`
+	UserID      uint               `json:"user_id,omitempty"`
+	UserName    string             `json:"user_name,omitempty"`
+	Content     string             `json:"content"`
+	MessageType string             `json:"message_type"`
+	Image       *dto.ImageResponse `json:"image,omitempty"`      // 이미지 메시지의 첨부 이미지
+	Moderation  string             `json:"moderation,omitempty"` // 메시지 이벤트의 검사 결과 (allowed, masked)
+	CreatedAt   time.Time          `json:"created_at"`
+	ExpiresAt   *time.Time         `json:"expires_at,omitempty"`
+	Status      string             `json:"status,omitempty"` // presence 이벤트의 접속 상태
+}
+
+// newMessageEvent - 저장된 메시지로 이벤트 생성
+func newMessageEvent(msg *dto.MessageResponse) *Event {
+	return &Event{
+		Type:        EventTypeMessage,
+		MessageID:   msg.ID,
+		ChatRoomID:  msg.ChatRoomID,
+		UserID:      msg.UserID,
+		UserName:    msg.UserName,
+		Content:     msg.Content,
+		MessageType: msg.MessageType,
+		Image:       msg.Image,
+		Moderation:  msg.Moderation,
+		CreatedAt:   msg.CreatedAt,
+		ExpiresAt:   msg.ExpiresAt,
+	}
+}
+
+// newSystemEvent - 입장/퇴장 등 시스템 이벤트 생성
+func newSystemEvent(eventType string, roomID, userID uint, userName, content string) *Event {
+	systemType := message.MessageTypeSystem
+	return &Event{
+		Type:        eventType,
+		ChatRoomID:  roomID,
+		UserID:      userID,
+		UserName:    userName,
+		Content:     content,
+		MessageType: systemType.String(),
+		CreatedAt:   time.Now(),
+	}
+}
+
+// newPresenceEvent - 접속 상태 변경 이벤트 생성
+func newPresenceEvent(roomID uint, p *dto.PresenceResponse) *Event {
+	systemType := message.MessageTypeSystem
+	return &Event{
+		Type:        EventTypePresence,
+		ChatRoomID:  roomID,
+		UserID:      p.UserID,
+		UserName:    p.UserName,
+		MessageType: systemType.String(),
+		CreatedAt:   time.Now(),
+		Status:      p.Status,
+	}
+}
+
+// NewErrorEvent - 에러 이벤트 생성
+func NewErrorEvent(roomID uint, content string) *Event {
+	systemType := message.MessageTypeSystem
+	return &Event{
+		Type:        EventTypeError,
+		ChatRoomID:  roomID,
+		Content:     content,
+		MessageType: systemType.String(),
+		CreatedAt:   time.Now(),
+	}
+}
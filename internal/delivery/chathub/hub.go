@@ -0,0 +1,132 @@
+package chathub
+
+import (
+	"log/slog"
+
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// 브로드캐스트 채널 버퍼 크기
+const broadcastBufferSize = 256
+
+// Hub - 채팅방 하나에 대한 구독자 관리자 (채팅방당 하나의 고루틴)
+type Hub struct {
+	room *dto.ChatRoomResponse
+
+	// Run 고루틴에서만 접근
+	subscribers map[*Subscriber]bool
+
+	register   chan *Subscriber
+	unregister chan *Subscriber
+	broadcast  chan *Event
+	direct     chan *directEvent
+	quit       chan struct{}
+
+	// Manager의 mutex로 보호되는 참여자 수
+	members int
+}
+
+// directEvent - 특정 구독자에게만 보내는 이벤트
+type directEvent struct {
+	subscriber *Subscriber
+	event      *Event
+}
+
+// newHub - Hub 생성자
+func newHub(room *dto.ChatRoomResponse) *Hub {
+	return &Hub{
+		room:        room,
+		subscribers: make(map[*Subscriber]bool),
+		register:    make(chan *Subscriber),
+		unregister:  make(chan *Subscriber),
+		broadcast:   make(chan *Event, broadcastBufferSize),
+		direct:      make(chan *directEvent, broadcastBufferSize),
+		quit:        make(chan struct{}),
+	}
+}
+
+// Run - 등록/해제/브로드캐스트 이벤트 처리 루프
+func (h *Hub) Run() {
+	slog.Debug("chat hub started", "room_id", h.room.ID, "room_name", h.room.Name)
+	defer slog.Debug("chat hub stopped", "room_id", h.room.ID)
+
+	for {
+		select {
+		case sub := <-h.register:
+			h.subscribers[sub] = true
+			h.fanOut(newSystemEvent(EventTypeJoin, h.room.ID, sub.userID, sub.userName,
+				sub.userName+"님이 입장했습니다"))
+
+		case sub := <-h.unregister:
+			if _, ok := h.subscribers[sub]; ok {
+				delete(h.subscribers, sub)
+				close(sub.send)
+				h.fanOut(newSystemEvent(EventTypeLeave, h.room.ID, sub.userID, sub.userName,
+					sub.userName+"님이 퇴장했습니다"))
+			}
+
+		case event := <-h.broadcast:
+			h.fanOut(event)
+
+		case d := <-h.direct:
+			if _, ok := h.subscribers[d.subscriber]; ok {
+				select {
+				case d.subscriber.send <- d.event:
+				default:
+				}
+			}
+
+		case <-h.quit:
+			for sub := range h.subscribers {
+				delete(h.subscribers, sub)
+				close(sub.send)
+			}
+			return
+		}
+	}
+}
+
+// join - 구독자 등록 (등록 전에 Hub가 종료되면 구독자 채널을 직접 닫음)
+func (h *Hub) join(sub *Subscriber) {
+	select {
+	case h.register <- sub:
+	case <-h.quit:
+		close(sub.send)
+	}
+}
+
+// leave - 구독자 해제 (Hub가 이미 종료됐으면 종료 시 채널이 닫혔으므로 무시)
+func (h *Hub) leave(sub *Subscriber) {
+	select {
+	case h.unregister <- sub:
+	case <-h.quit:
+	}
+}
+
+// notify - 특정 구독자에게만 이벤트 전송
+func (h *Hub) notify(sub *Subscriber, event *Event) {
+	select {
+	case h.direct <- &directEvent{subscriber: sub, event: event}:
+	case <-h.quit:
+	}
+}
+
+// stop - Hub 종료 (Manager에서만 호출)
+func (h *Hub) stop() {
+	close(h.quit)
+}
+
+// fanOut - 모든 구독자에게 이벤트 전송 (차단한 사용자의 이벤트는 건너뛰고, 느린 구독자는 연결 해제)
+func (h *Hub) fanOut(event *Event) {
+	for sub := range h.subscribers {
+		if sub.hides(event) {
+			continue
+		}
+		select {
+		case sub.send <- event:
+		default:
+			delete(h.subscribers, sub)
+			close(sub.send)
+		}
+	}
+}
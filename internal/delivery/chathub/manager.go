@@ -0,0 +1,196 @@
+package chathub
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// Manager - 채팅방별 Hub 생성/정리 관리 (usecase.MessagePublisher, usecase.PresencePublisher, usecase.RoomCloser, usecase.UserDisconnector, usecase.BlockPublisher 구현)
+type Manager struct {
+	mu     sync.Mutex
+	hubs   map[uint]*Hub
+	users  map[uint]map[*Subscriber]struct{} // 사용자별 구독 (차단 변경 반영용)
+	closed bool
+}
+
+// NewManager - Manager 생성자
+func NewManager() *Manager {
+	return &Manager{
+		hubs:  make(map[uint]*Hub),
+		users: make(map[uint]map[*Subscriber]struct{}),
+	}
+}
+
+// Join - 채팅방 Hub 구독 (Hub가 없으면 생성 후 실행, 서버 종료 중이면 nil 반환)
+//
+// blockedIDs는 구독자가 차단한 사용자로, 이 사용자들의 이벤트는 전달하지 않는다.
+func (m *Manager) Join(room *dto.ChatRoomResponse, userID uint, userName string, blockedIDs []uint) *Subscriber {
+	sub := m.addSubscriber(room, userID, userName, blockedIDs)
+	if sub == nil {
+		return nil
+	}
+
+	// Hub 등록은 잠금 밖에서 (느린 Hub가 다른 채팅방의 입장/퇴장을 막지 않도록)
+	sub.hub.join(sub)
+	return sub
+}
+
+// addSubscriber - 구독자를 만들어 Hub 참여자 수와 사용자별 구독에 반영
+func (m *Manager) addSubscriber(room *dto.ChatRoomResponse, userID uint, userName string, blockedIDs []uint) *Subscriber {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return nil
+	}
+
+	hub, ok := m.hubs[room.ID]
+	if !ok {
+		hub = newHub(room)
+		m.hubs[room.ID] = hub
+		go hub.Run()
+	}
+
+	sub := newSubscriber(userID, userName, blockedIDs)
+	sub.hub = hub
+	hub.members++
+
+	if m.users[userID] == nil {
+		m.users[userID] = make(map[*Subscriber]struct{})
+	}
+	m.users[userID][sub] = struct{}{}
+	return sub
+}
+
+// Leave - 구독 해제 (참여자가 없으면 Hub 종료, 이미 해제된 구독이면 무시)
+func (m *Manager) Leave(sub *Subscriber) {
+	if m.removeSubscriber(sub) {
+		sub.hub.leave(sub)
+	}
+}
+
+// removeSubscriber - 사용자별 구독과 Hub 참여자 수에서 제거 (Hub에 해제를 알려야 하면 true)
+func (m *Manager) removeSubscriber(sub *Subscriber) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if sub.left {
+		return false
+	}
+	sub.left = true
+
+	if subs, ok := m.users[sub.userID]; ok {
+		delete(subs, sub)
+		if len(subs) == 0 {
+			delete(m.users, sub.userID)
+		}
+	}
+
+	hub := sub.hub
+	if hub == nil || m.hubs[hub.room.ID] != hub {
+		// 이미 종료된 Hub
+		return false
+	}
+
+	hub.members--
+	if hub.members == 0 {
+		// 마지막 참여자면 Hub 종료로 구독도 함께 정리
+		delete(m.hubs, hub.room.ID)
+		hub.stop()
+		return false
+	}
+	return true
+}
+
+// PublishMessage - 저장된 메시지를 채팅방 구독자 전체에게 전송
+func (m *Manager) PublishMessage(roomID uint, msg *dto.MessageResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hub, ok := m.hubs[roomID]
+	if !ok {
+		// 접속 중인 사용자가 없는 채팅방
+		return
+	}
+
+	select {
+	case hub.broadcast <- newMessageEvent(msg):
+	default:
+		slog.Warn("chat hub broadcast buffer full, event dropped", "room_id", roomID)
+	}
+}
+
+// PublishPresence - 접속 상태 변경을 사용자가 속한 채팅방 구독자들에게 전송
+func (m *Manager) PublishPresence(roomIDs []uint, presence *dto.PresenceResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, roomID := range roomIDs {
+		hub, ok := m.hubs[roomID]
+		if !ok {
+			continue
+		}
+
+		select {
+		case hub.broadcast <- newPresenceEvent(roomID, presence):
+		default:
+			slog.Warn("chat hub broadcast buffer full, event dropped", "room_id", roomID)
+		}
+	}
+}
+
+// PublishBlock - 차단/해제를 차단한 사용자의 모든 구독에 반영 (이후 상대의 이벤트를 받지 않음)
+func (m *Manager) PublishBlock(blockerID, blockedID uint, blocked bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for sub := range m.users[blockerID] {
+		sub.setBlocked(blockedID, blocked)
+	}
+}
+
+// CloseRoom - 삭제된 채팅방의 Hub를 종료해 접속 중인 구독자 연결 해제
+func (m *Manager) CloseRoom(roomID uint) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hub, ok := m.hubs[roomID]
+	if !ok {
+		return
+	}
+	delete(m.hubs, roomID)
+	hub.stop()
+	slog.Info("chat hub closed", "room_id", roomID)
+}
+
+// DisconnectUser - 사용자의 모든 구독을 해제해 접속 중인 연결 종료 (정지된 사용자 등)
+func (m *Manager) DisconnectUser(userID uint) {
+	m.mu.Lock()
+	subs := make([]*Subscriber, 0, len(m.users[userID]))
+	for sub := range m.users[userID] {
+		subs = append(subs, sub)
+	}
+	m.mu.Unlock()
+
+	for _, sub := range subs {
+		m.Leave(sub)
+	}
+	if len(subs) > 0 {
+		slog.Info("chat subscriptions closed for user", "user_id", userID, "count", len(subs))
+	}
+}
+
+// Shutdown - 모든 Hub 종료 (서버 종료 시 호출)
+func (m *Manager) Shutdown() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closed = true
+	for id, hub := range m.hubs {
+		hub.stop()
+		delete(m.hubs, id)
+	}
+	slog.Info("all chat hubs stopped")
+}
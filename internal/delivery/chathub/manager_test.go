@@ -0,0 +1,255 @@
+package chathub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+const eventTimeout = time.Second
+
+// receive - 구독자의 다음 이벤트 (제한 시간 안에 오지 않으면 실패)
+func receive(t *testing.T, sub *Subscriber) *Event {
+	t.Helper()
+	select {
+	case event, ok := <-sub.Events():
+		if !ok {
+			t.Fatalf("subscriber %d channel closed unexpectedly", sub.userID)
+		}
+		return event
+	case <-time.After(eventTimeout):
+		t.Fatalf("timed out waiting for an event for subscriber %d", sub.userID)
+		return nil
+	}
+}
+
+// expectClosed - 남은 이벤트를 버리고 구독자 채널이 닫히는지 확인
+func expectClosed(t *testing.T, sub *Subscriber) {
+	t.Helper()
+	deadline := time.After(eventTimeout)
+	for {
+		select {
+		case _, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatalf("expected subscriber %d channel to be closed", sub.userID)
+		}
+	}
+}
+
+// waitFor - 조건이 참이 될 때까지 대기
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(eventTimeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestJoinLeaveFanOut(t *testing.T) {
+	m := NewManager()
+	defer m.Shutdown()
+	room := &dto.ChatRoomResponse{ID: 1, Name: "Japan-Tokyo"}
+
+	alice := m.Join(room, 1, "alice", nil)
+	if event := receive(t, alice); event.Type != EventTypeJoin || event.UserID != 1 {
+		t.Fatalf("expected alice's own join event, got %+v", event)
+	}
+
+	bob := m.Join(room, 2, "bob", nil)
+	for _, sub := range []*Subscriber{alice, bob} {
+		if event := receive(t, sub); event.Type != EventTypeJoin || event.UserID != 2 || event.ChatRoomID != room.ID {
+			t.Fatalf("expected bob's join event for subscriber %d, got %+v", sub.userID, event)
+		}
+	}
+
+	m.PublishMessage(room.ID, &dto.MessageResponse{ID: 10, ChatRoomID: room.ID, UserID: 1, Content: "hi"})
+	for _, sub := range []*Subscriber{alice, bob} {
+		if event := receive(t, sub); event.Type != EventTypeMessage || event.MessageID != 10 {
+			t.Fatalf("expected message event for subscriber %d, got %+v", sub.userID, event)
+		}
+	}
+
+	m.Leave(bob)
+	expectClosed(t, bob)
+	if event := receive(t, alice); event.Type != EventTypeLeave || event.UserID != 2 {
+		t.Fatalf("expected bob's leave event, got %+v", event)
+	}
+
+	// 마지막 참여자가 나가면 Hub도 정리
+	m.Leave(alice)
+	expectClosed(t, alice)
+	m.mu.Lock()
+	hubs, users := len(m.hubs), len(m.users)
+	m.mu.Unlock()
+	if hubs != 0 || users != 0 {
+		t.Errorf("expected empty manager after everyone left, got %d hubs and %d users", hubs, users)
+	}
+}
+
+func TestPublishDropsWhenBroadcastBufferFull(t *testing.T) {
+	m := NewManager()
+	room := &dto.ChatRoomResponse{ID: 1}
+
+	// Run 없이 등록한 Hub는 브로드캐스트를 소비하지 않음
+	hub := newHub(room)
+	m.hubs[room.ID] = hub
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < broadcastBufferSize+10; i++ {
+			m.PublishMessage(room.ID, &dto.MessageResponse{ID: uint(i + 1), ChatRoomID: room.ID})
+		}
+		m.PublishPresence([]uint{room.ID}, &dto.PresenceResponse{UserID: 1, Status: "online"})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(eventTimeout):
+		t.Fatal("publishing to a full broadcast buffer blocked")
+	}
+	if len(hub.broadcast) != broadcastBufferSize {
+		t.Errorf("expected %d buffered events, got %d", broadcastBufferSize, len(hub.broadcast))
+	}
+	if event := <-hub.broadcast; event.MessageID != 1 {
+		t.Errorf("expected the oldest event to be kept, got %+v", event)
+	}
+}
+
+func TestSlowSubscriberIsDisconnected(t *testing.T) {
+	m := NewManager()
+	defer m.Shutdown()
+	room := &dto.ChatRoomResponse{ID: 1}
+
+	slow := m.Join(room, 1, "slow", nil)
+	fast := m.Join(room, 2, "fast", nil)
+	receive(t, fast) // fast의 입장 알림
+
+	// slow는 읽지 않으므로 송신 버퍼가 가득 차면 연결 해제
+	for i := 0; i < sendBufferSize+1; i++ {
+		m.PublishMessage(room.ID, &dto.MessageResponse{ID: uint(i + 1), ChatRoomID: room.ID})
+		if event := receive(t, fast); event.MessageID != uint(i+1) {
+			t.Fatalf("expected message %d for fast subscriber, got %+v", i+1, event)
+		}
+	}
+	expectClosed(t, slow)
+}
+
+func TestCloseRoomClosesSubscribers(t *testing.T) {
+	m := NewManager()
+	defer m.Shutdown()
+	room := &dto.ChatRoomResponse{ID: 1}
+	other := &dto.ChatRoomResponse{ID: 2}
+
+	subs := []*Subscriber{m.Join(room, 1, "alice", nil), m.Join(room, 2, "bob", nil)}
+	bystander := m.Join(other, 3, "carol", nil)
+	receive(t, bystander)
+
+	m.CloseRoom(room.ID)
+	for _, sub := range subs {
+		expectClosed(t, sub)
+	}
+
+	// 종료된 Hub의 구독자가 나가도 다른 채팅방에는 영향 없음
+	for _, sub := range subs {
+		m.Leave(sub)
+	}
+	m.PublishMessage(other.ID, &dto.MessageResponse{ID: 1, ChatRoomID: other.ID})
+	if event := receive(t, bystander); event.Type != EventTypeMessage {
+		t.Errorf("expected other room to keep working, got %+v", event)
+	}
+}
+
+func TestDisconnectUser(t *testing.T) {
+	m := NewManager()
+	defer m.Shutdown()
+	tokyo := &dto.ChatRoomResponse{ID: 1}
+	osaka := &dto.ChatRoomResponse{ID: 2}
+
+	suspended := []*Subscriber{m.Join(tokyo, 1, "spammer", nil), m.Join(osaka, 1, "spammer", nil)}
+	bystander := m.Join(tokyo, 2, "alice", nil)
+	receive(t, bystander) // alice의 입장 알림
+
+	m.DisconnectUser(1)
+	for _, sub := range suspended {
+		expectClosed(t, sub)
+	}
+	if event := receive(t, bystander); event.Type != EventTypeLeave || event.UserID != 1 {
+		t.Fatalf("expected leave event for the disconnected user, got %+v", event)
+	}
+
+	// 연결 처리기가 뒤늦게 Leave해도 참여자 수는 한 번만 줄어듦
+	for _, sub := range suspended {
+		m.Leave(sub)
+	}
+	m.mu.Lock()
+	members, osakaOpen, users := m.hubs[tokyo.ID].members, m.hubs[osaka.ID] != nil, len(m.users[1])
+	m.mu.Unlock()
+	if members != 1 || osakaOpen || users != 0 {
+		t.Errorf("expected only alice to remain, got %d members, osaka hub open=%v, %d subscriptions", members, osakaOpen, users)
+	}
+
+	m.PublishMessage(tokyo.ID, &dto.MessageResponse{ID: 1, ChatRoomID: tokyo.ID, UserID: 2})
+	if event := receive(t, bystander); event.Type != EventTypeMessage {
+		t.Errorf("expected remaining subscriber to keep receiving, got %+v", event)
+	}
+}
+
+func TestShutdownRejectsJoin(t *testing.T) {
+	m := NewManager()
+	room := &dto.ChatRoomResponse{ID: 1}
+	sub := m.Join(room, 1, "alice", nil)
+
+	m.Shutdown()
+	expectClosed(t, sub)
+	if late := m.Join(room, 2, "bob", nil); late != nil {
+		t.Error("expected Join to be rejected after Shutdown")
+	}
+	m.Leave(sub)
+}
+
+func TestStuckHubDoesNotBlockOtherRooms(t *testing.T) {
+	m := NewManager()
+	defer m.Shutdown()
+	stuckRoom := &dto.ChatRoomResponse{ID: 1}
+
+	// Run 없이 등록한 Hub에는 입장 등록이 전달되지 않음
+	hub := newHub(stuckRoom)
+	m.hubs[stuckRoom.ID] = hub
+	stuck := make(chan *Subscriber, 1)
+	go func() {
+		stuck <- m.Join(stuckRoom, 1, "alice", nil)
+	}()
+	waitFor(t, func() bool {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		return hub.members == 1
+	})
+
+	joined := make(chan *Subscriber, 1)
+	go func() {
+		joined <- m.Join(&dto.ChatRoomResponse{ID: 2}, 2, "bob", nil)
+	}()
+	select {
+	case sub := <-joined:
+		receive(t, sub)
+	case <-time.After(eventTimeout):
+		t.Fatal("joining another room was blocked by a stuck hub")
+	}
+
+	// 등록 대기 중에 Hub가 종료되면 구독자 채널을 닫고 반환
+	m.CloseRoom(stuckRoom.ID)
+	select {
+	case sub := <-stuck:
+		expectClosed(t, sub)
+	case <-time.After(eventTimeout):
+		t.Fatal("Join on a closed hub never returned")
+	}
+}
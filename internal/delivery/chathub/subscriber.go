@@ -0,0 +1,82 @@
+package chathub
+
+import (
+	"sync"
+
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// 구독자별 송신 버퍼 크기
+const sendBufferSize = 64
+
+// Subscriber - 채팅방 이벤트를 받는 실시간 연결 하나 (WebSocket, gRPC 스트림 등)
+type Subscriber struct {
+	hub      *Hub
+	send     chan *Event
+	userID   uint
+	userName string
+	left     bool // Manager의 mutex로 보호되는 구독 해제 여부 (중복 Leave 방지)
+
+	// 이벤트를 받지 않을 사용자 (구독자가 차단한 사용자, Hub 고루틴과 Manager에서 접근)
+	mu      sync.RWMutex
+	blocked map[uint]struct{}
+}
+
+// newSubscriber - Subscriber 생성자
+func newSubscriber(userID uint, userName string, blockedIDs []uint) *Subscriber {
+	blocked := make(map[uint]struct{}, len(blockedIDs))
+	for _, id := range blockedIDs {
+		blocked[id] = struct{}{}
+	}
+	return &Subscriber{
+		send:     make(chan *Event, sendBufferSize),
+		userID:   userID,
+		userName: userName,
+		blocked:  blocked,
+	}
+}
+
+// Events - 수신 이벤트 채널 (Hub에서 구독을 해제하면 닫힘)
+func (s *Subscriber) Events() <-chan *Event {
+	return s.send
+}
+
+// Room - 구독 중인 채팅방
+func (s *Subscriber) Room() *dto.ChatRoomResponse {
+	return s.hub.room
+}
+
+// UserID - 구독자 사용자 ID
+func (s *Subscriber) UserID() uint {
+	return s.userID
+}
+
+// Notify - 이 구독자에게만 이벤트 전송 (에러 알림 등)
+func (s *Subscriber) Notify(event *Event) {
+	s.hub.notify(s, event)
+}
+
+// setBlocked - 사용자 차단/해제 반영
+func (s *Subscriber) setBlocked(userID uint, blocked bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if blocked {
+		s.blocked[userID] = struct{}{}
+	} else {
+		delete(s.blocked, userID)
+	}
+}
+
+// hides - 이 구독자에게 보내지 않을 이벤트인지 확인 (차단한 사용자의 메시지/입장/퇴장/접속 상태)
+func (s *Subscriber) hides(event *Event) bool {
+	if event.UserID == 0 {
+		return false
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	_, ok := s.blocked[event.UserID]
+	return ok
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	pb "github.com/chris910512/travel-chat/pkg/proto/admin"
+	userpb "github.com/chris910512/travel-chat/pkg/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type AdminGRPCHandler struct {
+	pb.UnimplementedAdminServiceServer
+	adminUsecase usecaseInterface.AdminUsecase
+	jwtService   *jwt.JWTService
+}
+
+// NewAdminGRPCHandler - Admin gRPC 핸들러 생성자 (역할 확인은 서버 인터셉터와 usecase에서 수행)
+func NewAdminGRPCHandler(adminUsecase usecaseInterface.AdminUsecase, jwtService *jwt.JWTService) *AdminGRPCHandler {
+	return &AdminGRPCHandler{
+		adminUsecase: adminUsecase,
+		jwtService:   jwtService,
+	}
+}
+
+// ListUsers - 정지된 사용자를 포함한 사용자 목록 조회
+func (h *AdminGRPCHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	actorID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	listReq := &dto.AdminListUsersRequest{
+		Page:   int(req.Page),
+		Limit:  int(req.Limit),
+		Role:   req.Role,
+		Status: req.Status,
+	}
+
+	listResp, err := h.adminUsecase.ListUsers(ctx, actorID, listReq)
+	if err != nil {
+		return nil, adminStatusError(err, "사용자 목록 조회 실패")
+	}
+
+	protoUsers := make([]*pb.AdminUser, len(listResp.Users))
+	for i := range listResp.Users {
+		protoUsers[i] = adminUserDtoToProto(&listResp.Users[i])
+	}
+
+	return &pb.ListUsersResponse{
+		Users:      protoUsers,
+		Page:       uint32(listResp.Page),
+		Limit:      uint32(listResp.Limit),
+		TotalCount: uint64(listResp.TotalCount),
+		TotalPages: uint32(listResp.TotalPages),
+	}, nil
+}
+
+// SuspendUser - 사용자 정지
+func (h *AdminGRPCHandler) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.AdminUserResponse, error) {
+	actorID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.adminUsecase.SuspendUser(ctx, actorID, uint(req.UserId))
+	if err != nil {
+		return nil, adminStatusError(err, "사용자 정지 실패")
+	}
+
+	return &pb.AdminUserResponse{
+		User:    adminUserDtoToProto(user),
+		Message: "사용자를 정지했습니다",
+	}, nil
+}
+
+// RestoreUser - 정지된 사용자 복구
+func (h *AdminGRPCHandler) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.AdminUserResponse, error) {
+	actorID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.adminUsecase.RestoreUser(ctx, actorID, uint(req.UserId))
+	if err != nil {
+		return nil, adminStatusError(err, "사용자 복구 실패")
+	}
+
+	return &pb.AdminUserResponse{
+		User:    adminUserDtoToProto(user),
+		Message: "사용자 정지를 해제했습니다",
+	}, nil
+}
+
+// UpdateUserRole - 사용자 역할 변경 (관리자만)
+func (h *AdminGRPCHandler) UpdateUserRole(ctx context.Context, req *pb.UpdateUserRoleRequest) (*pb.AdminUserResponse, error) {
+	actorID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.adminUsecase.UpdateUserRole(ctx, actorID, uint(req.UserId), &dto.UpdateRoleRequest{Role: req.Role})
+	if err != nil {
+		return nil, adminStatusError(err, "역할 변경 실패")
+	}
+
+	return &pb.AdminUserResponse{
+		User:    adminUserDtoToProto(user),
+		Message: "사용자 역할을 변경했습니다",
+	}, nil
+}
+
+// DeleteRoom - 채팅방과 메시지 삭제
+func (h *AdminGRPCHandler) DeleteRoom(ctx context.Context, req *pb.DeleteRoomRequest) (*pb.DeleteRoomResponse, error) {
+	actorID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.adminUsecase.DeleteRoom(ctx, actorID, uint(req.RoomId)); err != nil {
+		return nil, adminStatusError(err, "채팅방 삭제 실패")
+	}
+
+	return &pb.DeleteRoomResponse{
+		Message: "채팅방을 삭제했습니다",
+	}, nil
+}
+
+// PurgeMessages - 채팅방/작성자 조건으로 메시지 일괄 삭제
+func (h *AdminGRPCHandler) PurgeMessages(ctx context.Context, req *pb.PurgeMessagesRequest) (*pb.PurgeMessagesResponse, error) {
+	actorID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	purgeReq := &dto.PurgeMessagesRequest{
+		RoomID: uint(req.RoomId),
+		UserID: uint(req.UserId),
+	}
+
+	result, err := h.adminUsecase.PurgeMessages(ctx, actorID, purgeReq)
+	if err != nil {
+		return nil, adminStatusError(err, "메시지 삭제 실패")
+	}
+
+	return &pb.PurgeMessagesResponse{
+		Deleted: uint64(result.Deleted),
+		Message: "메시지를 삭제했습니다",
+	}, nil
+}
+
+// ListReports - 사용자 신고 목록 조회
+func (h *AdminGRPCHandler) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
+	actorID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	listReq := &dto.ListReportsRequest{
+		Page:       int(req.Page),
+		Limit:      int(req.Limit),
+		ReportedID: uint(req.ReportedId),
+	}
+
+	listResp, err := h.adminUsecase.ListReports(ctx, actorID, listReq)
+	if err != nil {
+		return nil, adminStatusError(err, "신고 목록 조회 실패")
+	}
+
+	protoReports := make([]*userpb.Report, len(listResp.Reports))
+	for i := range listResp.Reports {
+		protoReports[i] = reportDtoToProto(&listResp.Reports[i])
+	}
+
+	return &pb.ListReportsResponse{
+		Reports:    protoReports,
+		Page:       uint32(listResp.Page),
+		Limit:      uint32(listResp.Limit),
+		TotalCount: uint64(listResp.TotalCount),
+		TotalPages: uint32(listResp.TotalPages),
+	}, nil
+}
+
+// adminStatusError - 관리 기능 usecase 에러를 gRPC 상태 코드로 변환
+func adminStatusError(err error, action string) error {
+	code := codes.Internal
+	switch {
+	case usecaseErrors.IsUserNotFound(err), usecaseErrors.IsChatRoomNotFound(err):
+		code = codes.NotFound
+	case usecaseErrors.IsForbidden(err):
+		code = codes.PermissionDenied
+	case usecaseErrors.IsInvalidRole(err), usecaseErrors.IsInvalidUserStatus(err), usecaseErrors.IsPurgeFilterRequired(err):
+		code = codes.InvalidArgument
+	case usecaseErrors.IsUserAlreadySuspended(err), usecaseErrors.IsUserNotSuspended(err):
+		code = codes.FailedPrecondition
+	}
+	return status.Errorf(code, "%s: %v", action, err)
+}
+
+// adminUserDtoToProto - 관리자용 사용자 DTO를 Proto 메시지로 변환
+func adminUserDtoToProto(userDto *dto.AdminUserResponse) *pb.AdminUser {
+	protoUser := &pb.AdminUser{
+		User:                userDtoToProto(&userDto.UserResponse),
+		Suspended:           userDto.Suspended,
+		FailedLoginAttempts: uint32(userDto.FailedLoginAttempts),
+	}
+	if userDto.SuspendedAt != nil {
+		protoUser.SuspendedAt = timestamppb.New(*userDto.SuspendedAt)
+	}
+	if userDto.LockedUntil != nil {
+		protoUser.LockedUntil = timestamppb.New(*userDto.LockedUntil)
+	}
+	return protoUser
+}
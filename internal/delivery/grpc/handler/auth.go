@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// userIDFromContext - gRPC 메타데이터의 JWT 토큰에서 사용자 ID 추출
+func userIDFromContext(ctx context.Context, jwtService *jwt.JWTService) (uint, error) {
+	claims, err := ClaimsFromContext(ctx, jwtService)
+	if err != nil {
+		return 0, err
+	}
+
+	// 이후 같은 요청의 로그에 사용자 ID 포함
+	logger.SetUserID(ctx, claims.UserID)
+
+	return claims.UserID, nil
+}
+
+// optionalUserIDFromContext - 토큰이 있으면 사용자 ID, 없거나 유효하지 않으면 0 (공개 조회용)
+func optionalUserIDFromContext(ctx context.Context, jwtService *jwt.JWTService) uint {
+	userID, err := userIDFromContext(ctx, jwtService)
+	if err != nil {
+		return 0
+	}
+	return userID
+}
+
+// ClaimsFromContext - gRPC 메타데이터의 Bearer 액세스 토큰 검증 후 클레임 반환 (실패 시 Unauthenticated)
+func ClaimsFromContext(ctx context.Context, jwtService *jwt.JWTService) (*jwt.JWTClaims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "메타데이터가 없습니다")
+	}
+
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "Authorization 헤더가 없습니다")
+	}
+
+	authHeader := authHeaders[0]
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "Bearer 토큰이 아닙니다")
+	}
+
+	token := strings.TrimPrefix(authHeader, "Bearer ")
+	claims, err := jwtService.ValidateAccessToken(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "토큰 검증 실패: %v", err)
+	}
+
+	return claims, nil
+}
+
+// ClientIP - 호출한 클라이언트 IP
+//
+// 같은 서버의 Gateway(루프백)를 거친 호출은 Gateway가 덧붙인 x-forwarded-for의 마지막 값(실제 HTTP 접속 IP)을 사용한다.
+// 앞쪽 값은 클라이언트가 임의로 보낼 수 있으므로 신뢰하지 않는다.
+func ClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("x-forwarded-for"); len(values) > 0 {
+				forwarded := strings.Split(values[len(values)-1], ",")
+				if last := strings.TrimSpace(forwarded[len(forwarded)-1]); last != "" {
+					return last
+				}
+			}
+		}
+	}
+	return host
+}
+
+// userAgent - 호출한 클라이언트의 User-Agent (Gateway를 거친 호출은 원래 HTTP 요청의 값)
+func userAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
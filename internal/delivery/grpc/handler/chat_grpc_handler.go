@@ -0,0 +1,371 @@
+package handler
+
+import (
+	"context"
+	"io"
+
+	"github.com/chris910512/travel-chat/internal/delivery/chathub"
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	chatpb "github.com/chris910512/travel-chat/pkg/proto/chat"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ChatGRPCHandler struct {
+	chatpb.UnimplementedChatServiceServer
+	chatUsecase     usecaseInterface.ChatUsecase
+	userUsecase     usecaseInterface.UserUsecase
+	presenceUsecase usecaseInterface.PresenceUsecase
+	mediaUsecase    usecaseInterface.MediaUsecase
+	hubManager      *chathub.Manager
+	jwtService      *jwt.JWTService
+	limiter         *ratelimit.Limiter
+}
+
+func NewChatGRPCHandler(
+	chatUsecase usecaseInterface.ChatUsecase,
+	userUsecase usecaseInterface.UserUsecase,
+	presenceUsecase usecaseInterface.PresenceUsecase,
+	mediaUsecase usecaseInterface.MediaUsecase,
+	hubManager *chathub.Manager,
+	jwtService *jwt.JWTService,
+	limiter *ratelimit.Limiter,
+) *ChatGRPCHandler {
+	return &ChatGRPCHandler{
+		chatUsecase:     chatUsecase,
+		userUsecase:     userUsecase,
+		presenceUsecase: presenceUsecase,
+		mediaUsecase:    mediaUsecase,
+		hubManager:      hubManager,
+		jwtService:      jwtService,
+		limiter:         limiter,
+	}
+}
+
+// Chat - 실시간 채팅 (양방향 스트리밍)
+func (h *ChatGRPCHandler) Chat(stream chatpb.ChatService_ChatServer) error {
+	ctx := stream.Context()
+
+	userID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return err
+	}
+
+	userResp, err := h.userUsecase.GetByID(ctx, userID)
+	if err != nil {
+		return chatStatusError(err, "사용자 조회 실패")
+	}
+
+	// 첫 요청으로 접속할 채팅방 결정
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	join := first.GetJoin()
+	if join == nil {
+		return status.Error(codes.InvalidArgument, "첫 요청은 채팅방 참여(join)여야 합니다")
+	}
+
+	var room *dto.ChatRoomResponse
+	if join.RoomId == 0 {
+		room, err = h.chatUsecase.JoinPublicRoom(ctx, userResp.ID)
+	} else {
+		room, err = h.chatUsecase.GetRoom(ctx, userResp.ID, uint(join.RoomId))
+	}
+	if err != nil {
+		return chatStatusError(err, "채팅방 참여 실패")
+	}
+
+	blockedIDs, err := h.chatUsecase.GetBlockedUserIDs(ctx, userResp.ID)
+	if err != nil {
+		return chatStatusError(err, "차단 목록 조회 실패")
+	}
+
+	// 접속 상태 등록 (이메일 인증은 여기서 한 번만 확인)
+	if err := h.presenceUsecase.Connect(ctx, userResp.ID); err != nil {
+		return chatStatusError(err, "채팅방 참여 실패")
+	}
+	defer h.presenceUsecase.Disconnect(context.Background(), userResp.ID)
+
+	if err := stream.Send(&chatpb.ChatEvent{
+		Type:       chatpb.EventType_EVENT_TYPE_JOINED,
+		ChatRoomId: uint32(room.ID),
+		UserId:     uint32(userResp.ID),
+		UserName:   userResp.Name,
+		CreatedAt:  timestamppb.Now(),
+		Room:       chatRoomDtoToProto(room),
+	}); err != nil {
+		return err
+	}
+
+	sub := h.hubManager.Join(room, userResp.ID, userResp.Name, blockedIDs)
+	if sub == nil {
+		return status.Error(codes.Unavailable, "서버가 종료 중입니다")
+	}
+	defer h.hubManager.Leave(sub)
+
+	// 수신 고루틴: 클라이언트 메시지를 저장하면 Hub를 통해 다시 전달됨
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				recvErr <- nil
+				return
+			}
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			var msg *chatpb.SendMessage
+			switch payload := req.Payload.(type) {
+			case *chatpb.ChatRequest_Heartbeat:
+				h.presenceUsecase.RecordActivity(ctx, userResp.ID)
+				continue
+			case *chatpb.ChatRequest_Message:
+				h.presenceUsecase.RecordActivity(ctx, userResp.ID)
+				msg = payload.Message
+			default:
+				sub.Notify(chathub.NewErrorEvent(room.ID, "이미 채팅방에 참여했습니다"))
+				continue
+			}
+
+			// 사용자별 메시지 전송 한도 (WebSocket과 같은 정책)
+			if result := h.limiter.Allow(ctx, ratelimit.PolicyMessage, ratelimit.UserKey(userResp.ID)); !result.Allowed {
+				sub.Notify(chathub.NewErrorEvent(room.ID, chathub.RateLimitedMessage(result.RetryAfter)))
+				continue
+			}
+
+			sendReq := &dto.SendMessageRequest{Content: msg.Content, ImageID: msg.ImageId}
+			if _, err := h.chatUsecase.SendMessage(ctx, userResp.ID, room.ID, sendReq); err != nil {
+				sub.Notify(chathub.NewErrorEvent(room.ID, chathub.ErrorMessage(ctx, err)))
+			}
+		}
+	}()
+
+	// 송신 루프: stream.Send는 이 고루틴에서만 호출
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Error(codes.Unavailable, "채팅방 연결이 종료되었습니다")
+			}
+			if err := stream.Send(chatEventToProto(event)); err != nil {
+				return err
+			}
+
+		case err := <-recvErr:
+			return err
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ListMessages - 채팅방 메시지 기록 조회
+func (h *ChatGRPCHandler) ListMessages(ctx context.Context, req *chatpb.ListMessagesRequest) (*chatpb.ListMessagesResponse, error) {
+	userID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	historyReq := &dto.GetHistoryRequest{Limit: int(req.Limit)}
+	history, err := h.chatUsecase.GetHistory(ctx, userID, uint(req.RoomId), historyReq)
+	if err != nil {
+		return nil, chatStatusError(err, "메시지 기록 조회 실패")
+	}
+
+	protoMessages := make([]*chatpb.ChatMessage, len(history.Messages))
+	for i, msg := range history.Messages {
+		protoMessages[i] = messageDtoToProto(&msg)
+	}
+
+	return &chatpb.ListMessagesResponse{
+		Room:     chatRoomDtoToProto(&history.ChatRoom),
+		Messages: protoMessages,
+		Message:  "메시지 기록을 조회했습니다",
+	}, nil
+}
+
+// ListRooms - 내 채팅방 목록 조회
+func (h *ChatGRPCHandler) ListRooms(ctx context.Context, req *chatpb.ListRoomsRequest) (*chatpb.ListRoomsResponse, error) {
+	userID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := h.chatUsecase.ListRooms(ctx, userID)
+	if err != nil {
+		return nil, chatStatusError(err, "채팅방 목록 조회 실패")
+	}
+
+	protoRooms := make([]*chatpb.ChatRoom, len(rooms))
+	for i, room := range rooms {
+		protoRooms[i] = chatRoomDtoToProto(&room)
+	}
+
+	return &chatpb.ListRoomsResponse{
+		Rooms:   protoRooms,
+		Message: "채팅방 목록을 조회했습니다",
+	}, nil
+}
+
+// UploadImage - 채팅 이미지 업로드 (클라이언트 스트리밍, 받은 조각을 이어서 처리)
+func (h *ChatGRPCHandler) UploadImage(stream chatpb.ChatService_UploadImageServer) error {
+	ctx := stream.Context()
+
+	userID, err := userIDFromContext(ctx, h.jwtService)
+	if err != nil {
+		return err
+	}
+
+	image, err := h.mediaUsecase.UploadImage(ctx, userID, newChunkReader(stream.Recv))
+	if err != nil {
+		return chatStatusError(err, "이미지 업로드 실패")
+	}
+
+	return stream.SendAndClose(&chatpb.UploadImageResponse{
+		Image:   imageDtoToProto(image),
+		Message: "이미지를 업로드했습니다",
+	})
+}
+
+// Helper 함수들
+
+// chatStatusError - Usecase 에러를 gRPC 상태 코드로 변환
+func chatStatusError(err error, action string) error {
+	code := codes.Internal
+	switch {
+	case usecaseErrors.IsUserNotFound(err), usecaseErrors.IsChatRoomNotFound(err), usecaseErrors.IsImageNotFound(err):
+		code = codes.NotFound
+	case usecaseErrors.IsForbidden(err), usecaseErrors.IsEmailNotVerified(err), usecaseErrors.IsUserBlocked(err):
+		code = codes.PermissionDenied
+	case usecaseErrors.IsEmptyMessage(err),
+		usecaseErrors.IsMessageRejected(err),
+		usecaseErrors.IsDestinationNotSet(err),
+		usecaseErrors.IsImageRejected(err):
+		code = codes.InvalidArgument
+	}
+	return status.Errorf(code, "%s: %v", action, err)
+}
+
+// DTO를 Proto 메시지로 변환
+func chatRoomDtoToProto(room *dto.ChatRoomResponse) *chatpb.ChatRoom {
+	return &chatpb.ChatRoom{
+		Id:          uint32(room.ID),
+		Country:     room.Country,
+		City:        room.City,
+		Destination: room.Destination,
+		RoomType:    stringToProtoRoomType(room.RoomType),
+		Name:        room.Name,
+		CreatedAt:   timestamppb.New(room.CreatedAt),
+	}
+}
+
+func messageDtoToProto(msg *dto.MessageResponse) *chatpb.ChatMessage {
+	protoMsg := &chatpb.ChatMessage{
+		Id:          uint32(msg.ID),
+		ChatRoomId:  uint32(msg.ChatRoomID),
+		UserId:      uint32(msg.UserID),
+		UserName:    msg.UserName,
+		Content:     msg.Content,
+		MessageType: stringToProtoMessageType(msg.MessageType),
+		Moderation:  msg.Moderation,
+		CreatedAt:   timestamppb.New(msg.CreatedAt),
+	}
+	if msg.ExpiresAt != nil {
+		protoMsg.ExpiresAt = timestamppb.New(*msg.ExpiresAt)
+	}
+	if msg.Image != nil {
+		protoMsg.Image = imageDtoToProto(msg.Image)
+	}
+	return protoMsg
+}
+
+func imageDtoToProto(image *dto.ImageResponse) *chatpb.Image {
+	return &chatpb.Image{
+		Id:           image.ID,
+		Url:          image.URL,
+		ThumbnailUrl: image.ThumbnailURL,
+		ContentType:  image.ContentType,
+		Width:        uint32(image.Width),
+		Height:       uint32(image.Height),
+	}
+}
+
+// chatEventToProto - Hub 이벤트를 스트림 이벤트로 변환
+func chatEventToProto(event *chathub.Event) *chatpb.ChatEvent {
+	protoEvent := &chatpb.ChatEvent{
+		Type:       stringToProtoEventType(event.Type),
+		ChatRoomId: uint32(event.ChatRoomID),
+		UserId:     uint32(event.UserID),
+		UserName:   event.UserName,
+		Content:    event.Content,
+		CreatedAt:  timestamppb.New(event.CreatedAt),
+		Status:     event.Status,
+	}
+	if event.Type == chathub.EventTypeMessage {
+		protoEvent.Message = messageDtoToProto(&dto.MessageResponse{
+			ID:          event.MessageID,
+			ChatRoomID:  event.ChatRoomID,
+			UserID:      event.UserID,
+			UserName:    event.UserName,
+			Content:     event.Content,
+			MessageType: event.MessageType,
+			Image:       event.Image,
+			Moderation:  event.Moderation,
+			CreatedAt:   event.CreatedAt,
+			ExpiresAt:   event.ExpiresAt,
+		})
+	}
+	return protoEvent
+}
+
+// Enum 변환 함수들
+func stringToProtoRoomType(roomType string) chatpb.RoomType {
+	switch roomType {
+	case "public":
+		return chatpb.RoomType_ROOM_TYPE_PUBLIC
+	case "private":
+		return chatpb.RoomType_ROOM_TYPE_PRIVATE
+	default:
+		return chatpb.RoomType_ROOM_TYPE_UNSPECIFIED
+	}
+}
+
+func stringToProtoMessageType(messageType string) chatpb.MessageType {
+	switch messageType {
+	case "text":
+		return chatpb.MessageType_MESSAGE_TYPE_TEXT
+	case "image":
+		return chatpb.MessageType_MESSAGE_TYPE_IMAGE
+	case "system":
+		return chatpb.MessageType_MESSAGE_TYPE_SYSTEM
+	default:
+		return chatpb.MessageType_MESSAGE_TYPE_UNSPECIFIED
+	}
+}
+
+func stringToProtoEventType(eventType string) chatpb.EventType {
+	switch eventType {
+	case chathub.EventTypeMessage:
+		return chatpb.EventType_EVENT_TYPE_MESSAGE
+	case chathub.EventTypeJoin:
+		return chatpb.EventType_EVENT_TYPE_JOIN
+	case chathub.EventTypeLeave:
+		return chatpb.EventType_EVENT_TYPE_LEAVE
+	case chathub.EventTypeError:
+		return chatpb.EventType_EVENT_TYPE_ERROR
+	case chathub.EventTypePresence:
+		return chatpb.EventType_EVENT_TYPE_PRESENCE
+	default:
+		return chatpb.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,32 @@
+package handler
+
+import "io"
+
+// chunkRequest - 파일을 조각으로 나눠 보내는 클라이언트 스트리밍 요청
+type chunkRequest interface {
+	GetChunk() []byte
+}
+
+// chunkReader - 스트림으로 받은 조각들을 io.Reader로 연결 (클라이언트가 전송을 끝내면 io.EOF)
+type chunkReader[T chunkRequest] struct {
+	recv  func() (T, error)
+	chunk []byte
+}
+
+// newChunkReader - 스트림의 Recv로 chunkReader 생성
+func newChunkReader[T chunkRequest](recv func() (T, error)) io.Reader {
+	return &chunkReader[T]{recv: recv}
+}
+
+func (r *chunkReader[T]) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.chunk = req.GetChunk()
+	}
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
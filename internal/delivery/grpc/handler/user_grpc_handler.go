@@ -5,25 +5,36 @@ import (
 
 	"github.com/chris910512/travel-chat/internal/pkg/jwt"
 	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
 	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
 	pb "github.com/chris910512/travel-chat/pkg/proto/user"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
-	"strings"
 )
 
 type UserGRPCHandler struct {
 	pb.UnimplementedUserServiceServer
-	userUsecase usecaseInterface.UserUsecase
-	jwtService  *jwt.JWTService
+	userUsecase   usecaseInterface.UserUsecase
+	matchUsecase  usecaseInterface.MatchUsecase
+	safetyUsecase usecaseInterface.SafetyUsecase
+	mediaUsecase  usecaseInterface.MediaUsecase
+	jwtService    *jwt.JWTService
 }
 
-func NewUserGRPCHandler(userUsecase usecaseInterface.UserUsecase, jwtService *jwt.JWTService) *UserGRPCHandler {
+func NewUserGRPCHandler(
+	userUsecase usecaseInterface.UserUsecase,
+	matchUsecase usecaseInterface.MatchUsecase,
+	safetyUsecase usecaseInterface.SafetyUsecase,
+	mediaUsecase usecaseInterface.MediaUsecase,
+	jwtService *jwt.JWTService,
+) *UserGRPCHandler {
 	return &UserGRPCHandler{
-		userUsecase: userUsecase,
-		jwtService:  jwtService,
+		userUsecase:   userUsecase,
+		matchUsecase:  matchUsecase,
+		safetyUsecase: safetyUsecase,
+		mediaUsecase:  mediaUsecase,
+		jwtService:    jwtService,
 	}
 }
 
@@ -64,12 +75,17 @@ func (h *UserGRPCHandler) Register(ctx context.Context, req *pb.RegisterRequest)
 // Login - 사용자 로그인
 func (h *UserGRPCHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
 	loginReq := &dto.LoginRequest{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		IP:        ClientIP(ctx),
+		UserAgent: userAgent(ctx),
 	}
 
 	loginResp, err := h.userUsecase.Login(ctx, loginReq)
 	if err != nil {
+		if usecaseErrors.IsAccountLocked(err) {
+			return nil, status.Errorf(codes.PermissionDenied, "로그인 실패: %v", err)
+		}
 		return nil, status.Errorf(codes.Unauthenticated, "로그인 실패: %v", err)
 	}
 
@@ -93,7 +109,7 @@ func (h *UserGRPCHandler) RefreshToken(ctx context.Context, req *pb.RefreshToken
 
 	refreshResp, err := h.userUsecase.RefreshToken(ctx, refreshReq)
 	if err != nil {
-		return nil, status.Errorf(codes.Unauthenticated, "토큰 갱신 실패: %v", err)
+		return nil, userStatusError(err, "토큰 갱신 실패")
 	}
 
 	return &pb.RefreshTokenResponse{
@@ -105,6 +121,123 @@ func (h *UserGRPCHandler) RefreshToken(ctx context.Context, req *pb.RefreshToken
 	}, nil
 }
 
+// Logout - 현재 기기 로그아웃
+func (h *UserGRPCHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	userID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logoutReq := &dto.LogoutRequest{
+		RefreshToken: req.RefreshToken,
+	}
+
+	if err := h.userUsecase.Logout(ctx, userID, logoutReq); err != nil {
+		return nil, userStatusError(err, "로그아웃 실패")
+	}
+
+	return &pb.LogoutResponse{
+		Message: "로그아웃되었습니다",
+	}, nil
+}
+
+// LogoutAll - 모든 기기에서 로그아웃
+func (h *UserGRPCHandler) LogoutAll(ctx context.Context, req *pb.LogoutAllRequest) (*pb.LogoutAllResponse, error) {
+	userID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.userUsecase.LogoutAll(ctx, userID); err != nil {
+		return nil, userStatusError(err, "로그아웃 실패")
+	}
+
+	return &pb.LogoutAllResponse{
+		Message: "모든 기기에서 로그아웃되었습니다",
+	}, nil
+}
+
+// ForgotPassword - 비밀번호 재설정 메일 요청
+func (h *UserGRPCHandler) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*pb.ForgotPasswordResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "이메일이 필요합니다")
+	}
+
+	if err := h.userUsecase.ForgotPassword(ctx, &dto.ForgotPasswordRequest{Email: req.Email}); err != nil {
+		return nil, userStatusError(err, "비밀번호 재설정 요청 실패")
+	}
+
+	return &pb.ForgotPasswordResponse{
+		Message: "가입된 이메일이면 비밀번호 재설정 링크를 보냈습니다",
+	}, nil
+}
+
+// ResetPassword - 메일로 받은 토큰으로 비밀번호 재설정
+func (h *UserGRPCHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	resetReq := &dto.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}
+
+	if err := h.userUsecase.ResetPassword(ctx, resetReq); err != nil {
+		return nil, userStatusError(err, "비밀번호 재설정 실패")
+	}
+
+	return &pb.ResetPasswordResponse{
+		Message: "비밀번호가 재설정되었습니다. 새 비밀번호로 다시 로그인해주세요",
+	}, nil
+}
+
+// ChangePassword - 내 비밀번호 변경
+func (h *UserGRPCHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	userID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changeReq := &dto.ChangePasswordRequest{
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	}
+
+	if err := h.userUsecase.ChangePassword(ctx, userID, changeReq); err != nil {
+		return nil, userStatusError(err, "비밀번호 변경 실패")
+	}
+
+	return &pb.ChangePasswordResponse{
+		Message: "비밀번호가 변경되었습니다. 새 비밀번호로 다시 로그인해주세요",
+	}, nil
+}
+
+// VerifyEmail - 메일로 받은 토큰으로 이메일 인증
+func (h *UserGRPCHandler) VerifyEmail(ctx context.Context, req *pb.VerifyEmailRequest) (*pb.VerifyEmailResponse, error) {
+	userResp, err := h.userUsecase.VerifyEmail(ctx, &dto.VerifyEmailRequest{Token: req.Token})
+	if err != nil {
+		return nil, userStatusError(err, "이메일 인증 실패")
+	}
+
+	return &pb.VerifyEmailResponse{
+		User:    userDtoToProto(userResp),
+		Message: "이메일 인증이 완료되었습니다",
+	}, nil
+}
+
+// ResendVerificationEmail - 이메일 인증 메일 재발송
+func (h *UserGRPCHandler) ResendVerificationEmail(ctx context.Context, req *pb.ResendVerificationEmailRequest) (*pb.ResendVerificationEmailResponse, error) {
+	userID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.userUsecase.ResendVerification(ctx, userID); err != nil {
+		return nil, userStatusError(err, "인증 메일 재발송 실패")
+	}
+
+	return &pb.ResendVerificationEmailResponse{
+		Message: "인증 메일을 다시 보냈습니다",
+	}, nil
+}
+
 // GetProfile - 프로필 조회
 func (h *UserGRPCHandler) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileResponse, error) {
 	userResp, err := h.userUsecase.GetByID(ctx, uint(req.UserId))
@@ -150,7 +283,10 @@ func (h *UserGRPCHandler) GetUsers(ctx context.Context, req *pb.GetUsersRequest)
 		City:    req.City,
 	}
 
-	usersResp, err := h.userUsecase.GetUsers(ctx, getUsersReq)
+	// 로그인한 경우 차단 관계인 사용자 제외
+	viewerID := optionalUserIDFromContext(ctx, h.jwtService)
+
+	usersResp, err := h.userUsecase.GetUsers(ctx, viewerID, getUsersReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "사용자 목록 조회 실패: %v", err)
 	}
@@ -173,7 +309,9 @@ func (h *UserGRPCHandler) GetUsers(ctx context.Context, req *pb.GetUsersRequest)
 
 // GetUsersByDestination - 목적지별 사용자 조회
 func (h *UserGRPCHandler) GetUsersByDestination(ctx context.Context, req *pb.GetUsersByDestinationRequest) (*pb.GetUsersResponse, error) {
-	users, err := h.userUsecase.GetUsersByDestination(ctx, req.Country, req.City)
+	viewerID := optionalUserIDFromContext(ctx, h.jwtService)
+
+	users, err := h.userUsecase.GetUsersByDestination(ctx, viewerID, req.Country, req.City)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "목적지별 사용자 조회 실패: %v", err)
 	}
@@ -189,8 +327,88 @@ func (h *UserGRPCHandler) GetUsersByDestination(ctx context.Context, req *pb.Get
 	}, nil
 }
 
+// GetMyMatches - 내 여행 동행 추천 목록 조회
+func (h *UserGRPCHandler) GetMyMatches(ctx context.Context, req *pb.GetMyMatchesRequest) (*pb.GetMyMatchesResponse, error) {
+	userID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "인증이 필요합니다: %v", err)
+	}
+
+	matchesReq := &dto.GetMatchesRequest{
+		Page:  int(req.Page),
+		Limit: int(req.Limit),
+	}
+
+	matchesResp, err := h.matchUsecase.GetMatches(ctx, userID, matchesReq)
+	if err != nil {
+		if usecaseErrors.IsDestinationNotSet(err) {
+			return nil, status.Errorf(codes.FailedPrecondition, "동행 추천 조회 실패: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "동행 추천 조회 실패: %v", err)
+	}
+
+	protoMatches := make([]*pb.Match, len(matchesResp.Matches))
+	for i, match := range matchesResp.Matches {
+		protoMatches[i] = matchDtoToProto(&match)
+	}
+
+	return &pb.GetMyMatchesResponse{
+		Matches:    protoMatches,
+		Page:       uint32(matchesResp.Page),
+		Limit:      uint32(matchesResp.Limit),
+		TotalCount: uint64(matchesResp.TotalCount),
+		TotalPages: uint32(matchesResp.TotalPages),
+		Message:    "동행 추천 목록을 조회했습니다",
+	}, nil
+}
+
+// GetMyLogins - 내 로그인 기록 조회
+func (h *UserGRPCHandler) GetMyLogins(ctx context.Context, req *pb.GetMyLoginsRequest) (*pb.GetMyLoginsResponse, error) {
+	userID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	historyReq := &dto.GetLoginHistoryRequest{
+		Page:  int(req.Page),
+		Limit: int(req.Limit),
+	}
+
+	historyResp, err := h.userUsecase.GetLoginHistory(ctx, userID, historyReq)
+	if err != nil {
+		return nil, userStatusError(err, "로그인 기록 조회 실패")
+	}
+
+	protoLogins := make([]*pb.LoginHistory, len(historyResp.Logins))
+	for i, login := range historyResp.Logins {
+		protoLogins[i] = &pb.LoginHistory{
+			Id:            uint32(login.ID),
+			Ip:            login.IP,
+			UserAgent:     login.UserAgent,
+			Success:       login.Success,
+			FailureReason: login.FailureReason,
+			NewIp:         login.NewIP,
+			CreatedAt:     timestamppb.New(login.CreatedAt),
+		}
+	}
+
+	return &pb.GetMyLoginsResponse{
+		Logins:     protoLogins,
+		Page:       uint32(historyResp.Page),
+		Limit:      uint32(historyResp.Limit),
+		TotalCount: uint64(historyResp.TotalCount),
+		TotalPages: uint32(historyResp.TotalPages),
+		Message:    "로그인 기록을 조회했습니다",
+	}, nil
+}
+
 // UpdateProfile - 프로필 업데이트
 func (h *UserGRPCHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	currentUserID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	updateReq := &dto.UpdateUserRequest{}
 
 	// Optional 필드들 처리
@@ -205,9 +423,6 @@ func (h *UserGRPCHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfi
 		gender := protoGenderToString(*req.Gender)
 		updateReq.Gender = &gender
 	}
-	if req.ProfilePic != nil {
-		updateReq.ProfilePic = req.ProfilePic
-	}
 	if req.Country != nil {
 		updateReq.Country = req.Country
 	}
@@ -238,9 +453,9 @@ func (h *UserGRPCHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfi
 		updateReq.TravelStyle = &travelStyle
 	}
 
-	userResp, err := h.userUsecase.UpdateProfile(ctx, uint(req.UserId), updateReq)
+	userResp, err := h.userUsecase.UpdateProfile(ctx, currentUserID, uint(req.UserId), updateReq)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "프로필 업데이트 실패: %v", err)
+		return nil, userStatusError(err, "프로필 업데이트 실패")
 	}
 
 	protoUser := userDtoToProto(userResp)
@@ -253,9 +468,13 @@ func (h *UserGRPCHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfi
 
 // UpdateLastActive - 활동 시간 업데이트
 func (h *UserGRPCHandler) UpdateLastActive(ctx context.Context, req *pb.UpdateLastActiveRequest) (*pb.UpdateLastActiveResponse, error) {
-	err := h.userUsecase.UpdateLastActive(ctx, uint(req.UserId))
+	currentUserID, err := h.extractUserIDFromContext(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "활동 시간 업데이트 실패: %v", err)
+		return nil, err
+	}
+
+	if err := h.userUsecase.UpdateLastActive(ctx, currentUserID, uint(req.UserId)); err != nil {
+		return nil, userStatusError(err, "활동 시간 업데이트 실패")
 	}
 
 	return &pb.UpdateLastActiveResponse{
@@ -263,32 +482,185 @@ func (h *UserGRPCHandler) UpdateLastActive(ctx context.Context, req *pb.UpdateLa
 	}, nil
 }
 
-// Helper 함수들
+// BlockUser - 사용자 차단
+func (h *UserGRPCHandler) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	currentUserID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-// JWT 토큰에서 사용자 ID 추출
-func (h *UserGRPCHandler) extractUserIDFromContext(ctx context.Context) (uint, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return 0, status.Error(codes.Unauthenticated, "메타데이터가 없습니다")
+	if err := h.safetyUsecase.BlockUser(ctx, currentUserID, uint(req.UserId)); err != nil {
+		return nil, userStatusError(err, "사용자 차단 실패")
 	}
 
-	authHeaders := md.Get("authorization")
-	if len(authHeaders) == 0 {
-		return 0, status.Error(codes.Unauthenticated, "Authorization 헤더가 없습니다")
+	return &pb.BlockUserResponse{
+		Message: "사용자를 차단했습니다",
+	}, nil
+}
+
+// UnblockUser - 사용자 차단 해제
+func (h *UserGRPCHandler) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	currentUserID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	authHeader := authHeaders[0]
-	if !strings.HasPrefix(authHeader, "Bearer ") {
-		return 0, status.Error(codes.Unauthenticated, "Bearer 토큰이 아닙니다")
+	if err := h.safetyUsecase.UnblockUser(ctx, currentUserID, uint(req.UserId)); err != nil {
+		return nil, userStatusError(err, "차단 해제 실패")
 	}
 
-	token := strings.TrimPrefix(authHeader, "Bearer ")
-	claims, err := h.jwtService.ValidateToken(token)
+	return &pb.UnblockUserResponse{
+		Message: "차단을 해제했습니다",
+	}, nil
+}
+
+// ListBlockedUsers - 내가 차단한 사용자 목록 조회
+func (h *UserGRPCHandler) ListBlockedUsers(ctx context.Context, req *pb.ListBlockedUsersRequest) (*pb.ListBlockedUsersResponse, error) {
+	currentUserID, err := h.extractUserIDFromContext(ctx)
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "토큰 검증 실패: %v", err)
+		return nil, err
 	}
 
-	return claims.UserID, nil
+	blocked, err := h.safetyUsecase.ListBlockedUsers(ctx, currentUserID)
+	if err != nil {
+		return nil, userStatusError(err, "차단 목록 조회 실패")
+	}
+
+	protoUsers := make([]*pb.BlockedUser, len(blocked))
+	for i := range blocked {
+		protoUsers[i] = &pb.BlockedUser{
+			User:      userDtoToProto(&blocked[i].User),
+			BlockedAt: timestamppb.New(blocked[i].BlockedAt),
+		}
+	}
+
+	return &pb.ListBlockedUsersResponse{
+		Users:   protoUsers,
+		Message: "차단 목록을 조회했습니다",
+	}, nil
+}
+
+// ReportUser - 사용자 신고
+func (h *UserGRPCHandler) ReportUser(ctx context.Context, req *pb.ReportUserRequest) (*pb.ReportUserResponse, error) {
+	currentUserID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reportReq := &dto.ReportUserRequest{
+		Reason:      req.Reason,
+		Description: req.Description,
+	}
+	if req.MessageId != nil {
+		messageID := uint(*req.MessageId)
+		reportReq.MessageID = &messageID
+	}
+
+	report, err := h.safetyUsecase.ReportUser(ctx, currentUserID, uint(req.UserId), reportReq)
+	if err != nil {
+		return nil, userStatusError(err, "사용자 신고 실패")
+	}
+
+	return &pb.ReportUserResponse{
+		Report:  reportDtoToProto(report),
+		Message: "신고가 접수되었습니다",
+	}, nil
+}
+
+// UploadAvatar - 프로필 사진 업로드 (클라이언트 스트리밍, 받은 조각을 이어서 처리)
+func (h *UserGRPCHandler) UploadAvatar(stream pb.UserService_UploadAvatarServer) error {
+	ctx := stream.Context()
+
+	currentUserID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	avatar, err := h.mediaUsecase.UploadAvatar(ctx, currentUserID, newChunkReader(stream.Recv))
+	if err != nil {
+		return userStatusError(err, "프로필 사진 업로드 실패")
+	}
+
+	return stream.SendAndClose(&pb.UploadAvatarResponse{
+		ProfilePic:  avatar.ProfilePic,
+		ProfilePics: avatar.ProfilePics,
+		Message:     "프로필 사진을 변경했습니다",
+	})
+}
+
+// DeleteAvatar - 프로필 사진 삭제
+func (h *UserGRPCHandler) DeleteAvatar(ctx context.Context, req *pb.DeleteAvatarRequest) (*pb.DeleteAvatarResponse, error) {
+	currentUserID, err := h.extractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.mediaUsecase.DeleteAvatar(ctx, currentUserID); err != nil {
+		return nil, userStatusError(err, "프로필 사진 삭제 실패")
+	}
+
+	return &pb.DeleteAvatarResponse{
+		Message: "프로필 사진을 삭제했습니다",
+	}, nil
+}
+
+// Helper 함수들
+
+// JWT 토큰에서 사용자 ID 추출
+func (h *UserGRPCHandler) extractUserIDFromContext(ctx context.Context) (uint, error) {
+	return userIDFromContext(ctx, h.jwtService)
+}
+
+// userStatusError - 사용자/인증 Usecase 에러를 gRPC 상태 코드로 변환
+func userStatusError(err error, action string) error {
+	code := codes.Internal
+	switch {
+	case usecaseErrors.IsUserNotFound(err):
+		code = codes.NotFound
+	case usecaseErrors.IsForbidden(err), usecaseErrors.IsAccountLocked(err), usecaseErrors.IsEmailNotVerified(err):
+		code = codes.PermissionDenied
+	case usecaseErrors.IsUserBlocked(err):
+		code = codes.PermissionDenied
+	case usecaseErrors.IsEmailAlreadyVerified(err), usecaseErrors.IsUserAlreadyBlocked(err):
+		code = codes.AlreadyExists
+	case usecaseErrors.IsUserNotBlocked(err):
+		code = codes.FailedPrecondition
+	case usecaseErrors.IsMessageNotFound(err):
+		code = codes.NotFound
+	case usecaseErrors.IsCannotBlockSelf(err), usecaseErrors.IsCannotReportSelf(err),
+		usecaseErrors.IsInvalidReportReason(err), usecaseErrors.IsInvalidReportDescription(err):
+		code = codes.InvalidArgument
+	case usecaseErrors.IsImageRejected(err):
+		code = codes.InvalidArgument
+	case usecaseErrors.IsAvatarChanged(err):
+		code = codes.Aborted
+	case usecaseErrors.IsInvalidRefreshToken(err), usecaseErrors.IsRefreshTokenReused(err):
+		code = codes.Unauthenticated
+	case usecaseErrors.IsInvalidTravelDates(err), usecaseErrors.IsPastTravelDate(err):
+		code = codes.InvalidArgument
+	case usecaseErrors.IsWeakPassword(err), usecaseErrors.IsIncorrectPassword(err),
+		usecaseErrors.IsSamePassword(err), usecaseErrors.IsInvalidResetToken(err),
+		usecaseErrors.IsInvalidVerificationToken(err):
+		code = codes.InvalidArgument
+	}
+	return status.Errorf(code, "%s: %v", action, err)
+}
+
+// reportDtoToProto - 신고 DTO를 Proto 메시지로 변환
+func reportDtoToProto(report *dto.ReportResponse) *pb.Report {
+	protoReport := &pb.Report{
+		Id:          uint32(report.ID),
+		ReporterId:  uint32(report.ReporterID),
+		ReportedId:  uint32(report.ReportedID),
+		Reason:      report.Reason,
+		Description: report.Description,
+		CreatedAt:   timestamppb.New(report.CreatedAt),
+	}
+	if report.MessageID != nil {
+		messageID := uint32(*report.MessageID)
+		protoReport.MessageId = &messageID
+	}
+	return protoReport
 }
 
 // DTO를 Proto 메시지로 변환
@@ -312,6 +684,24 @@ func userDtoToProto(userDto *dto.UserResponse) *pb.User {
 		ActivityStatus: userDto.ActivityStatus,
 		CreatedAt:      timestamppb.New(userDto.CreatedAt),
 		UpdatedAt:      timestamppb.New(userDto.UpdatedAt),
+		EmailVerified:  userDto.EmailVerified,
+		Role:           userDto.Role,
+	}
+}
+
+// 동행 추천 DTO를 Proto 메시지로 변환
+func matchDtoToProto(match *dto.MatchResponse) *pb.Match {
+	return &pb.Match{
+		User:  userDtoToProto(&match.User),
+		Score: match.Score,
+		Breakdown: &pb.MatchScoreBreakdown{
+			Destination: match.Breakdown.Destination,
+			DateOverlap: match.Breakdown.DateOverlap,
+			Purpose:     match.Breakdown.Purpose,
+			Style:       match.Breakdown.Style,
+			Budget:      match.Breakdown.Budget,
+			OverlapDays: uint32(match.Breakdown.OverlapDays),
+		},
 	}
 }
 
@@ -4,43 +4,73 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/chris910512/travel-chat/internal/delivery/chathub"
 	"github.com/chris910512/travel-chat/internal/delivery/grpc/handler"
 	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
 	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	adminpb "github.com/chris910512/travel-chat/pkg/proto/admin"
+	chatpb "github.com/chris910512/travel-chat/pkg/proto/chat"
 	pb "github.com/chris910512/travel-chat/pkg/proto/user"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 type GRPCServer struct {
-	grpcServer  *grpc.Server
-	gatewayMux  *runtime.ServeMux
-	userHandler *handler.UserGRPCHandler
-	grpcPort    string
-	gatewayPort string
+	grpcServer   *grpc.Server
+	gatewayMux   *runtime.ServeMux
+	userHandler  *handler.UserGRPCHandler
+	chatHandler  *handler.ChatGRPCHandler
+	adminHandler *handler.AdminGRPCHandler
+	grpcPort     string
+	gatewayPort  string
 }
 
 // NewGRPCServer - gRPC 서버 생성자
 func NewGRPCServer(
 	userUsecase usecaseInterface.UserUsecase,
+	chatUsecase usecaseInterface.ChatUsecase,
+	matchUsecase usecaseInterface.MatchUsecase,
+	presenceUsecase usecaseInterface.PresenceUsecase,
+	adminUsecase usecaseInterface.AdminUsecase,
+	safetyUsecase usecaseInterface.SafetyUsecase,
+	mediaUsecase usecaseInterface.MediaUsecase,
+	hubManager *chathub.Manager,
 	jwtService *jwt.JWTService,
+	limiter *ratelimit.Limiter,
 	grpcPort, gatewayPort string,
 ) *GRPCServer {
 	// gRPC 서버 생성
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(loggingInterceptor),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(requestContextInterceptor, loggingInterceptor, metricsInterceptor, rateLimitInterceptor(limiter, jwtService), roleInterceptor(jwtService)),
+		grpc.ChainStreamInterceptor(streamRequestContextInterceptor, streamLoggingInterceptor, streamMetricsInterceptor, streamRateLimitInterceptor(limiter, jwtService), streamRoleInterceptor(jwtService)),
 	)
 
 	// 핸들러 생성
-	userHandler := handler.NewUserGRPCHandler(userUsecase, jwtService)
+	userHandler := handler.NewUserGRPCHandler(userUsecase, matchUsecase, safetyUsecase, mediaUsecase, jwtService)
+	chatHandler := handler.NewChatGRPCHandler(chatUsecase, userUsecase, presenceUsecase, mediaUsecase, hubManager, jwtService, limiter)
+	adminHandler := handler.NewAdminGRPCHandler(adminUsecase, jwtService)
 
 	// 서비스 등록
 	pb.RegisterUserServiceServer(grpcServer, userHandler)
+	chatpb.RegisterChatServiceServer(grpcServer, chatHandler)
+	adminpb.RegisterAdminServiceServer(grpcServer, adminHandler)
 
 	// gRPC reflection 등록 (개발용)
 	reflection.Register(grpcServer)
@@ -48,14 +78,17 @@ func NewGRPCServer(
 	// gRPC Gateway 설정
 	gatewayMux := runtime.NewServeMux(
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
 	)
 
 	return &GRPCServer{
-		grpcServer:  grpcServer,
-		gatewayMux:  gatewayMux,
-		userHandler: userHandler,
-		grpcPort:    grpcPort,
-		gatewayPort: gatewayPort,
+		grpcServer:   grpcServer,
+		gatewayMux:   gatewayMux,
+		userHandler:  userHandler,
+		chatHandler:  chatHandler,
+		adminHandler: adminHandler,
+		grpcPort:     grpcPort,
+		gatewayPort:  gatewayPort,
 	}
 }
 
@@ -82,6 +115,7 @@ func (s *GRPCServer) StartGatewayServer() error {
 		ctx,
 		grpcEndpoint,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 		grpc.WithBlock(),
 	)
 	if err != nil {
@@ -94,11 +128,27 @@ func (s *GRPCServer) StartGatewayServer() error {
 		return fmt.Errorf("failed to register gateway: %v", err)
 	}
 
-	// CORS 설정을 위한 래퍼
+	// 양방향 스트리밍(Chat)은 Gateway에서 지원하지 않으므로 단항 RPC만 매핑됨
+	err = chatpb.RegisterChatServiceHandler(ctx, s.gatewayMux, conn)
+	if err != nil {
+		return fmt.Errorf("failed to register chat gateway: %v", err)
+	}
+
+	err = adminpb.RegisterAdminServiceHandler(ctx, s.gatewayMux, conn)
+	if err != nil {
+		return fmt.Errorf("failed to register admin gateway: %v", err)
+	}
+
+	// CORS 설정을 위한 래퍼 (바깥에서 Gateway 구간 추적 스팬 시작, gRPC 호출 스팬은 그 아래에 연결)
 	corsHandler := corsWrapper(s.gatewayMux)
+	tracedHandler := otelhttp.NewHandler(corsHandler, "grpc-gateway",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "gateway " + r.Method
+		}),
+	)
 
 	log.Printf("gRPC Gateway server starting on port %s", s.gatewayPort)
-	return http.ListenAndServe(":"+s.gatewayPort, corsHandler)
+	return http.ListenAndServe(":"+s.gatewayPort, tracedHandler)
 }
 
 // Stop - 서버 정지
@@ -109,24 +159,146 @@ func (s *GRPCServer) Stop() {
 
 // 미들웨어 및 헬퍼 함수들
 
-// loggingInterceptor - gRPC 로깅 인터셉터
+// requestContextInterceptor - x-request-id 메타데이터를 이어받거나 새로 만들어 컨텍스트와 응답 헤더에 설정
+func requestContextInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	requestID := incomingRequestID(ctx)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(logger.RequestIDMetadataKey, requestID)); err != nil {
+		logger.FromContext(ctx).Warn("failed to set request id header", "error", err)
+	}
+
+	return handler(logger.NewContext(ctx, requestID), req)
+}
+
+// streamRequestContextInterceptor - 스트리밍용 requestContextInterceptor
+func streamRequestContextInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	requestID := incomingRequestID(ss.Context())
+	if err := ss.SetHeader(metadata.Pairs(logger.RequestIDMetadataKey, requestID)); err != nil {
+		logger.FromContext(ss.Context()).Warn("failed to set request id header", "error", err)
+	}
+
+	return handler(srv, &contextServerStream{
+		ServerStream: ss,
+		ctx:          logger.NewContext(ss.Context(), requestID),
+	})
+}
+
+// contextServerStream - Context()만 교체한 ServerStream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// incomingRequestID - 메타데이터의 요청 ID (없거나 형식이 잘못되면 새로 생성)
+func incomingRequestID(ctx context.Context) string {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logger.RequestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	return logger.RequestIDOrNew(requestID)
+}
+
+// loggingInterceptor - gRPC 로깅 인터셉터 (호출당 한 줄)
 func loggingInterceptor(
 	ctx context.Context,
 	req interface{},
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler,
 ) (interface{}, error) {
-	log.Printf("gRPC call: %s", info.FullMethod)
+	start := time.Now()
 
 	resp, err := handler(ctx, req)
 
+	logGRPCCall(ctx, "grpc call", info.FullMethod, err, time.Since(start))
+	return resp, err
+}
+
+// streamLoggingInterceptor - gRPC 스트리밍 로깅 인터셉터
+func streamLoggingInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	logger.FromContext(ss.Context()).Info("grpc stream opened", "method", info.FullMethod)
+
+	err := handler(srv, ss)
+
+	logGRPCCall(ss.Context(), "grpc stream closed", info.FullMethod, err, time.Since(start))
+	return err
+}
+
+// logGRPCCall - 상태 코드에 따라 레벨을 나눠 기록 (클라이언트 오류는 warn, 서버 오류는 error)
+func logGRPCCall(ctx context.Context, msg, method string, err error, elapsed time.Duration) {
+	code := status.Code(err)
+
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK:
+	case codes.Canceled, codes.InvalidArgument, codes.NotFound, codes.AlreadyExists,
+		codes.PermissionDenied, codes.Unauthenticated, codes.FailedPrecondition, codes.ResourceExhausted:
+		level = slog.LevelWarn
+	default:
+		level = slog.LevelError
+	}
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("code", code.String()),
+		slog.Duration("latency", elapsed),
+	}
 	if err != nil {
-		log.Printf("gRPC error: %s - %v", info.FullMethod, err)
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
 	}
+	logger.FromContext(ctx).LogAttrs(ctx, level, msg, attrs...)
+}
 
+// metricsInterceptor - gRPC 메서드별 호출 수와 응답 시간 기록 인터셉터
+func metricsInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+
+	resp, err := handler(ctx, req)
+
+	metrics.ObserveGRPCRequest(info.FullMethod, "unary", status.Code(err).String(), time.Since(start))
 	return resp, err
 }
 
+// streamMetricsInterceptor - gRPC 스트림별 호출 수와 유지 시간 기록 인터셉터
+func streamMetricsInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+
+	err := handler(srv, ss)
+
+	metrics.ObserveGRPCRequest(info.FullMethod, "stream", status.Code(err).String(), time.Since(start))
+	return err
+}
+
 // customHeaderMatcher - 헤더 매칭 함수
 func customHeaderMatcher(key string) (string, bool) {
 	switch key {
@@ -134,18 +306,34 @@ func customHeaderMatcher(key string) (string, bool) {
 		return key, true
 	case "Content-Type":
 		return key, true
+	case "X-Request-Id":
+		return logger.RequestIDMetadataKey, true
+	case "Traceparent", "Tracestate", "Baggage":
+		// W3C Trace Context - gRPC 서버 스팬이 호출자의 추적에 이어지도록 전달
+		return strings.ToLower(key), true
 	default:
 		return runtime.DefaultHeaderMatcher(key)
 	}
 }
 
+// outgoingHeaderMatcher - gRPC 응답 헤더 중 요청 ID와 재시도 대기 시간은 표준 HTTP 헤더로 전달
+func outgoingHeaderMatcher(key string) (string, bool) {
+	switch key {
+	case logger.RequestIDMetadataKey:
+		return logger.RequestIDHeader, true
+	case retryAfterMetadataKey:
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
 // corsWrapper - CORS 설정
 func corsWrapper(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-User-ID")
-		w.Header().Set("Access-Control-Expose-Headers", "Content-Length")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-User-ID, X-Request-ID, traceparent, tracestate")
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Length, X-Request-ID, Retry-After")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
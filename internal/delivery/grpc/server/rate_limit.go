@@ -0,0 +1,109 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/chris910512/travel-chat/internal/delivery/grpc/handler"
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// retryAfterMetadataKey - 거부된 호출의 재시도 대기 시간(초), Gateway에서는 Retry-After 헤더로 변환
+const retryAfterMetadataKey = "retry-after"
+
+// 메서드별 정책 (그 밖의 메서드는 PolicyAPI)
+var methodPolicies = map[string]ratelimit.Policy{
+	"/user.UserService/Login":                   ratelimit.PolicyLogin,
+	"/user.UserService/Register":                ratelimit.PolicyRegister,
+	"/user.UserService/ForgotPassword":          ratelimit.PolicyLogin,
+	"/user.UserService/ResetPassword":           ratelimit.PolicyLogin,
+	"/user.UserService/VerifyEmail":             ratelimit.PolicyLogin,
+	"/user.UserService/ResendVerificationEmail": ratelimit.PolicyLogin,
+}
+
+// 사용자 기준으로 세는 메서드별 정책 (토큰이 없거나 유효하지 않으면 IP 기준)
+var userMethodPolicies = map[string]ratelimit.Policy{
+	"/chat.ChatService/UploadImage":  ratelimit.PolicyUpload,
+	"/user.UserService/UploadAvatar": ratelimit.PolicyUpload,
+}
+
+// rateLimitInterceptor - 메서드 정책 한도를 넘은 호출은 ResourceExhausted로 거부
+func rateLimitInterceptor(limiter *ratelimit.Limiter, jwtService *jwt.JWTService) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkRateLimit(ctx, limiter, jwtService, info.FullMethod, grpc.SetHeader); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamRateLimitInterceptor - 스트림 연결 시작에 대한 한도 (스트림 안의 메시지 전송은 핸들러에서 제한)
+func streamRateLimitInterceptor(limiter *ratelimit.Limiter, jwtService *jwt.JWTService) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		setHeader := func(_ context.Context, md metadata.MD) error { return ss.SetHeader(md) }
+		if err := checkRateLimit(ss.Context(), limiter, jwtService, info.FullMethod, setHeader); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkRateLimit(
+	ctx context.Context,
+	limiter *ratelimit.Limiter,
+	jwtService *jwt.JWTService,
+	method string,
+	setHeader func(context.Context, metadata.MD) error,
+) error {
+	policy, key := rateLimitPolicy(ctx, jwtService, method)
+
+	result := limiter.Allow(ctx, policy, key)
+	if result.Allowed {
+		return nil
+	}
+
+	retryAfter := ratelimit.RetryAfterSeconds(result.RetryAfter)
+	if err := setHeader(ctx, metadata.Pairs(retryAfterMetadataKey, strconv.Itoa(retryAfter))); err != nil {
+		logger.FromContext(ctx).Warn("failed to set retry-after header", "error", err)
+	}
+
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("요청이 너무 많습니다. %d초 후 다시 시도해주세요", retryAfter))
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(result.RetryAfter)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// rateLimitPolicy - 메서드에 적용할 정책과 버킷 키
+func rateLimitPolicy(ctx context.Context, jwtService *jwt.JWTService, method string) (ratelimit.Policy, string) {
+	key := ratelimit.IPKey(handler.ClientIP(ctx))
+	if policy, ok := userMethodPolicies[method]; ok {
+		if claims, err := handler.ClaimsFromContext(ctx, jwtService); err == nil {
+			key = ratelimit.UserKey(claims.UserID)
+		}
+		return policy, key
+	}
+	if policy, ok := methodPolicies[method]; ok {
+		return policy, key
+	}
+	return ratelimit.PolicyAPI, ratelimit.RouteKey(method, key)
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chris910512/travel-chat/internal/delivery/grpc/handler"
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 서비스("/패키지.서비스/") 또는 메서드별 필요한 최소 역할 (메서드 항목이 우선, 목록에 없으면 역할 확인 없음)
+var methodRoles = map[string]user.Role{
+	"/admin.AdminService/":               user.RoleModerator,
+	"/admin.AdminService/UpdateUserRole": user.RoleAdmin,
+}
+
+// roleInterceptor - 토큰의 역할이 메서드에 필요한 역할보다 낮으면 PermissionDenied로 거부
+//
+// 토큰의 역할은 발급 시점 기준이므로 usecase에서 저장된 역할로 한 번 더 확인한다.
+func roleInterceptor(jwtService *jwt.JWTService) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := checkRole(ctx, jwtService, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// streamRoleInterceptor - 스트리밍용 roleInterceptor (스트림 연결 시작 시 확인)
+func streamRoleInterceptor(jwtService *jwt.JWTService) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := checkRole(ss.Context(), jwtService, info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkRole(ctx context.Context, jwtService *jwt.JWTService, method string) error {
+	required, ok := requiredRole(method)
+	if !ok {
+		return nil
+	}
+
+	claims, err := handler.ClaimsFromContext(ctx, jwtService)
+	if err != nil {
+		return err
+	}
+
+	role := user.RoleFromString(claims.Role)
+	if !role.AtLeast(required) {
+		return status.Error(codes.PermissionDenied, "이 기능을 사용할 권한이 없습니다")
+	}
+	return nil
+}
+
+// requiredRole - 메서드에 필요한 최소 역할 (메서드 항목이 없으면 서비스 항목 사용)
+func requiredRole(method string) (user.Role, bool) {
+	if role, ok := methodRoles[method]; ok {
+		return role, true
+	}
+	if i := strings.LastIndex(method, "/"); i > 0 {
+		role, ok := methodRoles[method[:i+1]]
+		return role, ok
+	}
+	return user.RoleUser, false
+}
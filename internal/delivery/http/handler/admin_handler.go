@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gin-gonic/gin"
+)
+
+type AdminHandler struct {
+	adminUsecase usecaseInterface.AdminUsecase
+}
+
+// NewAdminHandler - Admin Handler 생성자
+func NewAdminHandler(adminUsecase usecaseInterface.AdminUsecase) *AdminHandler {
+	return &AdminHandler{
+		adminUsecase: adminUsecase,
+	}
+}
+
+// ListUsers - 정지된 사용자를 포함한 사용자 목록 조회
+// GET /api/admin/users?page=1&limit=20&role=moderator&status=suspended
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	actorID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.AdminListUsersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "쿼리 파라미터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	users, err := h.adminUsecase.ListUsers(c.Request.Context(), actorID, &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "사용자 목록을 조회했습니다", users)
+}
+
+// SuspendUser - 사용자 정지
+// POST /api/admin/users/:id/suspend
+func (h *AdminHandler) SuspendUser(c *gin.Context) {
+	actorID, userID, ok := actorAndUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.adminUsecase.SuspendUser(c.Request.Context(), actorID, userID)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "사용자를 정지했습니다", user)
+}
+
+// RestoreUser - 정지된 사용자 복구
+// POST /api/admin/users/:id/restore
+func (h *AdminHandler) RestoreUser(c *gin.Context) {
+	actorID, userID, ok := actorAndUserID(c)
+	if !ok {
+		return
+	}
+
+	user, err := h.adminUsecase.RestoreUser(c.Request.Context(), actorID, userID)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "사용자 정지를 해제했습니다", user)
+}
+
+// UpdateUserRole - 사용자 역할 변경 (관리자만)
+// PUT /api/admin/users/:id/role
+func (h *AdminHandler) UpdateUserRole(c *gin.Context) {
+	actorID, userID, ok := actorAndUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	user, err := h.adminUsecase.UpdateUserRole(c.Request.Context(), actorID, userID, &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "사용자 역할을 변경했습니다", user)
+}
+
+// DeleteRoom - 채팅방과 메시지 삭제
+// DELETE /api/admin/rooms/:id
+func (h *AdminHandler) DeleteRoom(c *gin.Context) {
+	actorID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "올바르지 않은 채팅방 ID입니다")
+		return
+	}
+
+	if err := h.adminUsecase.DeleteRoom(c.Request.Context(), actorID, uint(roomID)); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "채팅방을 삭제했습니다", nil)
+}
+
+// PurgeMessages - 채팅방/작성자 조건으로 메시지 일괄 삭제
+// DELETE /api/admin/messages?room_id=1&user_id=2
+func (h *AdminHandler) PurgeMessages(c *gin.Context) {
+	actorID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.PurgeMessagesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "쿼리 파라미터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	result, err := h.adminUsecase.PurgeMessages(c.Request.Context(), actorID, &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "메시지를 삭제했습니다", result)
+}
+
+// ListReports - 사용자 신고 목록 조회
+// GET /api/admin/reports?page=1&limit=20&reported_id=2
+func (h *AdminHandler) ListReports(c *gin.Context) {
+	actorID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.ListReportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "쿼리 파라미터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	reports, err := h.adminUsecase.ListReports(c.Request.Context(), actorID, &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "신고 목록을 조회했습니다", reports)
+}
+
+// actorAndUserID - 요청자 ID와 경로의 대상 사용자 ID 추출 (실패 시 응답 후 false)
+func actorAndUserID(c *gin.Context) (uint, uint, bool) {
+	actorID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return 0, 0, false
+	}
+
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "올바르지 않은 사용자 ID입니다")
+		return 0, 0, false
+	}
+
+	return actorID, uint(userID), true
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gin-gonic/gin"
+)
+
+type ChatHandler struct {
+	chatUsecase usecaseInterface.ChatUsecase
+}
+
+// NewChatHandler - Chat Handler 생성자
+func NewChatHandler(chatUsecase usecaseInterface.ChatUsecase) *ChatHandler {
+	return &ChatHandler{
+		chatUsecase: chatUsecase,
+	}
+}
+
+// JoinPublicRoom - 내 목적지 전체 채팅방 참여
+// POST /api/chat/rooms/public
+func (h *ChatHandler) JoinPublicRoom(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	room, err := h.chatUsecase.JoinPublicRoom(c.Request.Context(), userID)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "전체 채팅방에 참여했습니다", room)
+}
+
+// ListRooms - 내 채팅방 목록 조회
+// GET /api/chat/rooms
+func (h *ChatHandler) ListRooms(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	rooms, err := h.chatUsecase.ListRooms(c.Request.Context(), userID)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "채팅방 목록을 조회했습니다", rooms)
+}
+
+// OpenPrivateRoom - 1:1 채팅방 생성
+// POST /api/chat/rooms/private
+func (h *ChatHandler) OpenPrivateRoom(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.OpenPrivateRoomRequest
+
+	// 요청 바인딩
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	room, err := h.chatUsecase.OpenPrivateRoom(c.Request.Context(), userID, req.TargetUserID)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "1:1 채팅방이 준비되었습니다", room)
+}
+
+// GetHistory - 채팅방 메시지 기록 조회
+// GET /api/chat/rooms/:id/messages?limit=50
+func (h *ChatHandler) GetHistory(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "올바르지 않은 채팅방 ID입니다")
+		return
+	}
+
+	var req dto.GetHistoryRequest
+
+	// 쿼리 파라미터 바인딩
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "쿼리 파라미터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	history, err := h.chatUsecase.GetHistory(c.Request.Context(), userID, uint(roomID), &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "메시지 기록을 조회했습니다", history)
+}
+
+// SendMessage - 메시지 전송
+// POST /api/chat/rooms/:id/messages
+func (h *ChatHandler) SendMessage(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		response.BadRequest(c, "올바르지 않은 채팅방 ID입니다")
+		return
+	}
+
+	var req dto.SendMessageRequest
+
+	// 요청 바인딩
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	msg, err := h.chatUsecase.SendMessage(c.Request.Context(), userID, uint(roomID), &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Created(c, "메시지를 전송했습니다", msg)
+}
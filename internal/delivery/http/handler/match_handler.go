@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gin-gonic/gin"
+)
+
+type MatchHandler struct {
+	matchUsecase usecaseInterface.MatchUsecase
+}
+
+// NewMatchHandler - Match Handler 생성자
+func NewMatchHandler(matchUsecase usecaseInterface.MatchUsecase) *MatchHandler {
+	return &MatchHandler{
+		matchUsecase: matchUsecase,
+	}
+}
+
+// GetMyMatches - 내 여행 동행 추천 목록 조회
+// GET /api/users/me/matches?page=1&limit=10
+func (h *MatchHandler) GetMyMatches(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.GetMatchesRequest
+
+	// 쿼리 파라미터 바인딩
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "쿼리 파라미터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	matches, err := h.matchUsecase.GetMatches(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "동행 추천 목록을 조회했습니다", matches)
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gin-gonic/gin"
+)
+
+// imageFormField - 업로드 요청에서 이미지 파일을 담는 multipart 필드 이름
+const imageFormField = "image"
+
+// blobMaxAge - 내용이 바뀌지 않는 파일의 최대 캐시 기간 (초, 1년)
+const blobMaxAge = 365 * 24 * 60 * 60
+
+// multipartOverhead - 요청 본문 한도 계산 시 파일 외 multipart 헤더/경계 문자열 여유분
+const multipartOverhead = 64 << 10
+
+type MediaHandler struct {
+	mediaUsecase usecaseInterface.MediaUsecase
+	maxBytes     int64
+}
+
+// NewMediaHandler - Media Handler 생성자 (maxBytes는 이미지 파일 최대 크기)
+func NewMediaHandler(mediaUsecase usecaseInterface.MediaUsecase, maxBytes int64) *MediaHandler {
+	return &MediaHandler{
+		mediaUsecase: mediaUsecase,
+		maxBytes:     maxBytes,
+	}
+}
+
+// UploadImage - 채팅 이미지 업로드 (multipart/form-data의 image 필드, 임시 파일 없이 바로 처리)
+// POST /api/chat/images
+func (h *MediaHandler) UploadImage(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	part, ok := h.imagePart(c)
+	if !ok {
+		return
+	}
+	defer part.Close()
+
+	image, err := h.mediaUsecase.UploadImage(c.Request.Context(), userID, part)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Created(c, "이미지를 업로드했습니다", image)
+}
+
+// UploadAvatar - 프로필 사진 업로드 (multipart/form-data의 image 필드, 이전 사진은 삭제)
+// POST /api/users/me/avatar
+func (h *MediaHandler) UploadAvatar(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	part, ok := h.imagePart(c)
+	if !ok {
+		return
+	}
+	defer part.Close()
+
+	avatar, err := h.mediaUsecase.UploadAvatar(c.Request.Context(), userID, part)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "프로필 사진을 변경했습니다", avatar)
+}
+
+// DeleteAvatar - 프로필 사진 삭제
+// DELETE /api/users/me/avatar
+func (h *MediaHandler) DeleteAvatar(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	if err := h.mediaUsecase.DeleteAvatar(c.Request.Context(), userID); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "프로필 사진을 삭제했습니다", nil)
+}
+
+// imagePart - 요청 본문 크기를 제한하고 이미지 파일 part 찾기 (실패하면 400 응답 후 false)
+func (h *MediaHandler) imagePart(c *gin.Context) (*multipart.Part, bool) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxBytes+multipartOverhead)
+	part, err := findImagePart(c.Request)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			response.BadRequest(c, usecaseErrors.ErrImageTooLarge.Error())
+			return nil, false
+		}
+		response.BadRequest(c, "image 필드에 이미지 파일을 첨부해주세요", err.Error())
+		return nil, false
+	}
+	return part, true
+}
+
+// findImagePart - multipart 본문에서 이미지 파일 part 찾기 (앞의 다른 필드는 건너뜀)
+func findImagePart(r *http.Request) (*multipart.Part, error) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		if part.FormName() == imageFormField && part.FileName() != "" {
+			return part, nil
+		}
+		part.Close()
+	}
+}
+
+// GetBlob - 업로드한 이미지 조회 (ID를 아는 경우만 접근 가능, 내용이 바뀌지 않으므로 캐시 허용)
+// 프로필 사진은 공유 캐시(CDN 등)에 오래 캐시하고, 채팅 이미지는 삭제될 때까지만 브라우저에 캐시
+// GET /api/blobs/:id/:variant
+func (h *MediaHandler) GetBlob(c *gin.Context) {
+	content, err := h.mediaUsecase.GetBlob(c.Request.Context(), c.Param("id"), c.Param("variant"))
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+	defer content.Body.Close()
+
+	etag := `"` + content.ID + "-" + content.Variant + `"`
+	c.Header("Cache-Control", blobCacheControl(content, time.Now()))
+	c.Header("ETag", etag)
+	c.Header("X-Content-Type-Options", "nosniff")
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.Header("Content-Type", content.ContentType)
+	c.Status(http.StatusOK)
+	if _, err := io.Copy(c.Writer, content.Body); err != nil {
+		logger.FromContext(c.Request.Context()).Warn("failed to write blob", "blob_id", content.ID, "error", err)
+	}
+}
+
+// blobCacheControl - 파일 종류별 캐시 정책 (만료되는 파일은 남은 보관 기간까지만 캐시)
+func blobCacheControl(content *dto.BlobContent, now time.Time) string {
+	if content.Public {
+		return fmt.Sprintf("public, max-age=%d, immutable", blobMaxAge)
+	}
+	if content.ExpiresAt == nil {
+		return "private, no-cache"
+	}
+
+	maxAge := int64(content.ExpiresAt.Sub(now) / time.Second)
+	maxAge = max(0, min(maxAge, blobMaxAge))
+	return fmt.Sprintf("private, max-age=%d", maxAge)
+}
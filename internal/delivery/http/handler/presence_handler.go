@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"strconv"
+
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gin-gonic/gin"
+)
+
+type PresenceHandler struct {
+	presenceUsecase usecaseInterface.PresenceUsecase
+}
+
+// NewPresenceHandler - Presence Handler 생성자
+func NewPresenceHandler(presenceUsecase usecaseInterface.PresenceUsecase) *PresenceHandler {
+	return &PresenceHandler{
+		presenceUsecase: presenceUsecase,
+	}
+}
+
+// GetActiveUsers - 현재 접속 중인(온라인/자리 비움) 사용자 목록 조회
+// GET /api/users/active
+func (h *PresenceHandler) GetActiveUsers(c *gin.Context) {
+	users, err := h.presenceUsecase.GetActiveUsers(c.Request.Context())
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "접속 중인 사용자 목록을 조회했습니다", users)
+}
+
+// GetPresence - 사용자 접속 상태 조회
+// GET /api/users/:id/presence
+func (h *PresenceHandler) GetPresence(c *gin.Context) {
+	// URL 파라미터에서 사용자 ID 추출
+	idParam := c.Param("id")
+	userID, err := strconv.ParseUint(idParam, 10, 32)
+	if err != nil {
+		response.BadRequest(c, "올바르지 않은 사용자 ID입니다")
+		return
+	}
+
+	presence, err := h.presenceUsecase.GetStatus(c.Request.Context(), uint(userID))
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "접속 상태를 조회했습니다", presence)
+}
+
+// Heartbeat - 활동 알림 (실시간 연결 없이 접속 상태 유지)
+// POST /api/users/me/heartbeat
+func (h *PresenceHandler) Heartbeat(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	if err := h.presenceUsecase.Heartbeat(c.Request.Context(), userID); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	presence, err := h.presenceUsecase.GetStatus(c.Request.Context(), userID)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "접속 상태가 갱신되었습니다", presence)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gin-gonic/gin"
+)
+
+type SafetyHandler struct {
+	safetyUsecase usecaseInterface.SafetyUsecase
+}
+
+// NewSafetyHandler - Safety Handler 생성자
+func NewSafetyHandler(safetyUsecase usecaseInterface.SafetyUsecase) *SafetyHandler {
+	return &SafetyHandler{
+		safetyUsecase: safetyUsecase,
+	}
+}
+
+// BlockUser - 사용자 차단
+// POST /api/users/:id/block
+func (h *SafetyHandler) BlockUser(c *gin.Context) {
+	currentUserID, targetUserID, ok := actorAndUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.safetyUsecase.BlockUser(c.Request.Context(), currentUserID, targetUserID); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "사용자를 차단했습니다", nil)
+}
+
+// UnblockUser - 차단 해제
+// DELETE /api/users/:id/block
+func (h *SafetyHandler) UnblockUser(c *gin.Context) {
+	currentUserID, targetUserID, ok := actorAndUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.safetyUsecase.UnblockUser(c.Request.Context(), currentUserID, targetUserID); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "차단을 해제했습니다", nil)
+}
+
+// ListBlockedUsers - 내가 차단한 사용자 목록
+// GET /api/users/me/blocks
+func (h *SafetyHandler) ListBlockedUsers(c *gin.Context) {
+	currentUserID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	users, err := h.safetyUsecase.ListBlockedUsers(c.Request.Context(), currentUserID)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "차단한 사용자 목록을 조회했습니다", users)
+}
+
+// ReportUser - 사용자 신고
+// POST /api/users/:id/report
+func (h *SafetyHandler) ReportUser(c *gin.Context) {
+	currentUserID, targetUserID, ok := actorAndUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ReportUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	report, err := h.safetyUsecase.ReportUser(c.Request.Context(), currentUserID, targetUserID, &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Created(c, "신고가 접수되었습니다", report)
+}
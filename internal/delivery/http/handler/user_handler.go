@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
 	"github.com/chris910512/travel-chat/internal/delivery/http/response"
 	"github.com/chris910512/travel-chat/internal/usecase/dto"
 	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
@@ -44,6 +45,123 @@ func (h *UserHandler) RefreshToken(c *gin.Context) {
 	response.Success(c, "토큰이 갱신되었습니다", refreshResp)
 }
 
+// Logout - 현재 기기 로그아웃
+// POST /api/auth/logout
+func (h *UserHandler) Logout(c *gin.Context) {
+	currentUserID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.LogoutRequest
+
+	// 요청 바인딩
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	if err := h.userUsecase.Logout(c.Request.Context(), currentUserID, &req); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "로그아웃되었습니다", nil)
+}
+
+// LogoutAll - 모든 기기에서 로그아웃
+// POST /api/auth/logout-all
+func (h *UserHandler) LogoutAll(c *gin.Context) {
+	currentUserID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	if err := h.userUsecase.LogoutAll(c.Request.Context(), currentUserID); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "모든 기기에서 로그아웃되었습니다", nil)
+}
+
+// ForgotPassword - 비밀번호 재설정 메일 요청 (가입 여부와 관계없이 같은 응답)
+// POST /api/auth/password/forgot
+func (h *UserHandler) ForgotPassword(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+
+	// 요청 바인딩
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	if err := h.userUsecase.ForgotPassword(c.Request.Context(), &req); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "가입된 이메일이면 비밀번호 재설정 링크를 보냈습니다", nil)
+}
+
+// ResetPassword - 메일로 받은 토큰으로 비밀번호 재설정
+// POST /api/auth/password/reset
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+
+	// 요청 바인딩
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	if err := h.userUsecase.ResetPassword(c.Request.Context(), &req); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "비밀번호가 재설정되었습니다. 새 비밀번호로 다시 로그인해주세요", nil)
+}
+
+// VerifyEmail - 메일로 받은 토큰으로 이메일 인증
+// POST /api/auth/email/verify
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	var req dto.VerifyEmailRequest
+
+	// 요청 바인딩
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	user, err := h.userUsecase.VerifyEmail(c.Request.Context(), &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "이메일 인증이 완료되었습니다", user)
+}
+
+// ResendVerification - 이메일 인증 메일 재발송
+// POST /api/auth/email/resend
+func (h *UserHandler) ResendVerification(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	if err := h.userUsecase.ResendVerification(c.Request.Context(), userID); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "인증 메일을 다시 보냈습니다", nil)
+}
+
 // Register - 사용자 등록
 // POST /api/users/register
 func (h *UserHandler) Register(c *gin.Context) {
@@ -76,6 +194,10 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
+	// 로그인 기록용 접속 정보 (신뢰하는 프록시를 거친 경우에만 X-Forwarded-For 사용)
+	req.IP = c.ClientIP()
+	req.UserAgent = c.Request.UserAgent()
+
 	// 로그인 처리
 	loginResp, err := h.userUsecase.Login(c.Request.Context(), &req)
 	if err != nil {
@@ -107,7 +229,7 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
 	response.Success(c, "사용자 정보를 조회했습니다", user)
 }
 
-// GetUsers - 사용자 목록 조회
+// GetUsers - 사용자 목록 조회 (로그인한 경우 차단 관계인 사용자 제외)
 // GET /api/users?page=1&limit=10&country=일본&city=도쿄
 func (h *UserHandler) GetUsers(c *gin.Context) {
 	var req dto.GetUsersRequest
@@ -126,8 +248,9 @@ func (h *UserHandler) GetUsers(c *gin.Context) {
 		req.Limit = 10
 	}
 
-	// 사용자 목록 조회
-	users, err := h.userUsecase.GetUsers(c.Request.Context(), &req)
+	// 사용자 목록 조회 (비로그인이면 viewerID 0)
+	viewerID, _ := middleware.GetCurrentUserID(c)
+	users, err := h.userUsecase.GetUsers(c.Request.Context(), viewerID, &req)
 	if err != nil {
 		handleUsecaseError(c, err)
 		return
@@ -136,7 +259,7 @@ func (h *UserHandler) GetUsers(c *gin.Context) {
 	response.Success(c, "사용자 목록을 조회했습니다", users)
 }
 
-// GetUsersByDestination - 목적지별 사용자 조회
+// GetUsersByDestination - 목적지별 사용자 조회 (로그인한 경우 차단 관계인 사용자 제외)
 // GET /api/users/destination/:country/:city
 func (h *UserHandler) GetUsersByDestination(c *gin.Context) {
 	country := c.Param("country")
@@ -147,8 +270,9 @@ func (h *UserHandler) GetUsersByDestination(c *gin.Context) {
 		return
 	}
 
-	// 목적지별 사용자 조회
-	users, err := h.userUsecase.GetUsersByDestination(c.Request.Context(), country, city)
+	// 목적지별 사용자 조회 (비로그인이면 viewerID 0)
+	viewerID, _ := middleware.GetCurrentUserID(c)
+	users, err := h.userUsecase.GetUsersByDestination(c.Request.Context(), viewerID, country, city)
 	if err != nil {
 		handleUsecaseError(c, err)
 		return
@@ -160,6 +284,12 @@ func (h *UserHandler) GetUsersByDestination(c *gin.Context) {
 // UpdateProfile - 사용자 프로필 업데이트
 // PUT /api/users/:id
 func (h *UserHandler) UpdateProfile(c *gin.Context) {
+	currentUserID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
 	// URL 파라미터에서 사용자 ID 추출
 	idParam := c.Param("id")
 	userID, err := strconv.ParseUint(idParam, 10, 32)
@@ -177,7 +307,7 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	}
 
 	// 프로필 업데이트
-	user, err := h.userUsecase.UpdateProfile(c.Request.Context(), uint(userID), &req)
+	user, err := h.userUsecase.UpdateProfile(c.Request.Context(), currentUserID, uint(userID), &req)
 	if err != nil {
 		handleUsecaseError(c, err)
 		return
@@ -189,6 +319,12 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 // UpdateLastActive - 마지막 활동 시간 업데이트
 // POST /api/users/:id/activity
 func (h *UserHandler) UpdateLastActive(c *gin.Context) {
+	currentUserID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
 	// URL 파라미터에서 사용자 ID 추출
 	idParam := c.Param("id")
 	userID, err := strconv.ParseUint(idParam, 10, 32)
@@ -198,7 +334,7 @@ func (h *UserHandler) UpdateLastActive(c *gin.Context) {
 	}
 
 	// 마지막 활동 시간 업데이트
-	if err := h.userUsecase.UpdateLastActive(c.Request.Context(), uint(userID)); err != nil {
+	if err := h.userUsecase.UpdateLastActive(c.Request.Context(), currentUserID, uint(userID)); err != nil {
 		handleUsecaseError(c, err)
 		return
 	}
@@ -209,6 +345,12 @@ func (h *UserHandler) UpdateLastActive(c *gin.Context) {
 // DeleteUser - 사용자 삭제
 // DELETE /api/users/:id
 func (h *UserHandler) DeleteUser(c *gin.Context) {
+	currentUserID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
 	// URL 파라미터에서 사용자 ID 추출
 	idParam := c.Param("id")
 	userID, err := strconv.ParseUint(idParam, 10, 32)
@@ -218,7 +360,7 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 	}
 
 	// 사용자 삭제
-	if err := h.userUsecase.DeleteUser(c.Request.Context(), uint(userID)); err != nil {
+	if err := h.userUsecase.DeleteUser(c.Request.Context(), currentUserID, uint(userID)); err != nil {
 		handleUsecaseError(c, err)
 		return
 	}
@@ -226,6 +368,57 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
 	response.Success(c, "사용자가 삭제되었습니다", nil)
 }
 
+// GetMyLogins - 내 로그인 기록 조회 (최근 순)
+// GET /api/users/me/logins?page=1&limit=20
+func (h *UserHandler) GetMyLogins(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.GetLoginHistoryRequest
+
+	// 쿼리 파라미터 바인딩
+	if err := c.ShouldBindQuery(&req); err != nil {
+		response.BadRequest(c, "쿼리 파라미터가 올바르지 않습니다", err.Error())
+		return
+	}
+
+	logins, err := h.userUsecase.GetLoginHistory(c.Request.Context(), userID, &req)
+	if err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "로그인 기록을 조회했습니다", logins)
+}
+
+// ChangePassword - 내 비밀번호 변경 (모든 기기의 리프레시 토큰 폐기)
+// PUT /api/users/me/password
+func (h *UserHandler) ChangePassword(c *gin.Context) {
+	userID, ok := middleware.GetCurrentUserID(c)
+	if !ok {
+		response.Unauthorized(c, "인증이 필요합니다")
+		return
+	}
+
+	var req dto.ChangePasswordRequest
+
+	// 요청 바인딩
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.BadRequest(c, "요청 형식이 올바르지 않습니다", err.Error())
+		return
+	}
+
+	if err := h.userUsecase.ChangePassword(c.Request.Context(), userID, &req); err != nil {
+		handleUsecaseError(c, err)
+		return
+	}
+
+	response.Success(c, "비밀번호가 변경되었습니다. 새 비밀번호로 다시 로그인해주세요", nil)
+}
+
 // GetMe - 현재 로그인한 사용자 정보 조회 (JWT 토큰 기반)
 // GET /api/users/me
 func (h *UserHandler) GetMe(c *gin.Context) {
@@ -273,6 +466,10 @@ func handleUsecaseError(c *gin.Context, err error) {
 		response.Unauthorized(c, err.Error())
 	case errors.Is(err, usecaseErrors.ErrWeakPassword):
 		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrIncorrectPassword):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrSamePassword):
+		response.BadRequest(c, err.Error())
 	case errors.Is(err, usecaseErrors.ErrInvalidEmail):
 		response.BadRequest(c, err.Error())
 	case errors.Is(err, usecaseErrors.ErrInvalidTravelDates):
@@ -283,6 +480,68 @@ func handleUsecaseError(c *gin.Context, err error) {
 		response.Unauthorized(c, err.Error())
 	case errors.Is(err, usecaseErrors.ErrForbidden):
 		response.Forbidden(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrAccountLocked):
+		until, _ := usecaseErrors.LockedUntil(err)
+		response.Locked(c, err.Error(), until)
+	case errors.Is(err, usecaseErrors.ErrInvalidRefreshToken):
+		response.Unauthorized(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrRefreshTokenReused):
+		response.Unauthorized(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrInvalidResetToken):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrEmailNotVerified):
+		response.Forbidden(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrEmailAlreadyVerified):
+		response.Conflict(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrInvalidVerificationToken):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrChatRoomNotFound):
+		response.NotFound(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrEmptyMessage):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrMessageTooLong):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrProfanityNotAllowed),
+		errors.Is(err, usecaseErrors.ErrContactInfoNotAllowed),
+		errors.Is(err, usecaseErrors.ErrURLNotAllowed),
+		errors.Is(err, usecaseErrors.ErrMessageRejected):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrDestinationNotSet):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrCannotChatWithSelf):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrInvalidRole):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrInvalidUserStatus):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrPurgeFilterRequired):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrUserAlreadySuspended):
+		response.Conflict(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrUserNotSuspended):
+		response.Conflict(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrCannotBlockSelf):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrUserAlreadyBlocked):
+		response.Conflict(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrUserNotBlocked):
+		response.Conflict(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrUserBlocked):
+		response.Forbidden(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrCannotReportSelf):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrInvalidReportReason):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrInvalidReportDescription):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrMessageNotFound):
+		response.NotFound(c, err.Error())
+	case usecaseErrors.IsImageRejected(err):
+		response.BadRequest(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrImageNotFound):
+		response.NotFound(c, err.Error())
+	case errors.Is(err, usecaseErrors.ErrAvatarChanged):
+		response.Conflict(c, err.Error())
 	default:
 		response.InternalServerError(c, "서버 내부 오류가 발생했습니다", err.Error())
 	}
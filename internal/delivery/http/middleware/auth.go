@@ -4,7 +4,10 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
 	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
@@ -43,7 +46,7 @@ func AuthMiddleware(jwtService *jwt.JWTService) gin.HandlerFunc {
 
 		// 토큰 검증
 		token := tokenParts[1]
-		claims, err := jwtService.ValidateToken(token)
+		claims, err := jwtService.ValidateAccessToken(token)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"success": false,
@@ -57,9 +60,11 @@ func AuthMiddleware(jwtService *jwt.JWTService) gin.HandlerFunc {
 			return
 		}
 
-		// 사용자 정보를 컨텍스트에 저장
+		// 사용자 정보를 컨텍스트에 저장 (이후 로그에 사용자 ID 포함)
 		c.Set("user_id", claims.UserID)
 		c.Set("user_email", claims.Email)
+		c.Set("user_role", user.RoleFromString(claims.Role))
+		logger.SetUserID(c.Request.Context(), claims.UserID)
 
 		c.Next()
 	}
@@ -77,9 +82,10 @@ func OptionalAuthMiddleware(jwtService *jwt.JWTService) gin.HandlerFunc {
 		tokenParts := strings.Split(authHeader, " ")
 		if len(tokenParts) == 2 && tokenParts[0] == "Bearer" {
 			token := tokenParts[1]
-			if claims, err := jwtService.ValidateToken(token); err == nil {
+			if claims, err := jwtService.ValidateAccessToken(token); err == nil {
 				c.Set("user_id", claims.UserID)
 				c.Set("user_email", claims.Email)
+				c.Set("user_role", user.RoleFromString(claims.Role))
 			}
 		}
 
@@ -87,6 +93,22 @@ func OptionalAuthMiddleware(jwtService *jwt.JWTService) gin.HandlerFunc {
 	}
 }
 
+// RequireRole - 토큰의 역할이 required 이상인 요청만 통과 (AuthMiddleware 뒤에 사용)
+//
+// 토큰의 역할은 발급 시점 기준이므로 usecase에서 저장된 역할로 한 번 더 확인한다.
+func RequireRole(required user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, ok := GetCurrentUserRole(c)
+		if !ok || !role.AtLeast(required) {
+			response.Forbidden(c, "이 기능을 사용할 권한이 없습니다")
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
 // GetCurrentUserID - 현재 인증된 사용자 ID 가져오기
 func GetCurrentUserID(c *gin.Context) (uint, bool) {
 	userID, exists := c.Get("user_id")
@@ -108,3 +130,14 @@ func GetCurrentUserEmail(c *gin.Context) (string, bool) {
 	email, ok := userEmail.(string)
 	return email, ok
 }
+
+// GetCurrentUserRole - 현재 인증된 사용자 역할 가져오기 (토큰 발급 시점 기준)
+func GetCurrentUserRole(c *gin.Context) (user.Role, bool) {
+	userRole, exists := c.Get("user_role")
+	if !exists {
+		return user.RoleUser, false
+	}
+
+	role, ok := userRole.(user.Role)
+	return role, ok
+}
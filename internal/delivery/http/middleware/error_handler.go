@@ -2,14 +2,15 @@ package middleware
 
 import (
 	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
 	"github.com/chris910512/travel-chat/internal/usecase/errors"
 	"github.com/gin-gonic/gin"
-	"log"
 )
 
 // ErrorHandler - 전역 에러 처리 미들웨어
 func ErrorHandler() gin.HandlerFunc {
 	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
+		logger.FromContext(c.Request.Context()).Error("panic recovered", "panic", recovered)
 		if err, ok := recovered.(error); ok {
 			HandleError(c, err)
 		} else {
@@ -21,7 +22,7 @@ func ErrorHandler() gin.HandlerFunc {
 
 // HandleError - 에러 타입별 처리
 func HandleError(c *gin.Context, err error) {
-	log.Printf("Error occurred: %v", err)
+	logger.FromContext(c.Request.Context()).Warn("request failed", "error", err)
 
 	switch {
 	case errors.IsUserNotFound(err):
@@ -32,6 +33,10 @@ func HandleError(c *gin.Context, err error) {
 		response.Unauthorized(c, err.Error())
 	case errors.IsWeakPassword(err):
 		response.BadRequest(c, err.Error())
+	case errors.IsIncorrectPassword(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsSamePassword(err):
+		response.BadRequest(c, err.Error())
 	case errors.IsInvalidEmail(err):
 		response.BadRequest(c, err.Error())
 	case errors.IsInvalidTravelDates(err):
@@ -42,6 +47,61 @@ func HandleError(c *gin.Context, err error) {
 		response.Unauthorized(c, err.Error())
 	case errors.IsForbidden(err):
 		response.Forbidden(c, err.Error())
+	case errors.IsAccountLocked(err):
+		until, _ := errors.LockedUntil(err)
+		response.Locked(c, err.Error(), until)
+	case errors.IsInvalidRefreshToken(err):
+		response.Unauthorized(c, err.Error())
+	case errors.IsRefreshTokenReused(err):
+		response.Unauthorized(c, err.Error())
+	case errors.IsInvalidResetToken(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsEmailNotVerified(err):
+		response.Forbidden(c, err.Error())
+	case errors.IsEmailAlreadyVerified(err):
+		response.Conflict(c, err.Error())
+	case errors.IsInvalidVerificationToken(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsChatRoomNotFound(err):
+		response.NotFound(c, err.Error())
+	case errors.IsEmptyMessage(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsMessageRejected(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsDestinationNotSet(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsCannotChatWithSelf(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsInvalidRole(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsInvalidUserStatus(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsPurgeFilterRequired(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsUserAlreadySuspended(err):
+		response.Conflict(c, err.Error())
+	case errors.IsUserNotSuspended(err):
+		response.Conflict(c, err.Error())
+	case errors.IsCannotBlockSelf(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsUserAlreadyBlocked(err):
+		response.Conflict(c, err.Error())
+	case errors.IsUserNotBlocked(err):
+		response.Conflict(c, err.Error())
+	case errors.IsUserBlocked(err):
+		response.Forbidden(c, err.Error())
+	case errors.IsCannotReportSelf(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsInvalidReportReason(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsInvalidReportDescription(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsMessageNotFound(err):
+		response.NotFound(c, err.Error())
+	case errors.IsImageRejected(err):
+		response.BadRequest(c, err.Error())
+	case errors.IsImageNotFound(err):
+		response.NotFound(c, err.Error())
 	default:
 		response.InternalServerError(c, "서버 내부 오류가 발생했습니다", err.Error())
 	}
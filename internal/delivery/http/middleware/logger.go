@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestID - X-Request-ID 헤더를 이어받거나 새로 만들어 요청 컨텍스트와 응답 헤더에 설정
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := logger.RequestIDOrNew(c.GetHeader(logger.RequestIDHeader))
+
+		c.Request = c.Request.WithContext(logger.NewContext(c.Request.Context(), requestID))
+		c.Header(logger.RequestIDHeader, requestID)
+
+		c.Next()
+	}
+}
+
+// RequestLogger - 요청 하나당 한 줄의 구조화된 접근 로그 기록 (gin.Logger 대체)
+func RequestLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+
+		ctx := c.Request.Context()
+		logger.FromContext(ctx).LogAttrs(ctx, level, "http request",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("client_ip", c.ClientIP()),
+			slog.Int("response_size", c.Writer.Size()),
+		)
+	}
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics - 라우트별 요청 수와 응답 시간 기록 미들웨어
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		// 경로 파라미터별로 지표가 늘어나지 않도록 라우트 템플릿 사용
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.ObserveHTTPRequest(c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitKey - 요청 수를 함께 세는 단위 (버킷 키)
+type RateLimitKey func(c *gin.Context) string
+
+// ByIP - 클라이언트 IP 기준
+func ByIP(c *gin.Context) string {
+	return ratelimit.IPKey(c.ClientIP())
+}
+
+// ByUser - 인증된 사용자 기준 (AuthMiddleware 뒤에서 사용, 인증 정보가 없으면 IP 기준)
+func ByUser(c *gin.Context) string {
+	if userID := c.GetUint("user_id"); userID != 0 {
+		return ratelimit.UserKey(userID)
+	}
+	return ByIP(c)
+}
+
+// ByRoute - 경로마다 버킷을 따로 두는 키 (예: ByRoute(ByIP))
+func ByRoute(key RateLimitKey) RateLimitKey {
+	return func(c *gin.Context) string {
+		return ratelimit.RouteKey(c.Request.Method+" "+c.FullPath(), key(c))
+	}
+}
+
+// RateLimit - 정책 한도를 넘은 요청은 429와 Retry-After로 거부
+func RateLimit(limiter *ratelimit.Limiter, policy ratelimit.Policy, key RateLimitKey) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		result := limiter.Allow(c.Request.Context(), policy, key(c))
+		if !result.Allowed {
+			retryAfter := ratelimit.RetryAfterSeconds(result.RetryAfter)
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			response.TooManyRequests(c, fmt.Sprintf("요청이 너무 많습니다. %d초 후 다시 시도해주세요", retryAfter))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
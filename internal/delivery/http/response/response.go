@@ -1,8 +1,12 @@
 package response
 
 import (
-	"github.com/gin-gonic/gin"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
 )
 
 // APIResponse - 표준 API 응답 구조체
@@ -108,6 +112,33 @@ func Conflict(c *gin.Context, message string) {
 	})
 }
 
+func TooManyRequests(c *gin.Context, message string) {
+	c.JSON(http.StatusTooManyRequests, APIResponse{
+		Success: false,
+		Message: "요청이 너무 많습니다",
+		Error: &ErrorInfo{
+			Code:    "TOO_MANY_REQUESTS",
+			Message: message,
+		},
+	})
+}
+
+// Locked - 계정 잠금 (잠금 해제 시각을 알면 Retry-After 헤더 포함)
+func Locked(c *gin.Context, message string, until time.Time) {
+	if !until.IsZero() {
+		retryAfter := int(math.Ceil(time.Until(until).Seconds()))
+		c.Header("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+	}
+	c.JSON(http.StatusLocked, APIResponse{
+		Success: false,
+		Message: "계정이 잠겼습니다",
+		Error: &ErrorInfo{
+			Code:    "ACCOUNT_LOCKED",
+			Message: message,
+		},
+	})
+}
+
 func InternalServerError(c *gin.Context, message string, details ...string) {
 	detail := ""
 	if len(details) > 0 {
@@ -1,30 +1,56 @@
 package router
 
 import (
+	"log/slog"
+
 	"github.com/chris910512/travel-chat/internal/delivery/http/handler"
 	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/websocket"
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
 	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 // SetupRoutes - 라우터 설정
 func SetupRoutes(
 	userHandler *handler.UserHandler,
+	chatHandler *handler.ChatHandler,
+	matchHandler *handler.MatchHandler,
+	presenceHandler *handler.PresenceHandler,
+	adminHandler *handler.AdminHandler,
+	safetyHandler *handler.SafetyHandler,
+	mediaHandler *handler.MediaHandler,
+	chatWSHandler *websocket.ChatHandler,
 	jwtService *jwt.JWTService,
+	limiter *ratelimit.Limiter,
+	trustedProxies []string,
 ) *gin.Engine {
-	// Gin 엔진 생성
-	r := gin.Default()
+	// Gin 엔진 생성 (gin.Default의 기본 Logger 대신 구조화된 접근 로그 사용)
+	r := gin.New()
+
+	// X-Forwarded-For는 신뢰하는 프록시를 거친 경우만 사용 (IP 기준 요청 제한 우회 방지)
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		slog.Error("invalid trusted proxies, ignoring proxy headers", "error", err)
+		r.SetTrustedProxies(nil)
+	}
 
-	// 전역 미들웨어 설정
+	// 전역 미들웨어 설정 (추적 스팬이 요청 컨텍스트에 먼저 들어가야 로그에 trace_id가 남음)
+	r.Use(otelgin.Middleware(tracing.ServiceName()))
+	r.Use(middleware.RequestID())
+	r.Use(middleware.RequestLogger())
+	r.Use(middleware.Metrics())
 	r.Use(middleware.ErrorHandler())
-	r.Use(gin.Logger())
 	r.Use(gin.Recovery())
 
 	// CORS 설정 (개발용)
 	r.Use(func(c *gin.Context) {
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-User-ID")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-User-ID, X-Request-ID, traceparent, tracestate")
+		c.Header("Access-Control-Expose-Headers", "X-Request-ID, Retry-After")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -34,37 +60,91 @@ func SetupRoutes(
 		c.Next()
 	})
 
-	// API 라우트 그룹
+	// API 라우트 그룹 (경로별 IP 기준 요청 수 제한)
 	api := r.Group("/api")
+	api.Use(middleware.RateLimit(limiter, ratelimit.PolicyAPI, middleware.ByRoute(middleware.ByIP)))
 	{
 		// Health Check
 		api.GET("/health", userHandler.HealthCheck)
 
-		// Auth 라우트 (인증 불필요)
+		// 업로드한 이미지 (추측할 수 없는 ID로만 접근, 인증 불필요)
+		api.GET("/blobs/:id/:variant", mediaHandler.GetBlob)
+
+		// WebSocket 채팅 (토큰은 헤더 또는 token 쿼리 파라미터로 전달)
+		api.GET("/ws", chatWSHandler.ServeWS)
+
+		// Auth 라우트 (로그아웃 외 인증 불필요)
 		authRoutes := api.Group("/auth")
 		{
-			authRoutes.POST("/register", userHandler.Register)
-			authRoutes.POST("/login", userHandler.Login)
+			authRoutes.POST("/register", middleware.RateLimit(limiter, ratelimit.PolicyRegister, middleware.ByIP), userHandler.Register)
+			authRoutes.POST("/login", middleware.RateLimit(limiter, ratelimit.PolicyLogin, middleware.ByIP), userHandler.Login)
 			authRoutes.POST("/refresh", userHandler.RefreshToken)
+
+			// 비밀번호 재설정 (메일 발송 남용을 막기 위해 로그인과 같은 한도 사용)
+			authRoutes.POST("/password/forgot", middleware.RateLimit(limiter, ratelimit.PolicyLogin, middleware.ByIP), userHandler.ForgotPassword)
+			authRoutes.POST("/password/reset", middleware.RateLimit(limiter, ratelimit.PolicyLogin, middleware.ByIP), userHandler.ResetPassword)
+
+			// 이메일 인증 (재발송은 로그인 필요, 메일 발송 남용을 막기 위해 로그인과 같은 한도 사용)
+			authRoutes.POST("/email/verify", middleware.RateLimit(limiter, ratelimit.PolicyLogin, middleware.ByIP), userHandler.VerifyEmail)
+			authRoutes.POST("/email/resend", middleware.AuthMiddleware(jwtService), middleware.RateLimit(limiter, ratelimit.PolicyLogin, middleware.ByIP), userHandler.ResendVerification)
+
+			// 로그아웃 (인증 필요)
+			authRoutes.POST("/logout", middleware.AuthMiddleware(jwtService), userHandler.Logout)
+			authRoutes.POST("/logout-all", middleware.AuthMiddleware(jwtService), userHandler.LogoutAll)
 		}
 
 		// 사용자 관련 라우트
 		userRoutes := api.Group("/users")
 		{
-			// 사용자 조회 (공개)
-			userRoutes.GET("", userHandler.GetUsers)
+			// 사용자 조회 (공개, 로그인한 경우 차단 관계인 사용자 제외)
+			userRoutes.GET("", middleware.OptionalAuthMiddleware(jwtService), userHandler.GetUsers)
+			userRoutes.GET("/active", presenceHandler.GetActiveUsers)
 			userRoutes.GET("/:id", userHandler.GetProfile)
-			userRoutes.GET("/destination/:country/:city", userHandler.GetUsersByDestination)
+			userRoutes.GET("/:id/presence", presenceHandler.GetPresence)
+			userRoutes.GET("/destination/:country/:city", middleware.OptionalAuthMiddleware(jwtService), userHandler.GetUsersByDestination)
 
 			// 인증 필요한 엔드포인트
 			authenticated := userRoutes.Group("/").Use(middleware.AuthMiddleware(jwtService))
 			{
 				authenticated.GET("/me", userHandler.GetMe)
+				authenticated.GET("/me/matches", matchHandler.GetMyMatches)
+				authenticated.GET("/me/logins", userHandler.GetMyLogins)
+				authenticated.PUT("/me/password", userHandler.ChangePassword)
+				authenticated.POST("/me/avatar", middleware.RateLimit(limiter, ratelimit.PolicyUpload, middleware.ByUser), mediaHandler.UploadAvatar)
+				authenticated.DELETE("/me/avatar", mediaHandler.DeleteAvatar)
+				authenticated.POST("/me/heartbeat", presenceHandler.Heartbeat)
+				authenticated.GET("/me/blocks", safetyHandler.ListBlockedUsers)
+				authenticated.POST("/:id/block", safetyHandler.BlockUser)
+				authenticated.DELETE("/:id/block", safetyHandler.UnblockUser)
+				authenticated.POST("/:id/report", safetyHandler.ReportUser)
 				authenticated.PUT("/:id", userHandler.UpdateProfile)
 				authenticated.POST("/:id/activity", userHandler.UpdateLastActive)
 				authenticated.DELETE("/:id", userHandler.DeleteUser)
 			}
 		}
+
+		// 채팅 관련 라우트 (인증 필요)
+		chatRoutes := api.Group("/chat").Use(middleware.AuthMiddleware(jwtService))
+		{
+			chatRoutes.GET("/rooms", chatHandler.ListRooms)
+			chatRoutes.POST("/rooms/public", chatHandler.JoinPublicRoom)
+			chatRoutes.POST("/rooms/private", chatHandler.OpenPrivateRoom)
+			chatRoutes.GET("/rooms/:id/messages", chatHandler.GetHistory)
+			chatRoutes.POST("/rooms/:id/messages", middleware.RateLimit(limiter, ratelimit.PolicyMessage, middleware.ByUser), chatHandler.SendMessage)
+			chatRoutes.POST("/images", middleware.RateLimit(limiter, ratelimit.PolicyUpload, middleware.ByUser), mediaHandler.UploadImage)
+		}
+
+		// 관리 라우트 (운영자 이상, 역할 변경은 관리자만)
+		adminRoutes := api.Group("/admin").Use(middleware.AuthMiddleware(jwtService), middleware.RequireRole(user.RoleModerator))
+		{
+			adminRoutes.GET("/users", adminHandler.ListUsers)
+			adminRoutes.POST("/users/:id/suspend", adminHandler.SuspendUser)
+			adminRoutes.POST("/users/:id/restore", adminHandler.RestoreUser)
+			adminRoutes.PUT("/users/:id/role", middleware.RequireRole(user.RoleAdmin), adminHandler.UpdateUserRole)
+			adminRoutes.DELETE("/rooms/:id", adminHandler.DeleteRoom)
+			adminRoutes.DELETE("/messages", adminHandler.PurgeMessages)
+			adminRoutes.GET("/reports", adminHandler.ListReports)
+		}
 	}
 
 	return r
@@ -0,0 +1,141 @@
+package websocket
+
+import (
+	"context"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/delivery/chathub"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// 클라이언트로 메시지를 쓰는 데 허용되는 시간
+	writeWait = 10 * time.Second
+
+	// 클라이언트의 pong 응답을 기다리는 시간
+	pongWait = 60 * time.Second
+
+	// ping 전송 주기 (pongWait보다 짧아야 함)
+	pingPeriod = (pongWait * 9) / 10
+
+	// 클라이언트 메시지 최대 크기 (바이트)
+	maxFrameSize = 8 * 1024
+)
+
+// Client - WebSocket 연결 하나 (사용자 한 명의 세션)
+type Client struct {
+	hubManager      *chathub.Manager
+	sub             *chathub.Subscriber
+	chatUsecase     usecaseInterface.ChatUsecase
+	presenceUsecase usecaseInterface.PresenceUsecase
+	limiter         *ratelimit.Limiter
+	conn            *websocket.Conn
+	ctx             context.Context // 연결 요청의 요청 ID/사용자 ID (로그용, 취소되지 않음)
+}
+
+// newClient - Client 생성자
+func newClient(
+	hubManager *chathub.Manager,
+	sub *chathub.Subscriber,
+	chatUsecase usecaseInterface.ChatUsecase,
+	presenceUsecase usecaseInterface.PresenceUsecase,
+	limiter *ratelimit.Limiter,
+	conn *websocket.Conn,
+	ctx context.Context,
+) *Client {
+	return &Client{
+		hubManager:      hubManager,
+		sub:             sub,
+		chatUsecase:     chatUsecase,
+		presenceUsecase: presenceUsecase,
+		limiter:         limiter,
+		conn:            conn,
+		ctx:             context.WithoutCancel(ctx),
+	}
+}
+
+// readPump - 클라이언트 메시지를 읽어 채팅방으로 전달하는 고루틴
+func (c *Client) readPump() {
+	defer func() {
+		c.hubManager.Leave(c.sub)
+		c.presenceUsecase.Disconnect(c.ctx, c.sub.UserID())
+		c.conn.Close()
+		metrics.WebSocketDisconnected()
+	}()
+
+	c.conn.SetReadLimit(maxFrameSize)
+	c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		c.conn.SetReadDeadline(time.Now().Add(pongWait))
+		return nil
+	})
+
+	roomID := c.sub.Room().ID
+	for {
+		var event IncomingEvent
+		if err := c.conn.ReadJSON(&event); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				logger.FromContext(c.ctx).Warn("websocket read error", "error", err)
+			}
+			return
+		}
+
+		switch event.Type {
+		case EventTypeHeartbeat:
+			c.presenceUsecase.RecordActivity(c.ctx, c.sub.UserID())
+			continue
+		case chathub.EventTypeMessage:
+			c.presenceUsecase.RecordActivity(c.ctx, c.sub.UserID())
+		default:
+			c.sub.Notify(chathub.NewErrorEvent(roomID, "지원하지 않는 이벤트 타입입니다"))
+			continue
+		}
+
+		// 사용자별 메시지 전송 한도 (초과한 메시지는 저장하지 않고 안내만 전송)
+		if result := c.limiter.Allow(c.ctx, ratelimit.PolicyMessage, ratelimit.UserKey(c.sub.UserID())); !result.Allowed {
+			c.sub.Notify(chathub.NewErrorEvent(roomID, chathub.RateLimitedMessage(result.RetryAfter)))
+			continue
+		}
+
+		// 저장 후 chathub.Manager(MessagePublisher)를 통해 채팅방 전체에 전달됨
+		req := &dto.SendMessageRequest{Content: event.Content, ImageID: event.ImageID}
+		if _, err := c.chatUsecase.SendMessage(c.ctx, c.sub.UserID(), roomID, req); err != nil {
+			c.sub.Notify(chathub.NewErrorEvent(roomID, chathub.ErrorMessage(c.ctx, err)))
+		}
+	}
+}
+
+// writePump - Hub에서 받은 이벤트를 클라이언트로 전송하는 고루틴
+func (c *Client) writePump() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case event, ok := <-c.sub.Events():
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				// Hub가 송신 채널을 닫음
+				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteJSON(event); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package websocket
+
+// 클라이언트가 보내는 이벤트 타입 (메시지는 chathub.EventTypeMessage)
+const (
+	EventTypeHeartbeat = "heartbeat" // 사용자 활동 알림 (접속 상태 유지)
+)
+
+// IncomingEvent - 클라이언트가 보내는 이벤트 (서버가 보내는 이벤트는 chathub.Event)
+type IncomingEvent struct {
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	ImageID string `json:"image_id,omitempty"` // POST /api/chat/images로 올린 이미지 ID (이미지 메시지)
+}
@@ -0,0 +1,143 @@
+package websocket
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chris910512/travel-chat/internal/delivery/chathub"
+	"github.com/chris910512/travel-chat/internal/delivery/http/middleware"
+	"github.com/chris910512/travel-chat/internal/delivery/http/response"
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/ratelimit"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+// ChatHandler - WebSocket 채팅 핸들러
+type ChatHandler struct {
+	upgrader        websocket.Upgrader
+	hubManager      *chathub.Manager
+	chatUsecase     usecaseInterface.ChatUsecase
+	userUsecase     usecaseInterface.UserUsecase
+	presenceUsecase usecaseInterface.PresenceUsecase
+	jwtService      *jwt.JWTService
+	limiter         *ratelimit.Limiter
+}
+
+// NewChatHandler - WebSocket 채팅 핸들러 생성자
+func NewChatHandler(
+	hubManager *chathub.Manager,
+	chatUsecase usecaseInterface.ChatUsecase,
+	userUsecase usecaseInterface.UserUsecase,
+	presenceUsecase usecaseInterface.PresenceUsecase,
+	jwtService *jwt.JWTService,
+	limiter *ratelimit.Limiter,
+) *ChatHandler {
+	return &ChatHandler{
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			// CORS 설정과 동일하게 모든 Origin 허용 (개발용)
+			CheckOrigin: func(r *http.Request) bool { return true },
+		},
+		hubManager:      hubManager,
+		chatUsecase:     chatUsecase,
+		userUsecase:     userUsecase,
+		presenceUsecase: presenceUsecase,
+		jwtService:      jwtService,
+		limiter:         limiter,
+	}
+}
+
+// ServeWS - 채팅방 WebSocket 연결
+// GET /api/ws?token=<access_token>&room_id=<optional>
+func (h *ChatHandler) ServeWS(c *gin.Context) {
+	// 브라우저는 WebSocket 요청에 헤더를 지정할 수 없으므로 쿼리 파라미터도 허용
+	token := extractToken(c)
+	if token == "" {
+		response.Unauthorized(c, "인증 토큰이 필요합니다")
+		return
+	}
+
+	claims, err := h.jwtService.ValidateAccessToken(token)
+	if err != nil {
+		response.Unauthorized(c, "토큰이 유효하지 않거나 만료되었습니다")
+		return
+	}
+
+	ctx := c.Request.Context()
+	logger.SetUserID(ctx, claims.UserID)
+
+	userResp, err := h.userUsecase.GetByID(ctx, claims.UserID)
+	if err != nil {
+		middleware.HandleError(c, err)
+		return
+	}
+
+	// room_id가 없으면 내 목적지 전체 채팅방, 있으면 접근 가능한 채팅방(1:1 포함)에 접속
+	var room *dto.ChatRoomResponse
+	if roomIDParam := c.Query("room_id"); roomIDParam != "" {
+		roomID, parseErr := strconv.ParseUint(roomIDParam, 10, 32)
+		if parseErr != nil {
+			response.BadRequest(c, "올바르지 않은 채팅방 ID입니다")
+			return
+		}
+		room, err = h.chatUsecase.GetRoom(ctx, userResp.ID, uint(roomID))
+	} else {
+		room, err = h.chatUsecase.JoinPublicRoom(ctx, userResp.ID)
+	}
+	if err != nil {
+		middleware.HandleError(c, err)
+		return
+	}
+
+	// 차단한 사용자의 메시지는 실시간으로도 전달하지 않음
+	blockedIDs, err := h.chatUsecase.GetBlockedUserIDs(ctx, userResp.ID)
+	if err != nil {
+		middleware.HandleError(c, err)
+		return
+	}
+
+	// 접속 상태 등록 (이메일 인증은 여기서 한 번만 확인, 이후 실패하면 해제)
+	if err := h.presenceUsecase.Connect(ctx, userResp.ID); err != nil {
+		middleware.HandleError(c, err)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// Upgrade가 이미 에러 응답을 작성함
+		logger.FromContext(ctx).Warn("websocket upgrade failed", "error", err)
+		h.presenceUsecase.Disconnect(ctx, userResp.ID)
+		return
+	}
+
+	sub := h.hubManager.Join(room, userResp.ID, userResp.Name, blockedIDs)
+	if sub == nil {
+		conn.WriteMessage(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down"))
+		conn.Close()
+		h.presenceUsecase.Disconnect(ctx, userResp.ID)
+		return
+	}
+
+	metrics.WebSocketConnected()
+
+	client := newClient(h.hubManager, sub, h.chatUsecase, h.presenceUsecase, h.limiter, conn, ctx)
+	go client.writePump()
+	go client.readPump()
+}
+
+// extractToken - Authorization 헤더 또는 token 쿼리 파라미터에서 토큰 추출
+func extractToken(c *gin.Context) string {
+	authHeader := c.GetHeader("Authorization")
+	if strings.HasPrefix(authHeader, "Bearer ") {
+		return strings.TrimPrefix(authHeader, "Bearer ")
+	}
+	return c.Query("token")
+}
@@ -0,0 +1,71 @@
+package blob
+
+import "time"
+
+// Kind - 저장한 파일의 용도
+type Kind string
+
+const (
+	KindImage  Kind = "image"  // 채팅 이미지 메시지
+	KindAvatar Kind = "avatar" // 프로필 사진
+)
+
+// 저장하는 크기별 파일 (저장소 키의 마지막 부분)
+const (
+	VariantOriginal  = "original"  // 긴 변을 제한해 다시 인코딩한 이미지
+	VariantThumbnail = "thumbnail" // 목록/미리보기용 작은 이미지
+)
+
+// 프로필 사진 크기 (모두 정사각형)
+const (
+	VariantSmall  = "small"
+	VariantMedium = "medium"
+	VariantLarge  = "large"
+)
+
+// Blob - 업로드한 파일의 메타데이터 (내용은 저장소에 Key(variant)로 저장)
+//
+// ID는 추측할 수 없는 임의 값이라 URL에 그대로 노출한다.
+type Blob struct {
+	ID          string     `gorm:"primaryKey;size:32" json:"id"`
+	OwnerID     uint       `gorm:"not null;index" json:"owner_id"`
+	Kind        Kind       `gorm:"size:16;not null" json:"kind"`
+	ContentType string     `gorm:"size:32;not null" json:"content_type"`
+	Size        int64      `gorm:"not null" json:"size"` // 원본 크기 (바이트)
+	Width       int        `gorm:"not null" json:"width"`
+	Height      int        `gorm:"not null" json:"height"`
+	ExpiresAt   *time.Time `gorm:"index" json:"expires_at"` // 이후 정리 작업이 파일과 함께 삭제 (nil이면 보관)
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// Variants - 용도별로 저장하는 파일 목록
+func (b *Blob) Variants() []string {
+	switch b.Kind {
+	case KindImage:
+		return []string{VariantOriginal, VariantThumbnail}
+	case KindAvatar:
+		return []string{VariantSmall, VariantMedium, VariantLarge}
+	default:
+		return nil
+	}
+}
+
+// HasVariant - 저장한 크기인지 확인
+func (b *Blob) HasVariant(variant string) bool {
+	for _, v := range b.Variants() {
+		if v == variant {
+			return true
+		}
+	}
+	return false
+}
+
+// Key - 저장소 키 ("image/{id}/thumbnail", "avatar/{id}/small" 형식)
+func (b *Blob) Key(variant string) string {
+	return string(b.Kind) + "/" + b.ID + "/" + variant
+}
+
+// IsExpired - 만료되었는지 확인
+func (b *Blob) IsExpired() bool {
+	return b.ExpiresAt != nil && time.Now().After(*b.ExpiresAt)
+}
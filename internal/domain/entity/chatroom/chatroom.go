@@ -8,10 +8,11 @@ import (
 
 type ChatRoom struct {
 	ID        uint           `gorm:"primarykey" json:"id"`
-	Country   string         `gorm:"not null;size:100" json:"country"` // 국가
-	City      string         `gorm:"not null;size:100" json:"city"`    // 도시
+	Country   string         `gorm:"not null;size:100;index:idx_chat_rooms_country_city" json:"country"` // 국가
+	City      string         `gorm:"not null;size:100;index:idx_chat_rooms_country_city" json:"city"`    // 도시
 	RoomType  RoomType       `gorm:"not null;default:0" json:"room_type"`
-	Name      string         `gorm:"size:200" json:"name"` // 채팅방 이름 (1:1의 경우 자동 생성)
+	Name      string         `gorm:"size:200" json:"name"`         // 채팅방 이름 (1:1의 경우 자동 생성)
+	PairKey   *string        `gorm:"uniqueIndex;size:64" json:"-"` // 1:1 채팅방 사용자 쌍 키 (중복 생성 방지)
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
@@ -32,6 +33,24 @@ func (c *ChatRoom) IsPrivate() bool {
 	return c.RoomType == RoomTypePrivate
 }
 
+// PrivatePartnerID - 1:1 채팅방에서 userID의 상대 사용자 ID (1:1 채팅방이 아니거나 참여자가 아니면 false)
+func (c *ChatRoom) PrivatePartnerID(userID uint) (uint, bool) {
+	if !c.IsPrivate() || c.PairKey == nil {
+		return 0, false
+	}
+	userID1, userID2, ok := ParsePrivatePairKey(*c.PairKey)
+	switch {
+	case !ok:
+		return 0, false
+	case userID == userID1:
+		return userID2, true
+	case userID == userID2:
+		return userID1, true
+	default:
+		return 0, false
+	}
+}
+
 // GeneratePublicRoomName - 전체 채팅방 이름 생성
 func (c *ChatRoom) GeneratePublicRoomName() {
 	c.Name = c.Country + " " + c.City + " 여행자 채팅"
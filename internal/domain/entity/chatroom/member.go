@@ -0,0 +1,41 @@
+package chatroom
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ChatRoomMember - 채팅방 참여자 (1:1 채팅방의 두 사용자)
+type ChatRoomMember struct {
+	ID         uint      `gorm:"primarykey" json:"id"`
+	ChatRoomID uint      `gorm:"not null;uniqueIndex:idx_chat_room_members_room_user" json:"chat_room_id"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_chat_room_members_room_user;index" json:"user_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// PrivatePairKey - 두 사용자의 1:1 채팅방 고유 키 생성 (순서와 무관하게 동일)
+func PrivatePairKey(userID1, userID2 uint) string {
+	if userID1 > userID2 {
+		userID1, userID2 = userID2, userID1
+	}
+	return fmt.Sprintf("%d:%d", userID1, userID2)
+}
+
+// ParsePrivatePairKey - 1:1 채팅방 키에서 두 사용자 ID 추출
+func ParsePrivatePairKey(key string) (uint, uint, bool) {
+	first, second, ok := strings.Cut(key, ":")
+	if !ok {
+		return 0, 0, false
+	}
+	userID1, err := strconv.ParseUint(first, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	userID2, err := strconv.ParseUint(second, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return uint(userID1), uint(userID2), true
+}
@@ -49,3 +49,11 @@ func MessageTypeFromString(s string) MessageType {
 		return MessageTypeText
 	}
 }
+
+// Moderation - 전송 전 검사 결과 (거부된 메시지는 저장하지 않음)
+type Moderation string
+
+const (
+	ModerationAllowed Moderation = "allowed" // 그대로 저장
+	ModerationMasked  Moderation = "masked"  // 일부를 가려서 저장
+)
@@ -9,10 +9,13 @@ type Message struct {
 	ID          uint           `gorm:"primarykey" json:"id"`
 	Content     string         `gorm:"not null;type:text" json:"content"`
 	UserID      uint           `gorm:"not null" json:"user_id"`
-	ChatRoomID  uint           `gorm:"not null" json:"chat_room_id"`
+	ChatRoomID  uint           `gorm:"not null;index:idx_messages_chat_room_id_created_at" json:"chat_room_id"`
 	MessageType MessageType    `gorm:"default:0" json:"message_type"`
-	ExpiresAt   *time.Time     `json:"expires_at"` // 메시지 만료 시간 (nullable)
-	CreatedAt   time.Time      `json:"created_at"`
+	Moderation  Moderation     `gorm:"size:16;not null;default:allowed" json:"moderation"` // 전송 전 검사 결과
+	ModeratedBy string         `gorm:"size:100;not null;default:''" json:"moderated_by"`   // 내용을 가린 검사 단계 (쉼표 구분)
+	BlobID      *string        `gorm:"size:32" json:"blob_id,omitempty"`                   // 이미지 메시지의 첨부 파일 (content는 설명)
+	ExpiresAt   *time.Time     `gorm:"index" json:"expires_at"`                            // 메시지 만료 시간 (nullable)
+	CreatedAt   time.Time      `gorm:"index:idx_messages_chat_room_id_created_at" json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 }
@@ -30,13 +33,3 @@ func (m *Message) SetExpiration(duration time.Duration) {
 	expiresAt := m.CreatedAt.Add(duration)
 	m.ExpiresAt = &expiresAt
 }
-
-// SetPublicChatExpiration - 전체 채팅 만료 시간 설정 (6시간)
-func (m *Message) SetPublicChatExpiration() {
-	m.SetExpiration(6 * time.Hour)
-}
-
-// SetPrivateChatExpiration - 1:1 채팅 만료 시간 설정 (24시간)
-func (m *Message) SetPrivateChatExpiration() {
-	m.SetExpiration(24 * time.Hour)
-}
@@ -0,0 +1,23 @@
+package token
+
+import "time"
+
+// EmailVerificationToken - 이메일 인증 토큰 기록 (원문 대신 SHA-256 해시 저장, 1회용)
+type EmailVerificationToken struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	TokenHash string     `gorm:"not null;uniqueIndex;size:64" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"` // 사용했거나 재발송으로 무효화된 시각
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// IsExpired - 만료 여부 확인
+func (t *EmailVerificationToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsUsed - 사용(또는 무효화) 여부 확인
+func (t *EmailVerificationToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
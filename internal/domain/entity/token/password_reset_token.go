@@ -0,0 +1,23 @@
+package token
+
+import "time"
+
+// PasswordResetToken - 비밀번호 재설정 토큰 기록 (원문 대신 SHA-256 해시 저장, 1회용)
+type PasswordResetToken struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	TokenHash string     `gorm:"not null;uniqueIndex;size:64" json:"-"`
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"` // 사용했거나 새 토큰 발급으로 무효화된 시각
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// IsExpired - 만료 여부 확인
+func (t *PasswordResetToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsUsed - 사용(또는 무효화) 여부 확인
+func (t *PasswordResetToken) IsUsed() bool {
+	return t.UsedAt != nil
+}
@@ -0,0 +1,30 @@
+package token
+
+import "time"
+
+// RefreshToken - 발급된 리프레시 토큰 기록 (JTI 기준, 토큰 원문은 저장하지 않음)
+type RefreshToken struct {
+	ID         uint       `gorm:"primarykey" json:"id"`
+	JTI        string     `gorm:"not null;uniqueIndex;size:64" json:"jti"`
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	FamilyID   string     `gorm:"not null;index;size:64" json:"family_id"` // 로그인 1회로 시작된 토큰 계열 (갱신해도 유지)
+	ReplacedBy string     `gorm:"size:64" json:"replaced_by"`              // 갱신으로 발급된 다음 토큰의 JTI
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// IsExpired - 만료 여부 확인
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// IsRevoked - 폐기 여부 확인 (갱신 또는 로그아웃)
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsRotated - 이미 갱신에 사용된 토큰인지 확인 (다시 사용되면 탈취로 간주)
+func (t *RefreshToken) IsRotated() bool {
+	return t.ReplacedBy != ""
+}
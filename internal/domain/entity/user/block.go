@@ -0,0 +1,11 @@
+package user
+
+import "time"
+
+// UserBlock - 사용자 차단 (차단한 사용자에게 상대가 탐색/매칭/메시지에서 보이지 않고, 서로 1:1 채팅을 시작할 수 없음)
+type UserBlock struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	BlockerID uint      `gorm:"not null;uniqueIndex:idx_user_blocks_blocker_id_blocked_id" json:"blocker_id"`
+	BlockedID uint      `gorm:"not null;uniqueIndex:idx_user_blocks_blocker_id_blocked_id;index" json:"blocked_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -152,3 +152,59 @@ func TravelStyleFromString(s string) TravelStyle {
 	}
 	return TravelStylePlanned
 }
+
+// Role - 사용자 역할 (값이 클수록 권한이 높음)
+type Role int
+
+const (
+	RoleUser      Role = iota // 일반 사용자
+	RoleModerator             // 운영자 (사용자 정지/복구, 채팅방·메시지 정리)
+	RoleAdmin                 // 관리자 (운영자 권한 + 역할 변경)
+)
+
+func (r *Role) String() string {
+	switch *r {
+	case RoleUser:
+		return "user"
+	case RoleModerator:
+		return "moderator"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return "unknown"
+	}
+}
+
+func (r *Role) IsValid() bool {
+	return *r >= RoleUser && *r <= RoleAdmin
+}
+
+// AtLeast - required 이상의 역할인지 확인
+func (r *Role) AtLeast(required Role) bool {
+	return *r >= required
+}
+
+func (r *Role) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *Role) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	*r = RoleFromString(s)
+	return nil
+}
+
+// RoleFromString - 알 수 없는 값은 가장 낮은 권한(user)으로 처리
+func RoleFromString(s string) Role {
+	switch s {
+	case "moderator":
+		return RoleModerator
+	case "admin":
+		return RoleAdmin
+	default:
+		return RoleUser
+	}
+}
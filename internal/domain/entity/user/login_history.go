@@ -0,0 +1,23 @@
+package user
+
+import "time"
+
+// LoginFailureReason - 로그인 실패 사유
+type LoginFailureReason string
+
+const (
+	LoginFailureWrongPassword LoginFailureReason = "wrong_password" // 비밀번호 불일치
+	LoginFailureLocked        LoginFailureReason = "locked"         // 잠금 중 시도 (비밀번호 확인 안 함)
+)
+
+// LoginHistory - 로그인 시도 기록 (가입된 이메일에 대한 시도만 기록)
+type LoginHistory struct {
+	ID            uint               `gorm:"primarykey" json:"id"`
+	UserID        uint               `gorm:"not null;index:idx_login_histories_user_id_created_at" json:"user_id"`
+	IP            string             `gorm:"size:64" json:"ip"`
+	UserAgent     string             `gorm:"size:512" json:"user_agent"`
+	Success       bool               `gorm:"not null" json:"success"`
+	FailureReason LoginFailureReason `gorm:"size:32" json:"failure_reason"`
+	NewIP         bool               `gorm:"not null;default:false" json:"new_ip"` // 이전에 로그인한 적 없는 IP에서 성공 (이상 징후)
+	CreatedAt     time.Time          `gorm:"index:idx_login_histories_user_id_created_at" json:"created_at"`
+}
@@ -0,0 +1,37 @@
+package user
+
+import "time"
+
+// ReportReason - 신고 사유
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"          // 스팸/광고
+	ReportReasonHarassment    ReportReason = "harassment"    // 괴롭힘/욕설
+	ReportReasonInappropriate ReportReason = "inappropriate" // 음란물/혐오 등 부적절한 내용
+	ReportReasonScam          ReportReason = "scam"          // 사기/금전 요구
+	ReportReasonFakeProfile   ReportReason = "fake_profile"  // 허위 프로필/사칭
+	ReportReasonOther         ReportReason = "other"         // 기타 (설명 필요)
+)
+
+// IsValid - 정의된 신고 사유인지 확인
+func (r ReportReason) IsValid() bool {
+	switch r {
+	case ReportReasonSpam, ReportReasonHarassment, ReportReasonInappropriate,
+		ReportReasonScam, ReportReasonFakeProfile, ReportReasonOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// UserReport - 사용자 신고 (운영자가 관리자 API로 확인)
+type UserReport struct {
+	ID          uint         `gorm:"primarykey" json:"id"`
+	ReporterID  uint         `gorm:"not null;index" json:"reporter_id"`
+	ReportedID  uint         `gorm:"not null;index:idx_user_reports_reported_id_created_at" json:"reported_id"`
+	Reason      ReportReason `gorm:"size:32;not null" json:"reason"`
+	Description string       `gorm:"size:1000" json:"description"`
+	MessageID   *uint        `json:"message_id,omitempty"` // 문제가 된 메시지 (선택)
+	CreatedAt   time.Time    `gorm:"index:idx_user_reports_reported_id_created_at" json:"created_at"`
+}
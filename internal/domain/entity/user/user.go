@@ -13,9 +13,9 @@ type User struct {
 	Name          string         `gorm:"not null" json:"name"`
 	Age           int            `json:"age"`
 	Gender        Gender         `gorm:"default:0" json:"gender"`
-	ProfilePic    string         `json:"profile_pic"`
-	Country       string         `json:"country"` // 여행 국가
-	City          string         `json:"city"`    // 여행 도시
+	AvatarID      *string        `gorm:"size:32" json:"avatar_id"`                    // 업로드한 프로필 사진 (blob.KindAvatar)
+	Country       string         `gorm:"index:idx_users_country_city" json:"country"` // 여행 국가
+	City          string         `gorm:"index:idx_users_country_city" json:"city"`    // 여행 도시
 	TravelStart   time.Time      `json:"travel_start"`
 	TravelEnd     time.Time      `json:"travel_end"`
 	Bio           string         `gorm:"type:text" json:"bio"`
@@ -26,6 +26,14 @@ type User struct {
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 	DeletedAt     gorm.DeletedAt `gorm:"index" json:"-"`
+
+	FailedLoginAttempts int        `gorm:"not null;default:0" json:"-"` // 마지막 로그인 성공 이후 연속 실패 횟수
+	LockedUntil         *time.Time `json:"-"`                           // 이 시각까지 로그인 잠금
+
+	EmailVerified   bool       `gorm:"not null;default:false" json:"email_verified"` // 인증 전에는 탐색에서 제외되고 채팅 불가
+	EmailVerifiedAt *time.Time `json:"email_verified_at"`
+
+	Role Role `gorm:"not null;default:0" json:"role"`
 }
 
 // GetDestination - Key for ChatRoom of public chatroom type
@@ -58,3 +66,25 @@ func (u *User) GetActivityStatus() string {
 func (u *User) UpdateLastActive() {
 	u.LastActive = time.Now()
 }
+
+// IsSuspended - 관리자에 의해 정지(소프트 삭제)된 사용자인지 확인
+func (u *User) IsSuspended() bool {
+	return u.DeletedAt.Valid
+}
+
+// IsLocked - 로그인 잠금 여부 확인
+func (u *User) IsLocked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// ResetLoginFailures - 로그인 성공 시 실패 횟수와 잠금 초기화
+func (u *User) ResetLoginFailures() {
+	u.FailedLoginAttempts = 0
+	u.LockedUntil = nil
+}
+
+// MarkEmailVerified - 이메일 인증 완료 처리
+func (u *User) MarkEmailVerified(now time.Time) {
+	u.EmailVerified = true
+	u.EmailVerifiedAt = &now
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/blob"
+)
+
+type BlobRepository interface {
+	Create(ctx context.Context, b *blob.Blob) error
+	GetByID(ctx context.Context, id string) (*blob.Blob, error) // 없으면 gorm.ErrRecordNotFound
+	Delete(ctx context.Context, id string) error
+
+	// ExtendExpiration - 만료 시간이 until보다 이르면 until로 늦춤 (만료 시간이 없는 파일은 그대로)
+	ExtendExpiration(ctx context.Context, id string, until time.Time) error
+
+	// ListExpired - before 이전에 만료된 파일을 만료 순으로 최대 limit개 조회
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*blob.Blob, error)
+}
@@ -1,15 +1,19 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/chris910512/travel-chat/internal/domain/entity/chatroom"
 )
 
 type ChatRoomRepository interface {
-	Create(chatRoom *chatroom.ChatRoom) error
-	GetByID(id uint) (*chatroom.ChatRoom, error)
-	GetByLocation(country, city string, roomType chatroom.RoomType) (*chatroom.ChatRoom, error)
-	GetOrCreatePublicRoom(country, city string) (*chatroom.ChatRoom, error)
-	CreatePrivateRoom(country, city, user1Name, user2Name string) (*chatroom.ChatRoom, error)
-	Update(chatRoom *chatroom.ChatRoom) error
-	Delete(id uint) error
+	Create(ctx context.Context, chatRoom *chatroom.ChatRoom) error
+	GetByID(ctx context.Context, id uint) (*chatroom.ChatRoom, error)
+	GetByLocation(ctx context.Context, country, city string, roomType chatroom.RoomType) (*chatroom.ChatRoom, error)
+	GetOrCreatePublicRoom(ctx context.Context, country, city string) (*chatroom.ChatRoom, error)
+	GetOrCreatePrivateRoom(ctx context.Context, country, city string, user1ID, user2ID uint, user1Name, user2Name string) (*chatroom.ChatRoom, error)
+	IsMember(ctx context.Context, roomID, userID uint) (bool, error)
+	GetByMember(ctx context.Context, userID uint) ([]*chatroom.ChatRoom, error)
+	Update(ctx context.Context, chatRoom *chatroom.ChatRoom) error
+	Delete(ctx context.Context, id uint) error // 소프트 삭제 (1:1 채팅방은 같은 사용자 쌍이 다시 열 수 있도록 쌍 키 해제)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+)
+
+type EmailVerificationTokenRepository interface {
+	Create(ctx context.Context, verificationToken *token.EmailVerificationToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*token.EmailVerificationToken, error)
+	MarkUsedIfActive(ctx context.Context, id uint) (bool, error)
+	InvalidateAllByUser(ctx context.Context, userID uint) error
+}
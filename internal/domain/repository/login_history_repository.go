@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+)
+
+type LoginHistoryRepository interface {
+	Create(ctx context.Context, history *user.LoginHistory) error
+	ListByUser(ctx context.Context, userID uint, offset, limit int) ([]*user.LoginHistory, error) // 최근 기록부터
+	CountByUser(ctx context.Context, userID uint) (int64, error)
+	KnownIPs(ctx context.Context, userID uint) ([]string, error) // 로그인에 성공한 적 있는 IP 목록
+}
@@ -1,15 +1,21 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/chris910512/travel-chat/internal/domain/entity/message"
 	"time"
 )
 
 type MessageRepository interface {
-	Create(message *message.Message) error
-	GetByID(id uint) (*message.Message, error)
-	GetByChatRoom(chatRoomID uint, limit int) ([]*message.Message, error)
-	DeleteExpired() error
-	DeleteExpiredBefore(before time.Time) error
-	Count() (int64, error)
+	Create(ctx context.Context, message *message.Message) error
+	GetByID(ctx context.Context, id uint) (*message.Message, error)
+	GetByChatRoom(ctx context.Context, chatRoomID uint, excludeUserIDs []uint, limit int) ([]*message.Message, error) // excludeUserIDs가 보낸 메시지는 제외하고 최신순으로 limit개
+	DeleteExpired(ctx context.Context) error
+	DeleteExpiredBefore(ctx context.Context, before time.Time) error
+	DeleteExpiredBatch(ctx context.Context, before time.Time, batchSize int) (int64, error)
+	Count(ctx context.Context) (int64, error)
+
+	// Purge - 채팅방/작성자 조건에 맞는 메시지를 영구 삭제하고 삭제된 행 수 반환 (0인 조건은 무시)
+	Purge(ctx context.Context, chatRoomID, userID uint) (int64, error)
 }
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+)
+
+type PasswordResetTokenRepository interface {
+	Create(ctx context.Context, resetToken *token.PasswordResetToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*token.PasswordResetToken, error)
+	MarkUsedIfActive(ctx context.Context, id uint) (bool, error)
+	InvalidateAllByUser(ctx context.Context, userID uint) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, refreshToken *token.RefreshToken) error
+	GetByJTI(ctx context.Context, jti string) (*token.RefreshToken, error)
+	RevokeIfActive(ctx context.Context, jti, replacedBy string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	RevokeAllByUser(ctx context.Context, userID uint) error
+}
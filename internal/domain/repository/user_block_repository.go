@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+)
+
+type UserBlockRepository interface {
+	Create(ctx context.Context, block *user.UserBlock) error
+	Delete(ctx context.Context, blockerID, blockedID uint) error // 차단 기록이 없으면 gorm.ErrRecordNotFound
+	Exists(ctx context.Context, blockerID, blockedID uint) (bool, error)
+	ListByBlocker(ctx context.Context, blockerID uint) ([]*user.UserBlock, error) // 최근 차단부터
+	GetBlockedIDs(ctx context.Context, blockerID uint) ([]uint, error)            // blockerID가 차단한 사용자
+	GetRelatedIDs(ctx context.Context, userID uint) ([]uint, error)               // userID가 차단했거나 userID를 차단한 사용자
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+)
+
+type UserReportRepository interface {
+	Create(ctx context.Context, report *user.UserReport) error
+	List(ctx context.Context, reportedID uint, offset, limit int) ([]*user.UserReport, error) // 최근 신고부터, reportedID가 0이면 전체
+	Count(ctx context.Context, reportedID uint) (int64, error)
+}
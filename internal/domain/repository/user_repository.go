@@ -1,19 +1,53 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/chris910512/travel-chat/internal/domain/entity/user"
 )
 
+// UserFilter - 관리자 사용자 목록 조건 (nil인 항목은 조건 없음)
+type UserFilter struct {
+	Role      *user.Role
+	Suspended *bool // true면 정지된 사용자만, false면 정지되지 않은 사용자만
+}
+
+// TripFilter - 동행 후보 조건 (Country로 여행하면서 여행 기간이 Start~End와 겹치고 EndsAfter 이후에 끝나는 사용자)
+type TripFilter struct {
+	Country   string
+	Start     time.Time
+	End       time.Time
+	EndsAfter time.Time
+}
+
 type UserRepository interface {
-	Create(user *user.User) error
-	GetByID(id uint) (*user.User, error)
-	GetByEmail(email string) (*user.User, error)
-	Update(user *user.User) error
-	Delete(id uint) error
-	List(offset, limit int) ([]*user.User, error)
-
-	GetByDestination(country, city string) ([]*user.User, error)
-	GetActiveUsers() ([]*user.User, error)
-	UpdateLastActive(userID uint) error
-	Count() (int64, error)
+	Create(ctx context.Context, user *user.User) error
+	GetByID(ctx context.Context, id uint) (*user.User, error)
+	GetByIDs(ctx context.Context, ids []uint) ([]*user.User, error)
+	GetByEmail(ctx context.Context, email string) (*user.User, error)
+	Update(ctx context.Context, user *user.User) error
+	Delete(ctx context.Context, id uint) error
+
+	// 사용자 탐색 (이메일 인증을 마친 사용자만 반환, excludeIDs는 결과에서 제외할 사용자)
+	List(ctx context.Context, excludeIDs []uint, offset, limit int) ([]*user.User, error)
+	GetByDestination(ctx context.Context, country, city string) ([]*user.User, error)
+	GetByTrip(ctx context.Context, filter TripFilter, excludeIDs []uint) ([]*user.User, error)
+	Count(ctx context.Context, excludeIDs []uint) (int64, error)
+
+	UpdateLastActive(ctx context.Context, userID uint) error
+	// 현재 프로필 사진이 previousID일 때만 avatarID로 교체 (nil이면 삭제, 동시에 바꾸면 한 요청만 성공, 사용자가 없어도 false)
+	UpdateAvatarIfUnchanged(ctx context.Context, userID uint, previousID, avatarID *string) (bool, error)
+
+	// 로그인 잠금
+	IncrementFailedLogins(ctx context.Context, userID uint) (int, error) // 증가 후 연속 실패 횟수 반환
+	LockUntil(ctx context.Context, userID uint, until time.Time) error
+	ResetLoginFailures(ctx context.Context, userID uint, lastActive time.Time) error // 로그인 성공 시 실패 횟수와 잠금 해제, 마지막 활동 시간 기록
+
+	// 관리자 (정지된 사용자 포함, 이메일 인증 여부와 무관)
+	GetByIDIncludingSuspended(ctx context.Context, id uint) (*user.User, error)
+	ListForAdmin(ctx context.Context, filter UserFilter, offset, limit int) ([]*user.User, error)
+	CountForAdmin(ctx context.Context, filter UserFilter) (int64, error)
+	Restore(ctx context.Context, id uint) error // 정지된 사용자가 없으면 gorm.ErrRecordNotFound
+	UpdateRole(ctx context.Context, id uint, role user.Role) error
 }
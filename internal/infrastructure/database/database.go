@@ -0,0 +1,115 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"gorm.io/gorm"
+)
+
+// 지원하는 데이터베이스 드라이버 (DB_DRIVER)
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
+// ErrUnsupportedDriver - 지원하지 않는 DB_DRIVER 값
+var ErrUnsupportedDriver = errors.New("unsupported database driver")
+
+// Config - 데이터베이스 연결 설정
+type Config struct {
+	Driver string `yaml:"driver"`
+	// DSN - 지정하면 Host/Port 등 개별 설정 대신 그대로 사용
+	DSN string `yaml:"dsn"`
+
+	// Postgres 개별 접속 정보 (DSN이 없을 때 사용)
+	Host     string `yaml:"host"`
+	Port     string `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"sslmode"`
+
+	// 커넥션 풀 설정 (0이면 database/sql 기본값 유지)
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+}
+
+// Validate - 드라이버와 풀 설정 값 검증
+func (c Config) Validate() error {
+	if !isSupportedDriver(c.Driver) {
+		return fmt.Errorf("%w: %q (use %s or %s)", ErrUnsupportedDriver, c.Driver, DriverPostgres, DriverSQLite)
+	}
+	if c.Driver == DriverPostgres && c.DSN == "" && c.Host == "" {
+		return errors.New("postgres requires either a DSN or a host")
+	}
+	if c.MaxOpenConns < 0 || c.MaxIdleConns < 0 {
+		return errors.New("connection pool sizes must not be negative")
+	}
+	if c.ConnMaxLifetime < 0 || c.ConnMaxIdleTime < 0 {
+		return errors.New("connection pool durations must not be negative")
+	}
+	return nil
+}
+
+// NewDB - 설정된 드라이버로 데이터베이스 연결 후 커넥션 풀 설정 적용
+func NewDB(cfg Config) (*gorm.DB, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	var dialector gorm.Dialector
+	var err error
+	switch cfg.Driver {
+	case DriverSQLite:
+		dialector, err = newSQLiteDialector(cfg)
+	default:
+		dialector = newPostgresDialector(cfg)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	// 쿼리 소요 시간 지표 수집
+	if err := db.Use(metrics.GormPlugin{}); err != nil {
+		return nil, err
+	}
+
+	// 쿼리별 추적 스팬 (요청 컨텍스트가 있을 때만)
+	if err := db.Use(tracing.GormPlugin{}); err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	return db, nil
+}
+
+func isSupportedDriver(driver string) bool {
+	return driver == DriverPostgres || driver == DriverSQLite
+}
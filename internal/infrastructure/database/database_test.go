@@ -0,0 +1,24 @@
+package database
+
+import "testing"
+
+func TestMigrationsMatchAcrossDrivers(t *testing.T) {
+	postgresMigrations, err := LoadMigrations(migrationFiles, "migrations/"+DriverPostgres)
+	if err != nil {
+		t.Fatalf("LoadMigrations(postgres): %v", err)
+	}
+	sqliteMigrations, err := LoadMigrations(migrationFiles, "migrations/"+DriverSQLite)
+	if err != nil {
+		t.Fatalf("LoadMigrations(sqlite): %v", err)
+	}
+
+	if len(postgresMigrations) != len(sqliteMigrations) {
+		t.Fatalf("postgres has %d migrations, sqlite has %d", len(postgresMigrations), len(sqliteMigrations))
+	}
+	for i := range postgresMigrations {
+		pg, lite := postgresMigrations[i], sqliteMigrations[i]
+		if pg.Version != lite.Version || pg.Name != lite.Name {
+			t.Errorf("migration mismatch: postgres %04d_%s, sqlite %04d_%s", pg.Version, pg.Name, lite.Version, lite.Name)
+		}
+	}
+}
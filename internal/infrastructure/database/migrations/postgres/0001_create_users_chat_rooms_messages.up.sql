@@ -0,0 +1,58 @@
+-- 사용자, 채팅방, 메시지 테이블
+CREATE TABLE IF NOT EXISTS users (
+    id             BIGSERIAL PRIMARY KEY,
+    email          TEXT        NOT NULL,
+    password       TEXT        NOT NULL,
+    name           TEXT        NOT NULL,
+    age            BIGINT,
+    gender         BIGINT      DEFAULT 0,
+    profile_pic    TEXT,
+    country        TEXT,
+    city           TEXT,
+    travel_start   TIMESTAMPTZ,
+    travel_end     TIMESTAMPTZ,
+    bio            TEXT,
+    travel_purpose BIGINT      DEFAULT 0,
+    travel_budget  BIGINT,
+    travel_style   BIGINT      DEFAULT 0,
+    last_active    TIMESTAMPTZ,
+    created_at     TIMESTAMPTZ,
+    updated_at     TIMESTAMPTZ,
+    deleted_at     TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_country_city ON users (country, city);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE IF NOT EXISTS chat_rooms (
+    id         BIGSERIAL PRIMARY KEY,
+    country    VARCHAR(100) NOT NULL,
+    city       VARCHAR(100) NOT NULL,
+    room_type  BIGINT       NOT NULL DEFAULT 0,
+    name       VARCHAR(200),
+    pair_key   VARCHAR(64),
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_chat_rooms_country_city ON chat_rooms (country, city);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_rooms_pair_key ON chat_rooms (pair_key);
+CREATE INDEX IF NOT EXISTS idx_chat_rooms_deleted_at ON chat_rooms (deleted_at);
+
+CREATE TABLE IF NOT EXISTS messages (
+    id           BIGSERIAL PRIMARY KEY,
+    content      TEXT   NOT NULL,
+    user_id      BIGINT NOT NULL,
+    chat_room_id BIGINT NOT NULL,
+    message_type BIGINT DEFAULT 0,
+    expires_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ,
+    updated_at   TIMESTAMPTZ,
+    deleted_at   TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_messages_chat_room_id_created_at ON messages (chat_room_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_messages_expires_at ON messages (expires_at);
+CREATE INDEX IF NOT EXISTS idx_messages_deleted_at ON messages (deleted_at);
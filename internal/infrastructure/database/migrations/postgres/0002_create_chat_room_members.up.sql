@@ -0,0 +1,10 @@
+-- 1:1 채팅방 참여자
+CREATE TABLE IF NOT EXISTS chat_room_members (
+    id           BIGSERIAL PRIMARY KEY,
+    chat_room_id BIGINT NOT NULL,
+    user_id      BIGINT NOT NULL,
+    created_at   TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_chat_room_members_room_user ON chat_room_members (chat_room_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_chat_room_members_user_id ON chat_room_members (user_id);
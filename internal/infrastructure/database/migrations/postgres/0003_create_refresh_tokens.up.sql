@@ -0,0 +1,15 @@
+-- 발급된 리프레시 토큰 (JTI 기준 회전/폐기 관리)
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id          BIGSERIAL PRIMARY KEY,
+    jti         VARCHAR(64) NOT NULL,
+    user_id     BIGINT      NOT NULL,
+    family_id   VARCHAR(64) NOT NULL,
+    replaced_by VARCHAR(64),
+    expires_at  TIMESTAMPTZ NOT NULL,
+    revoked_at  TIMESTAMPTZ,
+    created_at  TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_jti ON refresh_tokens (jti);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
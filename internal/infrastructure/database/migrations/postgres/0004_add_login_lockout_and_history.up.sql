@@ -0,0 +1,16 @@
+-- 로그인 연속 실패 횟수와 잠금, 로그인 시도 기록
+ALTER TABLE users ADD COLUMN IF NOT EXISTS failed_login_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS login_histories (
+    id             BIGSERIAL PRIMARY KEY,
+    user_id        BIGINT       NOT NULL,
+    ip             VARCHAR(64),
+    user_agent     VARCHAR(512),
+    success        BOOLEAN      NOT NULL,
+    failure_reason VARCHAR(32),
+    new_ip         BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_at     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_histories_user_id_created_at ON login_histories (user_id, created_at);
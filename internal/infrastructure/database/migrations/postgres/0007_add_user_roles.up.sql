@@ -0,0 +1,2 @@
+-- 사용자 역할 (0: user, 1: moderator, 2: admin)
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,23 @@
+-- 사용자 차단과 신고
+CREATE TABLE IF NOT EXISTS user_blocks (
+    id         BIGSERIAL PRIMARY KEY,
+    blocker_id BIGINT NOT NULL,
+    blocked_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_blocks_blocker_id_blocked_id ON user_blocks (blocker_id, blocked_id);
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+
+CREATE TABLE IF NOT EXISTS user_reports (
+    id          BIGSERIAL PRIMARY KEY,
+    reporter_id BIGINT        NOT NULL,
+    reported_id BIGINT        NOT NULL,
+    reason      VARCHAR(32)   NOT NULL,
+    description VARCHAR(1000),
+    message_id  BIGINT,
+    created_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_reports_reporter_id ON user_reports (reporter_id);
+CREATE INDEX IF NOT EXISTS idx_user_reports_reported_id_created_at ON user_reports (reported_id, created_at);
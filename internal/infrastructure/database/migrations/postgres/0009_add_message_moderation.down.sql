@@ -0,0 +1,2 @@
+ALTER TABLE messages DROP COLUMN IF EXISTS moderated_by;
+ALTER TABLE messages DROP COLUMN IF EXISTS moderation;
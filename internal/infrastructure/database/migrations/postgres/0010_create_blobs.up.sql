@@ -0,0 +1,17 @@
+-- 업로드 파일 메타데이터 (내용은 STORAGE_DRIVER 저장소에 저장)와 이미지 메시지의 첨부 파일
+CREATE TABLE IF NOT EXISTS blobs (
+    id           VARCHAR(32) PRIMARY KEY,
+    owner_id     BIGINT      NOT NULL,
+    kind         VARCHAR(16) NOT NULL,
+    content_type VARCHAR(32) NOT NULL,
+    size         BIGINT      NOT NULL,
+    width        BIGINT      NOT NULL,
+    height       BIGINT      NOT NULL,
+    expires_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_blobs_owner_id ON blobs (owner_id);
+CREATE INDEX IF NOT EXISTS idx_blobs_expires_at ON blobs (expires_at);
+
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS blob_id VARCHAR(32);
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS profile_pic TEXT;
+ALTER TABLE users DROP COLUMN IF EXISTS avatar_id;
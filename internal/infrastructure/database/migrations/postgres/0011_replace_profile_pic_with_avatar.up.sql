@@ -0,0 +1,3 @@
+-- 클라이언트가 임의로 지정하던 프로필 사진 URL 대신 업로드한 프로필 사진(blobs)의 ID 저장
+ALTER TABLE users ADD COLUMN IF NOT EXISTS avatar_id VARCHAR(32);
+ALTER TABLE users DROP COLUMN IF EXISTS profile_pic;
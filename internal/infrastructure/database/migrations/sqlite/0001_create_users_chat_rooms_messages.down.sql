@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS messages;
+DROP TABLE IF EXISTS chat_rooms;
+DROP TABLE IF EXISTS users;
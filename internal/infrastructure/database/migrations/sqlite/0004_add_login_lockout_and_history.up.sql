@@ -0,0 +1,16 @@
+-- 로그인 연속 실패 횟수와 잠금, 로그인 시도 기록
+ALTER TABLE users ADD COLUMN failed_login_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN locked_until DATETIME;
+
+CREATE TABLE IF NOT EXISTS login_histories (
+    id             INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id        INTEGER      NOT NULL,
+    ip             VARCHAR(64),
+    user_agent     VARCHAR(512),
+    success        NUMERIC      NOT NULL,
+    failure_reason VARCHAR(32),
+    new_ip         NUMERIC      NOT NULL DEFAULT false,
+    created_at     DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_login_histories_user_id_created_at ON login_histories (user_id, created_at);
@@ -0,0 +1,12 @@
+-- 비밀번호 재설정 토큰 (원문 대신 SHA-256 해시 저장, 1회용)
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER     NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at DATETIME    NOT NULL,
+    used_at    DATETIME,
+    created_at DATETIME
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_password_reset_tokens_token_hash ON password_reset_tokens (token_hash);
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_id ON password_reset_tokens (user_id);
@@ -0,0 +1,18 @@
+-- 이메일 인증 상태와 인증 토큰 (원문 대신 SHA-256 해시 저장, 1회용)
+ALTER TABLE users ADD COLUMN email_verified NUMERIC NOT NULL DEFAULT false;
+ALTER TABLE users ADD COLUMN email_verified_at DATETIME;
+
+-- 인증 기능 도입 전에 가입한 사용자는 인증된 것으로 처리
+UPDATE users SET email_verified = true, email_verified_at = CURRENT_TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    user_id    INTEGER     NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    expires_at DATETIME    NOT NULL,
+    used_at    DATETIME,
+    created_at DATETIME
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_email_verification_tokens_token_hash ON email_verification_tokens (token_hash);
+CREATE INDEX IF NOT EXISTS idx_email_verification_tokens_user_id ON email_verification_tokens (user_id);
@@ -0,0 +1,23 @@
+-- 사용자 차단과 신고
+CREATE TABLE IF NOT EXISTS user_blocks (
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    blocker_id INTEGER NOT NULL,
+    blocked_id INTEGER NOT NULL,
+    created_at DATETIME
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_blocks_blocker_id_blocked_id ON user_blocks (blocker_id, blocked_id);
+CREATE INDEX IF NOT EXISTS idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+
+CREATE TABLE IF NOT EXISTS user_reports (
+    id          INTEGER PRIMARY KEY AUTOINCREMENT,
+    reporter_id INTEGER       NOT NULL,
+    reported_id INTEGER       NOT NULL,
+    reason      VARCHAR(32)   NOT NULL,
+    description VARCHAR(1000),
+    message_id  INTEGER,
+    created_at  DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_reports_reporter_id ON user_reports (reporter_id);
+CREATE INDEX IF NOT EXISTS idx_user_reports_reported_id_created_at ON user_reports (reported_id, created_at);
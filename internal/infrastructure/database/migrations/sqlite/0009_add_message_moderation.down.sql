@@ -0,0 +1,2 @@
+ALTER TABLE messages DROP COLUMN moderated_by;
+ALTER TABLE messages DROP COLUMN moderation;
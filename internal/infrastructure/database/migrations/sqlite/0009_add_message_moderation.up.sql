@@ -0,0 +1,3 @@
+-- 메시지 전송 전 검사 결과 (allowed, masked)와 내용을 가린 검사 단계
+ALTER TABLE messages ADD COLUMN moderation VARCHAR(16) NOT NULL DEFAULT 'allowed';
+ALTER TABLE messages ADD COLUMN moderated_by VARCHAR(100) NOT NULL DEFAULT '';
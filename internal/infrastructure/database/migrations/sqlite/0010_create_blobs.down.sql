@@ -0,0 +1,2 @@
+ALTER TABLE messages DROP COLUMN blob_id;
+DROP TABLE IF EXISTS blobs;
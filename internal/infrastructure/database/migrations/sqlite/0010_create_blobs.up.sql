@@ -0,0 +1,17 @@
+-- 업로드 파일 메타데이터 (내용은 STORAGE_DRIVER 저장소에 저장)와 이미지 메시지의 첨부 파일
+CREATE TABLE IF NOT EXISTS blobs (
+    id           VARCHAR(32) PRIMARY KEY,
+    owner_id     INTEGER     NOT NULL,
+    kind         VARCHAR(16) NOT NULL,
+    content_type VARCHAR(32) NOT NULL,
+    size         INTEGER     NOT NULL,
+    width        INTEGER     NOT NULL,
+    height       INTEGER     NOT NULL,
+    expires_at   DATETIME,
+    created_at   DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_blobs_owner_id ON blobs (owner_id);
+CREATE INDEX IF NOT EXISTS idx_blobs_expires_at ON blobs (expires_at);
+
+ALTER TABLE messages ADD COLUMN blob_id VARCHAR(32);
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN profile_pic TEXT;
+ALTER TABLE users DROP COLUMN avatar_id;
@@ -2,19 +2,32 @@ package database
 
 import (
 	"fmt"
+	"strings"
+
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"os"
 )
 
-func NewPostgresDB() (*gorm.DB, error) {
-	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=require",
-		os.Getenv("DB_HOST"),
-		os.Getenv("DB_USER"),
-		os.Getenv("DB_PASSWORD"),
-		os.Getenv("DB_NAME"),
-		os.Getenv("DB_PORT"),
-	)
+// newPostgresDialector - DB_DSN이 없으면 개별 접속 정보로 DSN 구성
+func newPostgresDialector(cfg Config) gorm.Dialector {
+	dsn := cfg.DSN
+	if dsn == "" {
+		dsn = fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s",
+			quoteDSNValue(cfg.Host),
+			quoteDSNValue(cfg.User),
+			quoteDSNValue(cfg.Password),
+			quoteDSNValue(cfg.Name),
+			quoteDSNValue(cfg.Port),
+			quoteDSNValue(cfg.SSLMode),
+		)
+	}
+
+	return postgres.Open(dsn)
+}
 
-	return gorm.Open(postgres.Open(dsn), &gorm.Config{})
+// quoteDSNValue - 빈 값이나 공백이 포함된 값도 안전하도록 작은따옴표로 감싸기
+func quoteDSNValue(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
 }
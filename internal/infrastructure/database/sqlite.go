@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// DefaultSQLiteDSN - DB_DSN이 없을 때 사용하는 SQLite 파일 (동시 접근 시 잠금 대기, WAL 모드)
+const DefaultSQLiteDSN = "travel-chat.db?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+
+// newSQLiteDialector - 모든 시간 파라미터를 UTC로 바꿔 전달하는 SQLite 연결 생성
+//
+// SQLite는 시간을 문자열로 저장하고 문자열로 비교하므로, 타임존 오프셋이 섞이면
+// expires_at / last_active 비교 결과가 틀어짐. 저장과 비교 모두 UTC로 통일.
+func newSQLiteDialector(cfg Config) (gorm.Dialector, error) {
+	dsn := cfg.DSN
+	if dsn == "" {
+		dsn = DefaultSQLiteDSN
+	}
+
+	sqlDB, err := sql.Open(sqlite.DriverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlite.Dialector{DSN: dsn, Conn: &utcConnPool{conn: sqlDB, db: sqlDB}}, nil
+}
+
+// sqlConn - *sql.DB와 *sql.Tx의 공통 메서드
+type sqlConn interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// utcConnPool - 쿼리 인자의 time.Time을 UTC로 변환하는 gorm.ConnPool
+type utcConnPool struct {
+	conn sqlConn
+	db   *sql.DB // 트랜잭션이면 nil
+}
+
+func (p *utcConnPool) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return p.conn.PrepareContext(ctx, query)
+}
+
+func (p *utcConnPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return p.conn.ExecContext(ctx, query, toUTC(args)...)
+}
+
+func (p *utcConnPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return p.conn.QueryContext(ctx, query, toUTC(args)...)
+}
+
+func (p *utcConnPool) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return p.conn.QueryRowContext(ctx, query, toUTC(args)...)
+}
+
+// BeginTx - 트랜잭션 안의 쿼리도 같은 변환을 거치도록 감싸서 반환 (gorm.ConnPoolBeginner)
+func (p *utcConnPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	if p.db == nil {
+		return nil, gorm.ErrInvalidTransaction
+	}
+	tx, err := p.db.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &utcTx{utcConnPool: utcConnPool{conn: tx}, tx: tx}, nil
+}
+
+// GetDBConn - db.DB()로 커넥션 풀 설정이 가능하도록 원본 *sql.DB 반환 (gorm.GetDBConnector)
+func (p *utcConnPool) GetDBConn() (*sql.DB, error) {
+	return p.db, nil
+}
+
+// utcTx - utcConnPool 트랜잭션 (gorm.TxCommitter)
+type utcTx struct {
+	utcConnPool
+	tx *sql.Tx
+}
+
+func (t *utcTx) Commit() error {
+	return t.tx.Commit()
+}
+
+func (t *utcTx) Rollback() error {
+	return t.tx.Rollback()
+}
+
+func toUTC(args []interface{}) []interface{} {
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		converted[i] = arg
+		switch v := arg.(type) {
+		case time.Time:
+			converted[i] = v.UTC()
+		case *time.Time:
+			if v != nil {
+				converted[i] = v.UTC()
+			}
+		case driver.Valuer:
+			// gorm.DeletedAt, sql.NullTime 등
+			if value, err := v.Value(); err == nil {
+				if t, ok := value.(time.Time); ok {
+					converted[i] = t.UTC()
+				}
+			}
+		}
+	}
+	return converted
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/blob"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type blobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewBlobRepository(db *gorm.DB) repository.BlobRepository {
+	return &blobRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *blobRepositoryImpl) Create(ctx context.Context, b *blob.Blob) error {
+	return r.db.WithContext(ctx).Create(b).Error
+}
+
+func (r *blobRepositoryImpl) GetByID(ctx context.Context, id string) (*blob.Blob, error) {
+	var b blob.Blob
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&b).Error; err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *blobRepositoryImpl) Delete(ctx context.Context, id string) error {
+	return r.db.WithContext(ctx).Where("id = ?", id).Delete(&blob.Blob{}).Error
+}
+
+// ExtendExpiration - 조건부 UPDATE로 늦추기만 함 (동시에 여러 메시지에 첨부해도 가장 늦은 만료 시간이 남음)
+func (r *blobRepositoryImpl) ExtendExpiration(ctx context.Context, id string, until time.Time) error {
+	return r.db.WithContext(ctx).Model(&blob.Blob{}).
+		Where("id = ? AND expires_at IS NOT NULL AND expires_at < ?", id, until).
+		Update("expires_at", until).Error
+}
+
+// ListExpired - 만료된 파일을 만료 순으로 조회
+func (r *blobRepositoryImpl) ListExpired(ctx context.Context, before time.Time, limit int) ([]*blob.Blob, error) {
+	var blobs []*blob.Blob
+	err := r.db.WithContext(ctx).
+		Where("expires_at IS NOT NULL AND expires_at < ?", before).
+		Order("expires_at").
+		Limit(limit).
+		Find(&blobs).Error
+	return blobs, err
+}
@@ -1,6 +1,10 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"time"
+
 	"github.com/chris910512/travel-chat/internal/domain/entity/chatroom"
 	"github.com/chris910512/travel-chat/internal/domain/repository"
 	"gorm.io/gorm"
@@ -16,22 +20,22 @@ func NewChatRoomRepository(db *gorm.DB) repository.ChatRoomRepository {
 	}
 }
 
-func (r *chatRoomRepositoryImpl) Create(chatRoom *chatroom.ChatRoom) error {
-	return r.db.Create(chatRoom).Error
+func (r *chatRoomRepositoryImpl) Create(ctx context.Context, chatRoom *chatroom.ChatRoom) error {
+	return r.db.WithContext(ctx).Create(chatRoom).Error
 }
 
-func (r *chatRoomRepositoryImpl) GetByID(id uint) (*chatroom.ChatRoom, error) {
+func (r *chatRoomRepositoryImpl) GetByID(ctx context.Context, id uint) (*chatroom.ChatRoom, error) {
 	var room chatroom.ChatRoom
-	err := r.db.First(&room, id).Error
+	err := r.db.WithContext(ctx).First(&room, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &room, nil
 }
 
-func (r *chatRoomRepositoryImpl) GetByLocation(country, city string, roomType chatroom.RoomType) (*chatroom.ChatRoom, error) {
+func (r *chatRoomRepositoryImpl) GetByLocation(ctx context.Context, country, city string, roomType chatroom.RoomType) (*chatroom.ChatRoom, error) {
 	var room chatroom.ChatRoom
-	err := r.db.Where("country = ? AND city = ? AND room_type = ?",
+	err := r.db.WithContext(ctx).Where("country = ? AND city = ? AND room_type = ?",
 		country, city, roomType).First(&room).Error
 	if err != nil {
 		return nil, err
@@ -39,9 +43,9 @@ func (r *chatRoomRepositoryImpl) GetByLocation(country, city string, roomType ch
 	return &room, nil
 }
 
-func (r *chatRoomRepositoryImpl) GetOrCreatePublicRoom(country, city string) (*chatroom.ChatRoom, error) {
+func (r *chatRoomRepositoryImpl) GetOrCreatePublicRoom(ctx context.Context, country, city string) (*chatroom.ChatRoom, error) {
 	// 먼저 기존 방이 있는지 확인
-	room, err := r.GetByLocation(country, city, chatroom.RoomTypePublic)
+	room, err := r.GetByLocation(ctx, country, city, chatroom.RoomTypePublic)
 	if err == nil {
 		return room, nil
 	}
@@ -54,7 +58,7 @@ func (r *chatRoomRepositoryImpl) GetOrCreatePublicRoom(country, city string) (*c
 	}
 	newRoom.GeneratePublicRoomName()
 
-	err = r.Create(newRoom)
+	err = r.Create(ctx, newRoom)
 	if err != nil {
 		return nil, err
 	}
@@ -62,26 +66,85 @@ func (r *chatRoomRepositoryImpl) GetOrCreatePublicRoom(country, city string) (*c
 	return newRoom, nil
 }
 
-func (r *chatRoomRepositoryImpl) CreatePrivateRoom(country, city, user1Name, user2Name string) (*chatroom.ChatRoom, error) {
+func (r *chatRoomRepositoryImpl) GetOrCreatePrivateRoom(ctx context.Context, country, city string, user1ID, user2ID uint, user1Name, user2Name string) (*chatroom.ChatRoom, error) {
+	pairKey := chatroom.PrivatePairKey(user1ID, user2ID)
+
+	// 먼저 두 사용자의 기존 방이 있는지 확인
+	room, err := r.getByPairKey(ctx, pairKey)
+	if err == nil {
+		return room, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	// 없으면 채팅방과 참여자를 함께 생성
 	newRoom := &chatroom.ChatRoom{
 		Country:  country,
 		City:     city,
 		RoomType: chatroom.RoomTypePrivate,
+		PairKey:  &pairKey,
 	}
 	newRoom.GeneratePrivateRoomName(user1Name, user2Name)
 
-	err := r.Create(newRoom)
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newRoom).Error; err != nil {
+			return err
+		}
+
+		members := []*chatroom.ChatRoomMember{
+			{ChatRoomID: newRoom.ID, UserID: user1ID},
+			{ChatRoomID: newRoom.ID, UserID: user2ID},
+		}
+		return tx.Create(&members).Error
+	})
 	if err != nil {
+		// 동시에 같은 방이 생성된 경우 (pair_key 유니크 제약 위반)
+		if room, getErr := r.getByPairKey(ctx, pairKey); getErr == nil {
+			return room, nil
+		}
 		return nil, err
 	}
 
 	return newRoom, nil
 }
 
-func (r *chatRoomRepositoryImpl) Update(chatRoom *chatroom.ChatRoom) error {
-	return r.db.Save(chatRoom).Error
+func (r *chatRoomRepositoryImpl) IsMember(ctx context.Context, roomID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&chatroom.ChatRoomMember{}).
+		Where("chat_room_id = ? AND user_id = ?", roomID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *chatRoomRepositoryImpl) GetByMember(ctx context.Context, userID uint) ([]*chatroom.ChatRoom, error) {
+	var rooms []*chatroom.ChatRoom
+	err := r.db.WithContext(ctx).
+		Joins("JOIN chat_room_members ON chat_room_members.chat_room_id = chat_rooms.id").
+		Where("chat_room_members.user_id = ?", userID).
+		Order("chat_rooms.created_at DESC").
+		Find(&rooms).Error
+	return rooms, err
+}
+
+func (r *chatRoomRepositoryImpl) Update(ctx context.Context, chatRoom *chatroom.ChatRoom) error {
+	return r.db.WithContext(ctx).Save(chatRoom).Error
+}
+
+// Delete - 소프트 삭제 (pair_key 유니크 인덱스가 남지 않도록 쌍 키도 해제)
+func (r *chatRoomRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&chatroom.ChatRoom{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"pair_key":   nil,
+			"deleted_at": time.Now(),
+		}).Error
 }
 
-func (r *chatRoomRepositoryImpl) Delete(id uint) error {
-	return r.db.Delete(&chatroom.ChatRoom{}, id).Error
+func (r *chatRoomRepositoryImpl) getByPairKey(ctx context.Context, pairKey string) (*chatroom.ChatRoom, error) {
+	var room chatroom.ChatRoom
+	err := r.db.WithContext(ctx).Where("pair_key = ?", pairKey).First(&room).Error
+	if err != nil {
+		return nil, err
+	}
+	return &room, nil
 }
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type emailVerificationTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEmailVerificationTokenRepository(db *gorm.DB) repository.EmailVerificationTokenRepository {
+	return &emailVerificationTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *emailVerificationTokenRepositoryImpl) Create(ctx context.Context, verificationToken *token.EmailVerificationToken) error {
+	return r.db.WithContext(ctx).Create(verificationToken).Error
+}
+
+func (r *emailVerificationTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*token.EmailVerificationToken, error) {
+	var verificationToken token.EmailVerificationToken
+	err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&verificationToken).Error
+	if err != nil {
+		return nil, err
+	}
+	return &verificationToken, nil
+}
+
+// MarkUsedIfActive - 아직 사용되지 않은 토큰만 사용 처리 (동시에 같은 토큰을 써도 한 요청만 성공)
+func (r *emailVerificationTokenRepositoryImpl) MarkUsedIfActive(ctx context.Context, id uint) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&token.EmailVerificationToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// InvalidateAllByUser - 사용자의 남은 토큰 전체 무효화 (재발송, 인증 완료 시)
+func (r *emailVerificationTokenRepositoryImpl) InvalidateAllByUser(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Model(&token.EmailVerificationToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now()).Error
+}
@@ -0,0 +1,174 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/infrastructure/database"
+	gormRepository "github.com/chris910512/travel-chat/internal/infrastructure/repository"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/repositorytest"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var (
+	testDBOnce sync.Once
+	testDB     *gorm.DB
+	testDBErr  error
+)
+
+// openTestDB - TEST_DATABASE_DSN이 있으면 해당 Postgres를 비워서, 없으면 새 인메모리 SQLite에 마이그레이션 적용 후 반환
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		return openSQLiteTestDB(t)
+	}
+
+	testDBOnce.Do(func() {
+		testDB, testDBErr = openMigratedDB(database.Config{Driver: database.DriverPostgres, DSN: dsn})
+	})
+	if testDBErr != nil {
+		t.Fatalf("failed to prepare test database: %v", testDBErr)
+	}
+
+	err := testDB.Exec("TRUNCATE users, chat_rooms, chat_room_members, messages, refresh_tokens, login_histories, password_reset_tokens, email_verification_tokens, user_blocks, user_reports, blobs RESTART IDENTITY").Error
+	if err != nil {
+		t.Fatalf("failed to truncate tables: %v", err)
+	}
+	return testDB
+}
+
+// openSQLiteTestDB - 테스트마다 독립된 인메모리 SQLite (연결이 하나뿐이어야 같은 DB를 공유)
+func openSQLiteTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := openMigratedDB(database.Config{Driver: database.DriverSQLite, DSN: ":memory:", MaxOpenConns: 1})
+	if err != nil {
+		t.Fatalf("failed to prepare test database: %v", err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
+
+func openMigratedDB(cfg database.Config) (*gorm.DB, error) {
+	db, err := database.NewDB(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)})
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := migrator.Up(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func TestUserRepository(t *testing.T) {
+	repositorytest.RunUserRepositoryContract(t, func(t *testing.T) repository.UserRepository {
+		return gormRepository.NewUserRepository(openTestDB(t))
+	})
+}
+
+func TestChatRoomRepository(t *testing.T) {
+	repositorytest.RunChatRoomRepositoryContract(t, func(t *testing.T) repository.ChatRoomRepository {
+		return gormRepository.NewChatRoomRepository(openTestDB(t))
+	})
+}
+
+func TestMessageRepository(t *testing.T) {
+	repositorytest.RunMessageRepositoryContract(t, func(t *testing.T) repository.MessageRepository {
+		return gormRepository.NewMessageRepository(openTestDB(t))
+	})
+}
+
+func TestRefreshTokenRepository(t *testing.T) {
+	repositorytest.RunRefreshTokenRepositoryContract(t, func(t *testing.T) repository.RefreshTokenRepository {
+		return gormRepository.NewRefreshTokenRepository(openTestDB(t))
+	})
+}
+
+func TestLoginHistoryRepository(t *testing.T) {
+	repositorytest.RunLoginHistoryRepositoryContract(t, func(t *testing.T) repository.LoginHistoryRepository {
+		return gormRepository.NewLoginHistoryRepository(openTestDB(t))
+	})
+}
+
+func TestPasswordResetTokenRepository(t *testing.T) {
+	repositorytest.RunPasswordResetTokenRepositoryContract(t, func(t *testing.T) repository.PasswordResetTokenRepository {
+		return gormRepository.NewPasswordResetTokenRepository(openTestDB(t))
+	})
+}
+
+func TestEmailVerificationTokenRepository(t *testing.T) {
+	repositorytest.RunEmailVerificationTokenRepositoryContract(t, func(t *testing.T) repository.EmailVerificationTokenRepository {
+		return gormRepository.NewEmailVerificationTokenRepository(openTestDB(t))
+	})
+}
+
+func TestUserBlockRepository(t *testing.T) {
+	repositorytest.RunUserBlockRepositoryContract(t, func(t *testing.T) repository.UserBlockRepository {
+		return gormRepository.NewUserBlockRepository(openTestDB(t))
+	})
+}
+
+func TestUserReportRepository(t *testing.T) {
+	repositorytest.RunUserReportRepositoryContract(t, func(t *testing.T) repository.UserReportRepository {
+		return gormRepository.NewUserReportRepository(openTestDB(t))
+	})
+}
+
+func TestBlobRepository(t *testing.T) {
+	repositorytest.RunBlobRepositoryContract(t, func(t *testing.T) repository.BlobRepository {
+		return gormRepository.NewBlobRepository(openTestDB(t))
+	})
+}
+
+// SQLite는 시간을 문자열로 비교하므로 타임존이 다른 시간이 섞여도 만료 판정이 맞는지 확인
+func TestMessageExpiryAcrossTimeZones(t *testing.T) {
+	ctx := context.Background()
+	repo := gormRepository.NewMessageRepository(openTestDB(t))
+	kst := time.FixedZone("KST", 9*60*60)
+	pst := time.FixedZone("PST", -8*60*60)
+	now := time.Now()
+
+	expiredAt := now.Add(-30 * time.Minute).In(kst)
+	liveAt := now.Add(30 * time.Minute).In(pst)
+	expired := &message.Message{Content: "만료", UserID: 1, ChatRoomID: 1, CreatedAt: now.Add(-time.Hour).In(kst), ExpiresAt: &expiredAt}
+	live := &message.Message{Content: "유효", UserID: 1, ChatRoomID: 1, CreatedAt: now.In(pst), ExpiresAt: &liveAt}
+	for _, msg := range []*message.Message{expired, live} {
+		if err := repo.Create(ctx, msg); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+
+	messages, err := repo.GetByChatRoom(ctx, 1, nil, 10)
+	if err != nil {
+		t.Fatalf("GetByChatRoom: %v", err)
+	}
+	if len(messages) != 1 || messages[0].ID != live.ID {
+		t.Fatalf("expected only the live message (ID %d), got %d messages", live.ID, len(messages))
+	}
+
+	deleted, err := repo.DeleteExpiredBatch(ctx, now.UTC(), 10)
+	if err != nil {
+		t.Fatalf("DeleteExpiredBatch: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("expected 1 expired message purged, got %d", deleted)
+	}
+}
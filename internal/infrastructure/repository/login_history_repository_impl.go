@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type loginHistoryRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewLoginHistoryRepository(db *gorm.DB) repository.LoginHistoryRepository {
+	return &loginHistoryRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *loginHistoryRepositoryImpl) Create(ctx context.Context, history *user.LoginHistory) error {
+	return r.db.WithContext(ctx).Create(history).Error
+}
+
+// ListByUser - 최근 로그인 시도부터 조회
+func (r *loginHistoryRepositoryImpl) ListByUser(ctx context.Context, userID uint, offset, limit int) ([]*user.LoginHistory, error) {
+	var histories []*user.LoginHistory
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&histories).Error
+	return histories, err
+}
+
+func (r *loginHistoryRepositoryImpl) CountByUser(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&user.LoginHistory{}).Where("user_id = ?", userID).Count(&count).Error
+	return count, err
+}
+
+// KnownIPs - 로그인에 성공한 적 있는 IP 목록 (새 IP 감지용)
+func (r *loginHistoryRepositoryImpl) KnownIPs(ctx context.Context, userID uint) ([]string, error) {
+	var ips []string
+	err := r.db.WithContext(ctx).Model(&user.LoginHistory{}).
+		Where("user_id = ? AND success = ? AND ip <> ''", userID, true).
+		Distinct().Pluck("ip", &ips).Error
+	return ips, err
+}
@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/blob"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type blobRepositoryImpl struct {
+	mu    sync.RWMutex
+	blobs map[string]*blob.Blob
+}
+
+// NewBlobRepository - 메모리 기반 BlobRepository 생성자
+func NewBlobRepository() repository.BlobRepository {
+	return &blobRepositoryImpl{
+		blobs: make(map[string]*blob.Blob),
+	}
+}
+
+func (r *blobRepositoryImpl) Create(ctx context.Context, b *blob.Blob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.blobs[b.ID]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	if b.CreatedAt.IsZero() {
+		b.CreatedAt = time.Now()
+	}
+	r.blobs[b.ID] = copyBlob(b)
+	return nil
+}
+
+func (r *blobRepositoryImpl) GetByID(ctx context.Context, id string) (*blob.Blob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	b, ok := r.blobs[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return copyBlob(b), nil
+}
+
+func (r *blobRepositoryImpl) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.blobs, id)
+	return nil
+}
+
+func (r *blobRepositoryImpl) ExtendExpiration(ctx context.Context, id string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if b, ok := r.blobs[id]; ok && b.ExpiresAt != nil && b.ExpiresAt.Before(until) {
+		b.ExpiresAt = &until
+	}
+	return nil
+}
+
+func (r *blobRepositoryImpl) ListExpired(ctx context.Context, before time.Time, limit int) ([]*blob.Blob, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	expired := make([]*blob.Blob, 0)
+	for _, b := range r.blobs {
+		if b.ExpiresAt != nil && b.ExpiresAt.Before(before) {
+			expired = append(expired, copyBlob(b))
+		}
+	}
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].ExpiresAt.Before(*expired[j].ExpiresAt)
+	})
+	if len(expired) > limit {
+		expired = expired[:limit]
+	}
+	return expired, nil
+}
+
+func copyBlob(b *blob.Blob) *blob.Blob {
+	c := *b
+	if b.ExpiresAt != nil {
+		expiresAt := *b.ExpiresAt
+		c.ExpiresAt = &expiresAt
+	}
+	return &c
+}
@@ -0,0 +1,237 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/chatroom"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type chatRoomRepositoryImpl struct {
+	mu      sync.RWMutex
+	rooms   map[uint]*chatroom.ChatRoom
+	members map[uint]map[uint]struct{} // 채팅방 ID → 참여자 ID
+	nextID  uint
+}
+
+// NewChatRoomRepository - 메모리 기반 ChatRoomRepository 생성자
+func NewChatRoomRepository() repository.ChatRoomRepository {
+	return &chatRoomRepositoryImpl{
+		rooms:   make(map[uint]*chatroom.ChatRoom),
+		members: make(map[uint]map[uint]struct{}),
+		nextID:  1,
+	}
+}
+
+func (r *chatRoomRepositoryImpl) Create(ctx context.Context, chatRoom *chatroom.ChatRoom) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.create(chatRoom)
+}
+
+func (r *chatRoomRepositoryImpl) GetByID(ctx context.Context, id uint) (*chatroom.ChatRoom, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	room, ok := r.rooms[id]
+	if !ok || room.DeletedAt.Valid {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return copyChatRoom(room), nil
+}
+
+func (r *chatRoomRepositoryImpl) GetByLocation(ctx context.Context, country, city string, roomType chatroom.RoomType) (*chatroom.ChatRoom, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	room := r.findByLocation(country, city, roomType)
+	if room == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return copyChatRoom(room), nil
+}
+
+func (r *chatRoomRepositoryImpl) GetOrCreatePublicRoom(ctx context.Context, country, city string) (*chatroom.ChatRoom, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if room := r.findByLocation(country, city, chatroom.RoomTypePublic); room != nil {
+		return copyChatRoom(room), nil
+	}
+
+	newRoom := &chatroom.ChatRoom{
+		Country:  country,
+		City:     city,
+		RoomType: chatroom.RoomTypePublic,
+	}
+	newRoom.GeneratePublicRoomName()
+
+	if err := r.create(newRoom); err != nil {
+		return nil, err
+	}
+	return newRoom, nil
+}
+
+func (r *chatRoomRepositoryImpl) GetOrCreatePrivateRoom(ctx context.Context, country, city string, user1ID, user2ID uint, user1Name, user2Name string) (*chatroom.ChatRoom, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	pairKey := chatroom.PrivatePairKey(user1ID, user2ID)
+	for _, room := range r.rooms {
+		if room.PairKey != nil && *room.PairKey == pairKey && !room.DeletedAt.Valid {
+			return copyChatRoom(room), nil
+		}
+	}
+
+	newRoom := &chatroom.ChatRoom{
+		Country:  country,
+		City:     city,
+		RoomType: chatroom.RoomTypePrivate,
+		PairKey:  &pairKey,
+	}
+	newRoom.GeneratePrivateRoomName(user1Name, user2Name)
+
+	if err := r.create(newRoom); err != nil {
+		return nil, err
+	}
+	r.members[newRoom.ID] = map[uint]struct{}{
+		user1ID: {},
+		user2ID: {},
+	}
+	return newRoom, nil
+}
+
+func (r *chatRoomRepositoryImpl) IsMember(ctx context.Context, roomID, userID uint) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.members[roomID][userID]
+	return ok, nil
+}
+
+func (r *chatRoomRepositoryImpl) GetByMember(ctx context.Context, userID uint) ([]*chatroom.ChatRoom, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rooms := make([]*chatroom.ChatRoom, 0)
+	for roomID, members := range r.members {
+		room, ok := r.rooms[roomID]
+		if !ok || room.DeletedAt.Valid {
+			continue
+		}
+		if _, ok := members[userID]; ok {
+			rooms = append(rooms, copyChatRoom(room))
+		}
+	}
+
+	// 최근 생성순
+	sort.Slice(rooms, func(i, j int) bool {
+		if !rooms[i].CreatedAt.Equal(rooms[j].CreatedAt) {
+			return rooms[i].CreatedAt.After(rooms[j].CreatedAt)
+		}
+		return rooms[i].ID > rooms[j].ID
+	})
+	return rooms, nil
+}
+
+// Update - 전체 필드 저장 (GORM Save와 같이 없는 ID는 새로 저장)
+func (r *chatRoomRepositoryImpl) Update(ctx context.Context, chatRoom *chatroom.ChatRoom) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if chatRoom.ID == 0 {
+		return r.create(chatRoom)
+	}
+	if r.pairKeyTaken(chatRoom.PairKey, chatRoom.ID) {
+		return gorm.ErrDuplicatedKey
+	}
+
+	chatRoom.UpdatedAt = time.Now()
+	if chatRoom.ID >= r.nextID {
+		r.nextID = chatRoom.ID + 1
+	}
+	r.rooms[chatRoom.ID] = copyChatRoom(chatRoom)
+	return nil
+}
+
+// Delete - 소프트 삭제 (같은 사용자 쌍이 다시 열 수 있도록 쌍 키도 해제)
+func (r *chatRoomRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if room, ok := r.rooms[id]; ok && !room.DeletedAt.Valid {
+		room.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+		room.PairKey = nil
+	}
+	return nil
+}
+
+// create - 채팅방 저장 (호출자가 쓰기 잠금 보유)
+func (r *chatRoomRepositoryImpl) create(chatRoom *chatroom.ChatRoom) error {
+	if chatRoom.ID != 0 {
+		if _, ok := r.rooms[chatRoom.ID]; ok {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+	// pair_key 유니크 인덱스 (삭제된 채팅방 포함)
+	if r.pairKeyTaken(chatRoom.PairKey, 0) {
+		return gorm.ErrDuplicatedKey
+	}
+
+	now := time.Now()
+	if chatRoom.ID == 0 {
+		chatRoom.ID = r.nextID
+	}
+	if chatRoom.ID >= r.nextID {
+		r.nextID = chatRoom.ID + 1
+	}
+	if chatRoom.CreatedAt.IsZero() {
+		chatRoom.CreatedAt = now
+	}
+	if chatRoom.UpdatedAt.IsZero() {
+		chatRoom.UpdatedAt = now
+	}
+
+	r.rooms[chatRoom.ID] = copyChatRoom(chatRoom)
+	return nil
+}
+
+// findByLocation - 조건에 맞는 가장 작은 ID의 채팅방 (GORM First와 동일, 호출자가 잠금 보유)
+func (r *chatRoomRepositoryImpl) findByLocation(country, city string, roomType chatroom.RoomType) *chatroom.ChatRoom {
+	var found *chatroom.ChatRoom
+	for _, room := range r.rooms {
+		if room.DeletedAt.Valid || room.Country != country || room.City != city || room.RoomType != roomType {
+			continue
+		}
+		if found == nil || room.ID < found.ID {
+			found = room
+		}
+	}
+	return found
+}
+
+func (r *chatRoomRepositoryImpl) pairKeyTaken(pairKey *string, exceptID uint) bool {
+	if pairKey == nil {
+		return false
+	}
+	for _, room := range r.rooms {
+		if room.ID != exceptID && room.PairKey != nil && *room.PairKey == *pairKey {
+			return true
+		}
+	}
+	return false
+}
+
+func copyChatRoom(room *chatroom.ChatRoom) *chatroom.ChatRoom {
+	c := *room
+	if room.PairKey != nil {
+		pairKey := *room.PairKey
+		c.PairKey = &pairKey
+	}
+	return &c
+}
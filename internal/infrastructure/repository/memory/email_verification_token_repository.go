@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type emailVerificationTokenRepositoryImpl struct {
+	mu     sync.RWMutex
+	tokens map[uint]*token.EmailVerificationToken
+	nextID uint
+}
+
+// NewEmailVerificationTokenRepository - 메모리 기반 EmailVerificationTokenRepository 생성자
+func NewEmailVerificationTokenRepository() repository.EmailVerificationTokenRepository {
+	return &emailVerificationTokenRepositoryImpl{
+		tokens: make(map[uint]*token.EmailVerificationToken),
+		nextID: 1,
+	}
+}
+
+func (r *emailVerificationTokenRepositoryImpl) Create(ctx context.Context, verificationToken *token.EmailVerificationToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.tokens {
+		if existing.TokenHash == verificationToken.TokenHash {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+
+	if verificationToken.ID == 0 {
+		verificationToken.ID = r.nextID
+	}
+	if verificationToken.ID >= r.nextID {
+		r.nextID = verificationToken.ID + 1
+	}
+	if verificationToken.CreatedAt.IsZero() {
+		verificationToken.CreatedAt = time.Now()
+	}
+
+	r.tokens[verificationToken.ID] = copyEmailVerificationToken(verificationToken)
+	return nil
+}
+
+func (r *emailVerificationTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*token.EmailVerificationToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, verificationToken := range r.tokens {
+		if verificationToken.TokenHash == tokenHash {
+			return copyEmailVerificationToken(verificationToken), nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *emailVerificationTokenRepositoryImpl) MarkUsedIfActive(ctx context.Context, id uint) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	verificationToken, ok := r.tokens[id]
+	if !ok || verificationToken.IsUsed() {
+		return false, nil
+	}
+	now := time.Now()
+	verificationToken.UsedAt = &now
+	return true, nil
+}
+
+func (r *emailVerificationTokenRepositoryImpl) InvalidateAllByUser(ctx context.Context, userID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, verificationToken := range r.tokens {
+		if verificationToken.UserID == userID && !verificationToken.IsUsed() {
+			usedAt := now
+			verificationToken.UsedAt = &usedAt
+		}
+	}
+	return nil
+}
+
+func copyEmailVerificationToken(verificationToken *token.EmailVerificationToken) *token.EmailVerificationToken {
+	c := *verificationToken
+	if verificationToken.UsedAt != nil {
+		usedAt := *verificationToken.UsedAt
+		c.UsedAt = &usedAt
+	}
+	return &c
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+)
+
+type loginHistoryRepositoryImpl struct {
+	mu        sync.RWMutex
+	histories []*user.LoginHistory
+	nextID    uint
+}
+
+// NewLoginHistoryRepository - 메모리 기반 LoginHistoryRepository 생성자
+func NewLoginHistoryRepository() repository.LoginHistoryRepository {
+	return &loginHistoryRepositoryImpl{
+		nextID: 1,
+	}
+}
+
+func (r *loginHistoryRepositoryImpl) Create(ctx context.Context, history *user.LoginHistory) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if history.ID == 0 {
+		history.ID = r.nextID
+	}
+	if history.ID >= r.nextID {
+		r.nextID = history.ID + 1
+	}
+	if history.CreatedAt.IsZero() {
+		history.CreatedAt = time.Now()
+	}
+
+	c := *history
+	r.histories = append(r.histories, &c)
+	return nil
+}
+
+func (r *loginHistoryRepositoryImpl) ListByUser(ctx context.Context, userID uint, offset, limit int) ([]*user.LoginHistory, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	histories := r.byUser(userID)
+	sort.Slice(histories, func(i, j int) bool {
+		if !histories[i].CreatedAt.Equal(histories[j].CreatedAt) {
+			return histories[i].CreatedAt.After(histories[j].CreatedAt)
+		}
+		return histories[i].ID > histories[j].ID
+	})
+	return paginate(histories, offset, limit), nil
+}
+
+func (r *loginHistoryRepositoryImpl) CountByUser(ctx context.Context, userID uint) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.byUser(userID))), nil
+}
+
+func (r *loginHistoryRepositoryImpl) KnownIPs(ctx context.Context, userID uint) ([]string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]struct{})
+	ips := make([]string, 0)
+	for _, history := range r.byUser(userID) {
+		if !history.Success || history.IP == "" {
+			continue
+		}
+		if _, ok := seen[history.IP]; !ok {
+			seen[history.IP] = struct{}{}
+			ips = append(ips, history.IP)
+		}
+	}
+	return ips, nil
+}
+
+// byUser - 사용자의 기록 복사본 (호출자가 잠금 보유)
+func (r *loginHistoryRepositoryImpl) byUser(userID uint) []*user.LoginHistory {
+	histories := make([]*user.LoginHistory, 0)
+	for _, history := range r.histories {
+		if history.UserID == userID {
+			c := *history
+			histories = append(histories, &c)
+		}
+	}
+	return histories
+}
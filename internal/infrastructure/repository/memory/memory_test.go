@@ -0,0 +1,69 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/repositorytest"
+)
+
+func TestUserRepository(t *testing.T) {
+	repositorytest.RunUserRepositoryContract(t, func(t *testing.T) repository.UserRepository {
+		return memory.NewUserRepository()
+	})
+}
+
+func TestChatRoomRepository(t *testing.T) {
+	repositorytest.RunChatRoomRepositoryContract(t, func(t *testing.T) repository.ChatRoomRepository {
+		return memory.NewChatRoomRepository()
+	})
+}
+
+func TestMessageRepository(t *testing.T) {
+	repositorytest.RunMessageRepositoryContract(t, func(t *testing.T) repository.MessageRepository {
+		return memory.NewMessageRepository()
+	})
+}
+
+func TestRefreshTokenRepository(t *testing.T) {
+	repositorytest.RunRefreshTokenRepositoryContract(t, func(t *testing.T) repository.RefreshTokenRepository {
+		return memory.NewRefreshTokenRepository()
+	})
+}
+
+func TestLoginHistoryRepository(t *testing.T) {
+	repositorytest.RunLoginHistoryRepositoryContract(t, func(t *testing.T) repository.LoginHistoryRepository {
+		return memory.NewLoginHistoryRepository()
+	})
+}
+
+func TestPasswordResetTokenRepository(t *testing.T) {
+	repositorytest.RunPasswordResetTokenRepositoryContract(t, func(t *testing.T) repository.PasswordResetTokenRepository {
+		return memory.NewPasswordResetTokenRepository()
+	})
+}
+
+func TestEmailVerificationTokenRepository(t *testing.T) {
+	repositorytest.RunEmailVerificationTokenRepositoryContract(t, func(t *testing.T) repository.EmailVerificationTokenRepository {
+		return memory.NewEmailVerificationTokenRepository()
+	})
+}
+
+func TestUserBlockRepository(t *testing.T) {
+	repositorytest.RunUserBlockRepositoryContract(t, func(t *testing.T) repository.UserBlockRepository {
+		return memory.NewUserBlockRepository()
+	})
+}
+
+func TestUserReportRepository(t *testing.T) {
+	repositorytest.RunUserReportRepositoryContract(t, func(t *testing.T) repository.UserReportRepository {
+		return memory.NewUserReportRepository()
+	})
+}
+
+func TestBlobRepository(t *testing.T) {
+	repositorytest.RunBlobRepositoryContract(t, func(t *testing.T) repository.BlobRepository {
+		return memory.NewBlobRepository()
+	})
+}
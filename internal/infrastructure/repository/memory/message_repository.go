@@ -0,0 +1,184 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type messageRepositoryImpl struct {
+	mu       sync.RWMutex
+	messages map[uint]*message.Message
+	nextID   uint
+}
+
+// NewMessageRepository - 메모리 기반 MessageRepository 생성자
+func NewMessageRepository() repository.MessageRepository {
+	return &messageRepositoryImpl{
+		messages: make(map[uint]*message.Message),
+		nextID:   1,
+	}
+}
+
+func (r *messageRepositoryImpl) Create(ctx context.Context, msg *message.Message) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if msg.ID != 0 {
+		if _, ok := r.messages[msg.ID]; ok {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+
+	now := time.Now()
+	if msg.ID == 0 {
+		msg.ID = r.nextID
+	}
+	if msg.ID >= r.nextID {
+		r.nextID = msg.ID + 1
+	}
+	if msg.CreatedAt.IsZero() {
+		msg.CreatedAt = now
+	}
+	if msg.UpdatedAt.IsZero() {
+		msg.UpdatedAt = now
+	}
+
+	r.messages[msg.ID] = copyMessage(msg)
+	return nil
+}
+
+func (r *messageRepositoryImpl) GetByID(ctx context.Context, id uint) (*message.Message, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	msg, ok := r.messages[id]
+	if !ok || msg.DeletedAt.Valid {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return copyMessage(msg), nil
+}
+
+// GetByChatRoom - 채팅방의 만료되지 않은 메시지를 최신순으로 최대 limit개 조회
+func (r *messageRepositoryImpl) GetByChatRoom(ctx context.Context, chatRoomID uint, excludeUserIDs []uint, limit int) ([]*message.Message, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+	messages := make([]*message.Message, 0)
+	for _, msg := range r.messages {
+		if msg.DeletedAt.Valid || msg.ChatRoomID != chatRoomID || slices.Contains(excludeUserIDs, msg.UserID) {
+			continue
+		}
+		if msg.ExpiresAt != nil && !msg.ExpiresAt.After(now) {
+			continue
+		}
+		messages = append(messages, copyMessage(msg))
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		if !messages[i].CreatedAt.Equal(messages[j].CreatedAt) {
+			return messages[i].CreatedAt.After(messages[j].CreatedAt)
+		}
+		return messages[i].ID > messages[j].ID
+	})
+	return paginate(messages, 0, limit), nil
+}
+
+// DeleteExpired - 만료된 메시지 소프트 삭제
+func (r *messageRepositoryImpl) DeleteExpired(ctx context.Context) error {
+	return r.DeleteExpiredBefore(ctx, time.Now())
+}
+
+// DeleteExpiredBefore - before 이전에 만료된 메시지 소프트 삭제
+func (r *messageRepositoryImpl) DeleteExpiredBefore(ctx context.Context, before time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, msg := range r.messages {
+		if !msg.DeletedAt.Valid && msg.ExpiresAt != nil && msg.ExpiresAt.Before(before) {
+			msg.DeletedAt = gorm.DeletedAt{Time: now, Valid: true}
+		}
+	}
+	return nil
+}
+
+// DeleteExpiredBatch - 만료된 메시지를 최대 batchSize개까지 영구 삭제하고 삭제된 행 수 반환
+func (r *messageRepositoryImpl) DeleteExpiredBatch(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 소프트 삭제된 메시지도 포함 (Unscoped)
+	expired := make([]*message.Message, 0)
+	for _, msg := range r.messages {
+		if msg.ExpiresAt != nil && msg.ExpiresAt.Before(before) {
+			expired = append(expired, msg)
+		}
+	}
+
+	sort.Slice(expired, func(i, j int) bool {
+		return expired[i].ExpiresAt.Before(*expired[j].ExpiresAt)
+	})
+	expired = paginate(expired, 0, batchSize)
+
+	for _, msg := range expired {
+		delete(r.messages, msg.ID)
+	}
+	return int64(len(expired)), nil
+}
+
+// Purge - 채팅방/작성자 조건에 맞는 메시지 영구 삭제 (0인 조건은 무시, 둘 다 0이면 아무것도 삭제하지 않음)
+func (r *messageRepositoryImpl) Purge(ctx context.Context, chatRoomID, userID uint) (int64, error) {
+	if chatRoomID == 0 && userID == 0 {
+		return 0, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var deleted int64
+	for id, msg := range r.messages {
+		if chatRoomID != 0 && msg.ChatRoomID != chatRoomID {
+			continue
+		}
+		if userID != 0 && msg.UserID != userID {
+			continue
+		}
+		delete(r.messages, id)
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (r *messageRepositoryImpl) Count(ctx context.Context) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var count int64
+	for _, msg := range r.messages {
+		if !msg.DeletedAt.Valid {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func copyMessage(msg *message.Message) *message.Message {
+	c := *msg
+	if msg.ExpiresAt != nil {
+		expiresAt := *msg.ExpiresAt
+		c.ExpiresAt = &expiresAt
+	}
+	if msg.BlobID != nil {
+		blobID := *msg.BlobID
+		c.BlobID = &blobID
+	}
+	return &c
+}
@@ -0,0 +1,15 @@
+package memory
+
+// paginate - offset/limit 적용 (GORM과 같이 음수면 적용하지 않음)
+func paginate[T any](items []T, offset, limit int) []T {
+	if offset > 0 {
+		if offset >= len(items) {
+			return items[:0]
+		}
+		items = items[offset:]
+	}
+	if limit >= 0 && limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type passwordResetTokenRepositoryImpl struct {
+	mu     sync.RWMutex
+	tokens map[uint]*token.PasswordResetToken
+	nextID uint
+}
+
+// NewPasswordResetTokenRepository - 메모리 기반 PasswordResetTokenRepository 생성자
+func NewPasswordResetTokenRepository() repository.PasswordResetTokenRepository {
+	return &passwordResetTokenRepositoryImpl{
+		tokens: make(map[uint]*token.PasswordResetToken),
+		nextID: 1,
+	}
+}
+
+func (r *passwordResetTokenRepositoryImpl) Create(ctx context.Context, resetToken *token.PasswordResetToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.tokens {
+		if existing.TokenHash == resetToken.TokenHash {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+
+	if resetToken.ID == 0 {
+		resetToken.ID = r.nextID
+	}
+	if resetToken.ID >= r.nextID {
+		r.nextID = resetToken.ID + 1
+	}
+	if resetToken.CreatedAt.IsZero() {
+		resetToken.CreatedAt = time.Now()
+	}
+
+	r.tokens[resetToken.ID] = copyPasswordResetToken(resetToken)
+	return nil
+}
+
+func (r *passwordResetTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*token.PasswordResetToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, resetToken := range r.tokens {
+		if resetToken.TokenHash == tokenHash {
+			return copyPasswordResetToken(resetToken), nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *passwordResetTokenRepositoryImpl) MarkUsedIfActive(ctx context.Context, id uint) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resetToken, ok := r.tokens[id]
+	if !ok || resetToken.IsUsed() {
+		return false, nil
+	}
+	now := time.Now()
+	resetToken.UsedAt = &now
+	return true, nil
+}
+
+func (r *passwordResetTokenRepositoryImpl) InvalidateAllByUser(ctx context.Context, userID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, resetToken := range r.tokens {
+		if resetToken.UserID == userID && !resetToken.IsUsed() {
+			usedAt := now
+			resetToken.UsedAt = &usedAt
+		}
+	}
+	return nil
+}
+
+func copyPasswordResetToken(resetToken *token.PasswordResetToken) *token.PasswordResetToken {
+	c := *resetToken
+	if resetToken.UsedAt != nil {
+		usedAt := *resetToken.UsedAt
+		c.UsedAt = &usedAt
+	}
+	return &c
+}
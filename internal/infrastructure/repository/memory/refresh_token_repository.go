@@ -0,0 +1,109 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type refreshTokenRepositoryImpl struct {
+	mu     sync.RWMutex
+	tokens map[string]*token.RefreshToken // JTI → 토큰
+	nextID uint
+}
+
+// NewRefreshTokenRepository - 메모리 기반 RefreshTokenRepository 생성자
+func NewRefreshTokenRepository() repository.RefreshTokenRepository {
+	return &refreshTokenRepositoryImpl{
+		tokens: make(map[string]*token.RefreshToken),
+		nextID: 1,
+	}
+}
+
+func (r *refreshTokenRepositoryImpl) Create(ctx context.Context, refreshToken *token.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tokens[refreshToken.JTI]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+
+	if refreshToken.ID == 0 {
+		refreshToken.ID = r.nextID
+	}
+	if refreshToken.ID >= r.nextID {
+		r.nextID = refreshToken.ID + 1
+	}
+	if refreshToken.CreatedAt.IsZero() {
+		refreshToken.CreatedAt = time.Now()
+	}
+
+	r.tokens[refreshToken.JTI] = copyRefreshToken(refreshToken)
+	return nil
+}
+
+func (r *refreshTokenRepositoryImpl) GetByJTI(ctx context.Context, jti string) (*token.RefreshToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	refreshToken, ok := r.tokens[jti]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return copyRefreshToken(refreshToken), nil
+}
+
+// RevokeIfActive - 아직 폐기되지 않은 토큰만 폐기
+func (r *refreshTokenRepositoryImpl) RevokeIfActive(ctx context.Context, jti, replacedBy string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	refreshToken, ok := r.tokens[jti]
+	if !ok || refreshToken.IsRevoked() {
+		return false, nil
+	}
+
+	now := time.Now()
+	refreshToken.RevokedAt = &now
+	refreshToken.ReplacedBy = replacedBy
+	return true, nil
+}
+
+func (r *refreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyID string) error {
+	return r.revokeWhere(func(t *token.RefreshToken) bool {
+		return t.FamilyID == familyID
+	})
+}
+
+func (r *refreshTokenRepositoryImpl) RevokeAllByUser(ctx context.Context, userID uint) error {
+	return r.revokeWhere(func(t *token.RefreshToken) bool {
+		return t.UserID == userID
+	})
+}
+
+func (r *refreshTokenRepositoryImpl) revokeWhere(match func(*token.RefreshToken) bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	for _, refreshToken := range r.tokens {
+		if !refreshToken.IsRevoked() && match(refreshToken) {
+			revokedAt := now
+			refreshToken.RevokedAt = &revokedAt
+		}
+	}
+	return nil
+}
+
+func copyRefreshToken(refreshToken *token.RefreshToken) *token.RefreshToken {
+	c := *refreshToken
+	if refreshToken.RevokedAt != nil {
+		revokedAt := *refreshToken.RevokedAt
+		c.RevokedAt = &revokedAt
+	}
+	return &c
+}
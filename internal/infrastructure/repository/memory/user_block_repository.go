@@ -0,0 +1,135 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type userBlockRepositoryImpl struct {
+	mu     sync.RWMutex
+	blocks map[uint]*user.UserBlock
+	nextID uint
+}
+
+// NewUserBlockRepository - 메모리 기반 UserBlockRepository 생성자
+func NewUserBlockRepository() repository.UserBlockRepository {
+	return &userBlockRepositoryImpl{
+		blocks: make(map[uint]*user.UserBlock),
+		nextID: 1,
+	}
+}
+
+func (r *userBlockRepositoryImpl) Create(ctx context.Context, block *user.UserBlock) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.find(block.BlockerID, block.BlockedID) != nil {
+		return gorm.ErrDuplicatedKey
+	}
+
+	if block.ID == 0 {
+		block.ID = r.nextID
+	}
+	if block.ID >= r.nextID {
+		r.nextID = block.ID + 1
+	}
+	if block.CreatedAt.IsZero() {
+		block.CreatedAt = time.Now()
+	}
+
+	c := *block
+	r.blocks[c.ID] = &c
+	return nil
+}
+
+func (r *userBlockRepositoryImpl) Delete(ctx context.Context, blockerID, blockedID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	block := r.find(blockerID, blockedID)
+	if block == nil {
+		return gorm.ErrRecordNotFound
+	}
+	delete(r.blocks, block.ID)
+	return nil
+}
+
+func (r *userBlockRepositoryImpl) Exists(ctx context.Context, blockerID, blockedID uint) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.find(blockerID, blockedID) != nil, nil
+}
+
+func (r *userBlockRepositoryImpl) ListByBlocker(ctx context.Context, blockerID uint) ([]*user.UserBlock, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	blocks := make([]*user.UserBlock, 0)
+	for _, block := range r.blocks {
+		if block.BlockerID == blockerID {
+			c := *block
+			blocks = append(blocks, &c)
+		}
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if !blocks[i].CreatedAt.Equal(blocks[j].CreatedAt) {
+			return blocks[i].CreatedAt.After(blocks[j].CreatedAt)
+		}
+		return blocks[i].ID > blocks[j].ID
+	})
+	return blocks, nil
+}
+
+func (r *userBlockRepositoryImpl) GetBlockedIDs(ctx context.Context, blockerID uint) ([]uint, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]uint, 0)
+	for _, block := range r.blocks {
+		if block.BlockerID == blockerID {
+			ids = append(ids, block.BlockedID)
+		}
+	}
+	return ids, nil
+}
+
+func (r *userBlockRepositoryImpl) GetRelatedIDs(ctx context.Context, userID uint) ([]uint, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[uint]struct{})
+	ids := make([]uint, 0)
+	for _, block := range r.blocks {
+		var other uint
+		switch userID {
+		case block.BlockerID:
+			other = block.BlockedID
+		case block.BlockedID:
+			other = block.BlockerID
+		default:
+			continue
+		}
+		if _, ok := seen[other]; !ok {
+			seen[other] = struct{}{}
+			ids = append(ids, other)
+		}
+	}
+	return ids, nil
+}
+
+// find - 차단 기록 조회 (호출자가 잠금 보유)
+func (r *userBlockRepositoryImpl) find(blockerID, blockedID uint) *user.UserBlock {
+	for _, block := range r.blocks {
+		if block.BlockerID == blockerID && block.BlockedID == blockedID {
+			return block
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+)
+
+type userReportRepositoryImpl struct {
+	mu      sync.RWMutex
+	reports []*user.UserReport
+	nextID  uint
+}
+
+// NewUserReportRepository - 메모리 기반 UserReportRepository 생성자
+func NewUserReportRepository() repository.UserReportRepository {
+	return &userReportRepositoryImpl{
+		nextID: 1,
+	}
+}
+
+func (r *userReportRepositoryImpl) Create(ctx context.Context, report *user.UserReport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if report.ID == 0 {
+		report.ID = r.nextID
+	}
+	if report.ID >= r.nextID {
+		r.nextID = report.ID + 1
+	}
+	if report.CreatedAt.IsZero() {
+		report.CreatedAt = time.Now()
+	}
+
+	c := *report
+	r.reports = append(r.reports, &c)
+	return nil
+}
+
+func (r *userReportRepositoryImpl) List(ctx context.Context, reportedID uint, offset, limit int) ([]*user.UserReport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	reports := r.byReported(reportedID)
+	sort.Slice(reports, func(i, j int) bool {
+		if !reports[i].CreatedAt.Equal(reports[j].CreatedAt) {
+			return reports[i].CreatedAt.After(reports[j].CreatedAt)
+		}
+		return reports[i].ID > reports[j].ID
+	})
+	return paginate(reports, offset, limit), nil
+}
+
+func (r *userReportRepositoryImpl) Count(ctx context.Context, reportedID uint) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.byReported(reportedID))), nil
+}
+
+// byReported - 신고 대상의 기록 복사본, 0이면 전체 (호출자가 잠금 보유)
+func (r *userReportRepositoryImpl) byReported(reportedID uint) []*user.UserReport {
+	reports := make([]*user.UserReport, 0)
+	for _, report := range r.reports {
+		if reportedID == 0 || report.ReportedID == reportedID {
+			c := *report
+			reports = append(reports, &c)
+		}
+	}
+	return reports
+}
@@ -0,0 +1,355 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type userRepositoryImpl struct {
+	mu     sync.RWMutex
+	users  map[uint]*user.User
+	nextID uint
+}
+
+// NewUserRepository - 메모리 기반 UserRepository 생성자
+func NewUserRepository() repository.UserRepository {
+	return &userRepositoryImpl{
+		users:  make(map[uint]*user.User),
+		nextID: 1,
+	}
+}
+
+func (r *userRepositoryImpl) Create(ctx context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 이메일 유니크 인덱스 (삭제된 사용자 포함)
+	for _, existing := range r.users {
+		if existing.Email == u.Email {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+
+	now := time.Now()
+	if u.ID == 0 {
+		u.ID = r.nextID
+	} else if _, ok := r.users[u.ID]; ok {
+		return gorm.ErrDuplicatedKey
+	}
+	if u.ID >= r.nextID {
+		r.nextID = u.ID + 1
+	}
+	if u.CreatedAt.IsZero() {
+		u.CreatedAt = now
+	}
+	if u.UpdatedAt.IsZero() {
+		u.UpdatedAt = now
+	}
+	u.LastActive = now
+
+	r.users[u.ID] = copyUser(u)
+	return nil
+}
+
+func (r *userRepositoryImpl) GetByID(ctx context.Context, id uint) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[id]
+	if !ok || u.DeletedAt.Valid {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return copyUser(u), nil
+}
+
+func (r *userRepositoryImpl) GetByIDs(ctx context.Context, ids []uint) ([]*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	wanted := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+
+	return r.filter(func(u *user.User) bool {
+		_, ok := wanted[u.ID]
+		return ok
+	}), nil
+}
+
+func (r *userRepositoryImpl) GetByEmail(ctx context.Context, email string) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := r.filter(func(u *user.User) bool {
+		return u.Email == email
+	})
+	if len(users) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return users[0], nil
+}
+
+// Update - 전체 필드 저장 (GORM Save와 같이 없는 ID는 새로 저장)
+func (r *userRepositoryImpl) Update(ctx context.Context, u *user.User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if existing.ID != u.ID && existing.Email == u.Email {
+			return gorm.ErrDuplicatedKey
+		}
+	}
+
+	now := time.Now()
+	u.UpdatedAt = now
+	u.LastActive = now // autoUpdateTime
+	if u.CreatedAt.IsZero() {
+		u.CreatedAt = now
+	}
+	if u.ID == 0 {
+		u.ID = r.nextID
+	}
+	if u.ID >= r.nextID {
+		r.nextID = u.ID + 1
+	}
+
+	r.users[u.ID] = copyUser(u)
+	return nil
+}
+
+// Delete - 소프트 삭제
+func (r *userRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if u, ok := r.users[id]; ok && !u.DeletedAt.Valid {
+		u.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	}
+	return nil
+}
+
+func (r *userRepositoryImpl) List(ctx context.Context, excludeIDs []uint, offset, limit int) ([]*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	users := r.filter(discoverableExcept(excludeIDs))
+	return paginate(users, offset, limit), nil
+}
+
+func (r *userRepositoryImpl) GetByDestination(ctx context.Context, country, city string) ([]*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.filter(func(u *user.User) bool {
+		return u.EmailVerified && u.Country == country && u.City == city
+	}), nil
+}
+
+func (r *userRepositoryImpl) GetByTrip(ctx context.Context, filter repository.TripFilter, excludeIDs []uint) ([]*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	discoverable := discoverableExcept(excludeIDs)
+	return r.filter(func(u *user.User) bool {
+		return discoverable(u) && u.Country == filter.Country &&
+			u.TravelStart.Before(filter.End) && u.TravelEnd.After(filter.Start) && !u.TravelEnd.Before(filter.EndsAfter)
+	}), nil
+}
+
+func (r *userRepositoryImpl) UpdateLastActive(ctx context.Context, userID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if u, ok := r.users[userID]; ok && !u.DeletedAt.Valid {
+		now := time.Now()
+		u.LastActive = now
+		u.UpdatedAt = now
+	}
+	return nil
+}
+
+func (r *userRepositoryImpl) Count(ctx context.Context, excludeIDs []uint) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.filter(discoverableExcept(excludeIDs)))), nil
+}
+
+func (r *userRepositoryImpl) IncrementFailedLogins(ctx context.Context, userID uint) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[userID]
+	if !ok || u.DeletedAt.Valid {
+		return 0, gorm.ErrRecordNotFound
+	}
+	u.FailedLoginAttempts++
+	return u.FailedLoginAttempts, nil
+}
+
+func (r *userRepositoryImpl) LockUntil(ctx context.Context, userID uint, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if u, ok := r.users[userID]; ok && !u.DeletedAt.Valid {
+		u.LockedUntil = &until
+	}
+	return nil
+}
+
+func (r *userRepositoryImpl) ResetLoginFailures(ctx context.Context, userID uint, lastActive time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if u, ok := r.users[userID]; ok && !u.DeletedAt.Valid {
+		u.FailedLoginAttempts = 0
+		u.LockedUntil = nil
+		u.LastActive = lastActive
+	}
+	return nil
+}
+
+// GetByIDIncludingSuspended - 정지(소프트 삭제)된 사용자도 조회
+func (r *userRepositoryImpl) GetByIDIncludingSuspended(ctx context.Context, id uint) (*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.users[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return copyUser(u), nil
+}
+
+func (r *userRepositoryImpl) ListForAdmin(ctx context.Context, filter repository.UserFilter, offset, limit int) ([]*user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return paginate(r.adminFilter(filter), offset, limit), nil
+}
+
+func (r *userRepositoryImpl) CountForAdmin(ctx context.Context, filter repository.UserFilter) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.adminFilter(filter))), nil
+}
+
+// Restore - 정지된 사용자 복구 (deleted_at 해제)
+func (r *userRepositoryImpl) Restore(ctx context.Context, id uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok || !u.DeletedAt.Valid {
+		return gorm.ErrRecordNotFound
+	}
+	u.DeletedAt = gorm.DeletedAt{}
+	return nil
+}
+
+func (r *userRepositoryImpl) UpdateAvatarIfUnchanged(ctx context.Context, userID uint, previousID, avatarID *string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[userID]
+	if !ok || u.DeletedAt.Valid {
+		return false, nil
+	}
+	if (u.AvatarID == nil) != (previousID == nil) || (u.AvatarID != nil && *u.AvatarID != *previousID) {
+		return false, nil
+	}
+	if avatarID != nil {
+		id := *avatarID
+		avatarID = &id
+	}
+	u.AvatarID = avatarID
+	u.UpdatedAt = time.Now()
+	return true, nil
+}
+
+func (r *userRepositoryImpl) UpdateRole(ctx context.Context, id uint, role user.Role) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, ok := r.users[id]
+	if !ok || u.DeletedAt.Valid {
+		return gorm.ErrRecordNotFound
+	}
+	u.Role = role
+	u.UpdatedAt = time.Now()
+	return nil
+}
+
+// adminFilter - 정지된 사용자를 포함해 조건에 맞는 사용자를 ID 순으로 복사해서 반환 (호출자가 잠금 보유)
+func (r *userRepositoryImpl) adminFilter(filter repository.UserFilter) []*user.User {
+	users := make([]*user.User, 0)
+	for _, u := range r.users {
+		if filter.Role != nil && u.Role != *filter.Role {
+			continue
+		}
+		if filter.Suspended != nil && u.DeletedAt.Valid != *filter.Suspended {
+			continue
+		}
+		users = append(users, copyUser(u))
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+	return users
+}
+
+// discoverableExcept - 이메일 인증을 마쳤고 excludeIDs에 없는 사용자
+func discoverableExcept(excludeIDs []uint) func(*user.User) bool {
+	return func(u *user.User) bool {
+		if !u.EmailVerified {
+			return false
+		}
+		for _, id := range excludeIDs {
+			if u.ID == id {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// filter - 삭제되지 않은 사용자 중 조건에 맞는 사용자를 ID 순으로 복사해서 반환 (호출자가 잠금 보유)
+func (r *userRepositoryImpl) filter(match func(*user.User) bool) []*user.User {
+	users := make([]*user.User, 0)
+	for _, u := range r.users {
+		if !u.DeletedAt.Valid && match(u) {
+			users = append(users, copyUser(u))
+		}
+	}
+
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+	return users
+}
+
+func copyUser(u *user.User) *user.User {
+	c := *u
+	if u.LockedUntil != nil {
+		lockedUntil := *u.LockedUntil
+		c.LockedUntil = &lockedUntil
+	}
+	if u.EmailVerifiedAt != nil {
+		verifiedAt := *u.EmailVerifiedAt
+		c.EmailVerifiedAt = &verifiedAt
+	}
+	if u.AvatarID != nil {
+		avatarID := *u.AvatarID
+		c.AvatarID = &avatarID
+	}
+	return &c
+}
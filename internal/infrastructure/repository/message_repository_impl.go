@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/chris910512/travel-chat/internal/domain/entity/message"
 	"github.com/chris910512/travel-chat/internal/domain/repository"
 	"gorm.io/gorm"
@@ -17,41 +18,76 @@ func NewMessageRepository(db *gorm.DB) repository.MessageRepository {
 	}
 }
 
-func (r *messageRepositoryImpl) Create(message *message.Message) error {
-	return r.db.Create(message).Error
+func (r *messageRepositoryImpl) Create(ctx context.Context, message *message.Message) error {
+	return r.db.WithContext(ctx).Create(message).Error
 }
 
-func (r *messageRepositoryImpl) GetByID(id uint) (*message.Message, error) {
+func (r *messageRepositoryImpl) GetByID(ctx context.Context, id uint) (*message.Message, error) {
 	var msg message.Message
-	err := r.db.First(&msg, id).Error
+	err := r.db.WithContext(ctx).First(&msg, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &msg, nil
 }
 
-func (r *messageRepositoryImpl) GetByChatRoom(chatRoomID uint, limit int) ([]*message.Message, error) {
+// GetByChatRoom - 채팅방의 만료되지 않은 메시지를 최신순으로 최대 limit개 조회
+func (r *messageRepositoryImpl) GetByChatRoom(ctx context.Context, chatRoomID uint, excludeUserIDs []uint, limit int) ([]*message.Message, error) {
 	var messages []*message.Message
-	err := r.db.Where("chat_room_id = ?", chatRoomID).
-		Order("created_at DESC").
+	query := r.db.WithContext(ctx).Where("chat_room_id = ?", chatRoomID).
+		Where("expires_at IS NULL OR expires_at > ?", time.Now())
+	if len(excludeUserIDs) > 0 {
+		query = query.Where("user_id NOT IN ?", excludeUserIDs)
+	}
+	err := query.Order("created_at DESC, id DESC").
 		Limit(limit).
 		Find(&messages).Error
 	return messages, err
 }
 
-func (r *messageRepositoryImpl) DeleteExpired() error {
+func (r *messageRepositoryImpl) DeleteExpired(ctx context.Context) error {
 	now := time.Now()
-	return r.db.Where("expires_at IS NOT NULL AND expires_at < ?", now).
+	return r.db.WithContext(ctx).Where("expires_at IS NOT NULL AND expires_at < ?", now).
 		Delete(&message.Message{}).Error
 }
 
-func (r *messageRepositoryImpl) DeleteExpiredBefore(before time.Time) error {
-	return r.db.Where("expires_at IS NOT NULL AND expires_at < ?", before).
+func (r *messageRepositoryImpl) DeleteExpiredBefore(ctx context.Context, before time.Time) error {
+	return r.db.WithContext(ctx).Where("expires_at IS NOT NULL AND expires_at < ?", before).
 		Delete(&message.Message{}).Error
 }
 
-func (r *messageRepositoryImpl) Count() (int64, error) {
+// DeleteExpiredBatch - 만료된 메시지를 최대 batchSize개까지 영구 삭제하고 삭제된 행 수 반환
+func (r *messageRepositoryImpl) DeleteExpiredBatch(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	expiredIDs := r.db.WithContext(ctx).Unscoped().Model(&message.Message{}).
+		Select("id").
+		Where("expires_at IS NOT NULL AND expires_at < ?", before).
+		Order("expires_at").
+		Limit(batchSize)
+
+	result := r.db.WithContext(ctx).Unscoped().Where("id IN (?)", expiredIDs).Delete(&message.Message{})
+	return result.RowsAffected, result.Error
+}
+
+// Purge - 채팅방/작성자 조건에 맞는 메시지 영구 삭제 (0인 조건은 무시, 둘 다 0이면 아무것도 삭제하지 않음)
+func (r *messageRepositoryImpl) Purge(ctx context.Context, chatRoomID, userID uint) (int64, error) {
+	if chatRoomID == 0 && userID == 0 {
+		return 0, nil
+	}
+
+	db := r.db.WithContext(ctx).Unscoped()
+	if chatRoomID != 0 {
+		db = db.Where("chat_room_id = ?", chatRoomID)
+	}
+	if userID != 0 {
+		db = db.Where("user_id = ?", userID)
+	}
+
+	result := db.Delete(&message.Message{})
+	return result.RowsAffected, result.Error
+}
+
+func (r *messageRepositoryImpl) Count(ctx context.Context) (int64, error) {
 	var count int64
-	err := r.db.Model(&message.Message{}).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&message.Message{}).Count(&count).Error
 	return count, err
 }
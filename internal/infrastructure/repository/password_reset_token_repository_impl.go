@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type passwordResetTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewPasswordResetTokenRepository(db *gorm.DB) repository.PasswordResetTokenRepository {
+	return &passwordResetTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *passwordResetTokenRepositoryImpl) Create(ctx context.Context, resetToken *token.PasswordResetToken) error {
+	return r.db.WithContext(ctx).Create(resetToken).Error
+}
+
+func (r *passwordResetTokenRepositoryImpl) GetByHash(ctx context.Context, tokenHash string) (*token.PasswordResetToken, error) {
+	var resetToken token.PasswordResetToken
+	err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&resetToken).Error
+	if err != nil {
+		return nil, err
+	}
+	return &resetToken, nil
+}
+
+// MarkUsedIfActive - 아직 사용되지 않은 토큰만 사용 처리 (동시에 같은 토큰을 써도 한 요청만 성공)
+func (r *passwordResetTokenRepositoryImpl) MarkUsedIfActive(ctx context.Context, id uint) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&token.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	return result.RowsAffected == 1, result.Error
+}
+
+// InvalidateAllByUser - 사용자의 남은 토큰 전체 무효화 (새 토큰 발급, 비밀번호 변경 시)
+func (r *passwordResetTokenRepositoryImpl) InvalidateAllByUser(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Model(&token.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", time.Now()).Error
+}
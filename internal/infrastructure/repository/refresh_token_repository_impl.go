@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type refreshTokenRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRefreshTokenRepository(db *gorm.DB) repository.RefreshTokenRepository {
+	return &refreshTokenRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *refreshTokenRepositoryImpl) Create(ctx context.Context, refreshToken *token.RefreshToken) error {
+	return r.db.WithContext(ctx).Create(refreshToken).Error
+}
+
+func (r *refreshTokenRepositoryImpl) GetByJTI(ctx context.Context, jti string) (*token.RefreshToken, error) {
+	var refreshToken token.RefreshToken
+	err := r.db.WithContext(ctx).Where("jti = ?", jti).First(&refreshToken).Error
+	if err != nil {
+		return nil, err
+	}
+	return &refreshToken, nil
+}
+
+// RevokeIfActive - 아직 폐기되지 않은 토큰만 폐기 (동시에 같은 토큰으로 갱신하면 한 요청만 성공)
+func (r *refreshTokenRepositoryImpl) RevokeIfActive(ctx context.Context, jti, replacedBy string) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&token.RefreshToken{}).
+		Where("jti = ? AND revoked_at IS NULL", jti).
+		Updates(map[string]interface{}{
+			"revoked_at":  time.Now(),
+			"replaced_by": replacedBy,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+// RevokeFamily - 같은 계열의 토큰 전체 폐기
+func (r *refreshTokenRepositoryImpl) RevokeFamily(ctx context.Context, familyID string) error {
+	return r.db.WithContext(ctx).Model(&token.RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// RevokeAllByUser - 사용자의 모든 토큰 폐기 (모든 기기에서 로그아웃)
+func (r *refreshTokenRepositoryImpl) RevokeAllByUser(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Model(&token.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
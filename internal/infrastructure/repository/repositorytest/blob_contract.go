@@ -0,0 +1,77 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/blob"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunBlobRepositoryContract - BlobRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunBlobRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.BlobRepository) {
+	ctx := context.Background()
+	t.Run("CreateGetDelete", func(t *testing.T) {
+		repo := newRepo(t)
+		b := &blob.Blob{ID: "0123456789abcdef0123456789abcdef", OwnerID: 1, Kind: blob.KindImage, ContentType: "image/jpeg", Size: 1234, Width: 640, Height: 480}
+		if err := repo.Create(ctx, b); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		got, err := repo.GetByID(ctx, b.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.OwnerID != 1 || got.Kind != blob.KindImage || got.Width != 640 || got.Height != 480 || got.ExpiresAt != nil {
+			t.Errorf("stored blob mismatch: %+v", got)
+		}
+
+		if err := repo.Delete(ctx, b.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, b.ID); err != gorm.ErrRecordNotFound {
+			t.Errorf("expected ErrRecordNotFound after delete, got %v", err)
+		}
+	})
+
+	t.Run("ExpirationOnlyMovesLater", func(t *testing.T) {
+		repo := newRepo(t)
+		now := time.Now()
+		soon := now.Add(time.Hour)
+		expired := now.Add(-time.Minute)
+		kept := &blob.Blob{ID: "kept", OwnerID: 1, Kind: blob.KindImage, ContentType: "image/png"}
+		extended := &blob.Blob{ID: "extended", OwnerID: 1, Kind: blob.KindImage, ContentType: "image/png", ExpiresAt: &expired}
+		old := &blob.Blob{ID: "old", OwnerID: 1, Kind: blob.KindImage, ContentType: "image/png", ExpiresAt: &expired}
+		for _, b := range []*blob.Blob{kept, extended, old} {
+			if err := repo.Create(ctx, b); err != nil {
+				t.Fatalf("Create: %v", err)
+			}
+		}
+
+		for _, until := range []time.Time{soon, now} {
+			if err := repo.ExtendExpiration(ctx, extended.ID, until); err != nil {
+				t.Fatalf("ExtendExpiration: %v", err)
+			}
+		}
+		if err := repo.ExtendExpiration(ctx, kept.ID, soon); err != nil {
+			t.Fatalf("ExtendExpiration: %v", err)
+		}
+
+		if got, err := repo.GetByID(ctx, extended.ID); err != nil || got.ExpiresAt == nil || !got.ExpiresAt.After(now.Add(59*time.Minute)) {
+			t.Errorf("expected expiration to stay at the later time, got %+v (%v)", got, err)
+		}
+		if got, err := repo.GetByID(ctx, kept.ID); err != nil || got.ExpiresAt != nil {
+			t.Errorf("expected blob without expiration to be kept, got %+v (%v)", got, err)
+		}
+
+		list, err := repo.ListExpired(ctx, now, 10)
+		if err != nil {
+			t.Fatalf("ListExpired: %v", err)
+		}
+		if len(list) != 1 || list[0].ID != old.ID {
+			t.Errorf("expected only the old blob to be expired, got %+v", list)
+		}
+	})
+}
@@ -0,0 +1,233 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/chatroom"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunChatRoomRepositoryContract - ChatRoomRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunChatRoomRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.ChatRoomRepository) {
+	ctx := context.Background()
+	t.Run("CreateAndGetByID", func(t *testing.T) {
+		repo := newRepo(t)
+		room := &chatroom.ChatRoom{Country: "일본", City: "도쿄", RoomType: chatroom.RoomTypePublic, Name: "도쿄 채팅"}
+		if err := repo.Create(ctx, room); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if room.ID == 0 {
+			t.Fatal("expected ID to be assigned")
+		}
+
+		got, err := repo.GetByID(ctx, room.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.Name != "도쿄 채팅" || !got.IsPublic() {
+			t.Errorf("stored room mismatch: got %+v", got)
+		}
+
+		if _, err := repo.GetByID(ctx, 9999); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound, got %v", err)
+		}
+	})
+
+	t.Run("GetByLocationMatchesRoomType", func(t *testing.T) {
+		repo := newRepo(t)
+		public := &chatroom.ChatRoom{Country: "일본", City: "도쿄", RoomType: chatroom.RoomTypePublic}
+		if err := repo.Create(ctx, public); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		got, err := repo.GetByLocation(ctx, "일본", "도쿄", chatroom.RoomTypePublic)
+		if err != nil {
+			t.Fatalf("GetByLocation: %v", err)
+		}
+		if got.ID != public.ID {
+			t.Errorf("expected room %d, got %d", public.ID, got.ID)
+		}
+
+		if _, err := repo.GetByLocation(ctx, "일본", "도쿄", chatroom.RoomTypePrivate); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound for private room, got %v", err)
+		}
+		if _, err := repo.GetByLocation(ctx, "일본", "오사카", chatroom.RoomTypePublic); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound for other city, got %v", err)
+		}
+	})
+
+	t.Run("GetOrCreatePublicRoomIsIdempotent", func(t *testing.T) {
+		repo := newRepo(t)
+
+		first, err := repo.GetOrCreatePublicRoom(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetOrCreatePublicRoom: %v", err)
+		}
+		second, err := repo.GetOrCreatePublicRoom(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetOrCreatePublicRoom: %v", err)
+		}
+
+		if first.ID == 0 || first.ID != second.ID {
+			t.Errorf("expected the same public room, got %d and %d", first.ID, second.ID)
+		}
+		if first.Name == "" || !first.IsPublic() {
+			t.Errorf("expected named public room, got %+v", first)
+		}
+	})
+
+	t.Run("GetOrCreatePrivateRoomDeduplicatesPair", func(t *testing.T) {
+		repo := newRepo(t)
+
+		room, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 1, 2, "민수", "지영")
+		if err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom: %v", err)
+		}
+		again, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 2, 1, "지영", "민수")
+		if err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom: %v", err)
+		}
+		if room.ID != again.ID {
+			t.Errorf("expected the same private room for the same pair, got %d and %d", room.ID, again.ID)
+		}
+		if !room.IsPrivate() || room.Name != "민수 & 지영" {
+			t.Errorf("unexpected private room: %+v", room)
+		}
+
+		other, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 1, 3, "민수", "철수")
+		if err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom: %v", err)
+		}
+		if other.ID == room.ID {
+			t.Error("expected a different room for a different pair")
+		}
+
+		for _, tc := range []struct {
+			userID uint
+			want   bool
+		}{{1, true}, {2, true}, {3, false}} {
+			isMember, err := repo.IsMember(ctx, room.ID, tc.userID)
+			if err != nil {
+				t.Fatalf("IsMember: %v", err)
+			}
+			if isMember != tc.want {
+				t.Errorf("IsMember(room %d, user %d) = %v, want %v", room.ID, tc.userID, isMember, tc.want)
+			}
+		}
+	})
+
+	t.Run("GetByMemberNewestFirst", func(t *testing.T) {
+		repo := newRepo(t)
+
+		older, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 1, 2, "민수", "지영")
+		if err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		newer, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 1, 3, "민수", "철수")
+		if err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom: %v", err)
+		}
+		if _, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 2, 3, "지영", "철수"); err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom: %v", err)
+		}
+
+		rooms, err := repo.GetByMember(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetByMember: %v", err)
+		}
+		assertRoomIDs(t, rooms, newer.ID, older.ID)
+
+		if err := repo.Delete(ctx, newer.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		rooms, err = repo.GetByMember(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetByMember: %v", err)
+		}
+		assertRoomIDs(t, rooms, older.ID)
+	})
+
+	t.Run("UpdatePersistsFields", func(t *testing.T) {
+		repo := newRepo(t)
+		room, err := repo.GetOrCreatePublicRoom(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetOrCreatePublicRoom: %v", err)
+		}
+
+		room.Name = "새 이름"
+		if err := repo.Update(ctx, room); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+
+		got, err := repo.GetByID(ctx, room.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.Name != "새 이름" {
+			t.Errorf("expected updated name, got %q", got.Name)
+		}
+	})
+
+	t.Run("DeleteIsSoft", func(t *testing.T) {
+		repo := newRepo(t)
+		room, err := repo.GetOrCreatePublicRoom(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetOrCreatePublicRoom: %v", err)
+		}
+
+		if err := repo.Delete(ctx, room.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, room.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected deleted room to be hidden from GetByID, got %v", err)
+		}
+		if _, err := repo.GetByLocation(ctx, "일본", "도쿄", chatroom.RoomTypePublic); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected deleted room to be hidden from GetByLocation, got %v", err)
+		}
+
+		// 삭제 후에는 새 전체 채팅방 생성
+		recreated, err := repo.GetOrCreatePublicRoom(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetOrCreatePublicRoom: %v", err)
+		}
+		if recreated.ID == room.ID {
+			t.Error("expected a new public room after deletion")
+		}
+	})
+
+	t.Run("DeletedPrivateRoomCanBeReopened", func(t *testing.T) {
+		repo := newRepo(t)
+		room, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 1, 2, "민수", "지영")
+		if err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom: %v", err)
+		}
+
+		if err := repo.Delete(ctx, room.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+
+		reopened, err := repo.GetOrCreatePrivateRoom(ctx, "일본", "도쿄", 2, 1, "지영", "민수")
+		if err != nil {
+			t.Fatalf("GetOrCreatePrivateRoom after delete: %v", err)
+		}
+		if reopened.ID == room.ID {
+			t.Error("expected a new private room after deletion")
+		}
+	})
+}
+
+func assertRoomIDs(t *testing.T, rooms []*chatroom.ChatRoom, want ...uint) {
+	t.Helper()
+	got := make([]uint, len(rooms))
+	for i, room := range rooms {
+		got[i] = room.ID
+	}
+	if !equalIDs(got, want) {
+		t.Errorf("expected room IDs %v, got %v", want, got)
+	}
+}
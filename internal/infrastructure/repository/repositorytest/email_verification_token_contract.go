@@ -0,0 +1,106 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunEmailVerificationTokenRepositoryContract - EmailVerificationTokenRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunEmailVerificationTokenRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.EmailVerificationTokenRepository) {
+	ctx := context.Background()
+	t.Run("CreateAndGetByHash", func(t *testing.T) {
+		repo := newRepo(t)
+		created := newEmailVerificationToken("hash-1", 1)
+		mustCreateEmailVerificationToken(t, repo, created)
+		if created.ID == 0 {
+			t.Fatal("expected Create to assign an ID")
+		}
+
+		got, err := repo.GetByHash(ctx, "hash-1")
+		if err != nil {
+			t.Fatalf("GetByHash: %v", err)
+		}
+		if got.ID != created.ID || got.UserID != 1 || got.IsUsed() || got.IsExpired(time.Now()) {
+			t.Errorf("stored token mismatch: got %+v", got)
+		}
+
+		if _, err := repo.GetByHash(ctx, "missing"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound, got %v", err)
+		}
+		if err := repo.Create(ctx, newEmailVerificationToken("hash-1", 2)); err == nil {
+			t.Error("expected duplicate hash to be rejected")
+		}
+	})
+
+	t.Run("MarkUsedIfActiveOnlyOnce", func(t *testing.T) {
+		repo := newRepo(t)
+		created := newEmailVerificationToken("hash-1", 1)
+		mustCreateEmailVerificationToken(t, repo, created)
+
+		used, err := repo.MarkUsedIfActive(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("MarkUsedIfActive: %v", err)
+		}
+		if !used {
+			t.Fatal("expected first use to succeed")
+		}
+
+		used, err = repo.MarkUsedIfActive(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("MarkUsedIfActive: %v", err)
+		}
+		if used {
+			t.Error("expected second use to be rejected")
+		}
+
+		got, err := repo.GetByHash(ctx, "hash-1")
+		if err != nil {
+			t.Fatalf("GetByHash: %v", err)
+		}
+		if !got.IsUsed() {
+			t.Errorf("expected token to be marked used, got %+v", got)
+		}
+	})
+
+	t.Run("InvalidateAllByUser", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreateEmailVerificationToken(t, repo, newEmailVerificationToken("a1", 1))
+		mustCreateEmailVerificationToken(t, repo, newEmailVerificationToken("a2", 1))
+		mustCreateEmailVerificationToken(t, repo, newEmailVerificationToken("b1", 2))
+
+		if err := repo.InvalidateAllByUser(ctx, 1); err != nil {
+			t.Fatalf("InvalidateAllByUser: %v", err)
+		}
+		for hash, used := range map[string]bool{"a1": true, "a2": true, "b1": false} {
+			got, err := repo.GetByHash(ctx, hash)
+			if err != nil {
+				t.Fatalf("GetByHash(%s): %v", hash, err)
+			}
+			if got.IsUsed() != used {
+				t.Errorf("token %s used = %v, want %v", hash, got.IsUsed(), used)
+			}
+		}
+	})
+}
+
+func newEmailVerificationToken(hash string, userID uint) *token.EmailVerificationToken {
+	return &token.EmailVerificationToken{
+		UserID:    userID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func mustCreateEmailVerificationToken(t *testing.T, repo repository.EmailVerificationTokenRepository, verificationToken *token.EmailVerificationToken) {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, verificationToken); err != nil {
+		t.Fatalf("Create(%s): %v", verificationToken.TokenHash, err)
+	}
+}
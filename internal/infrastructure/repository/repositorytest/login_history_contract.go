@@ -0,0 +1,89 @@
+package repositorytest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+)
+
+// RunLoginHistoryRepositoryContract - LoginHistoryRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunLoginHistoryRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.LoginHistoryRepository) {
+	ctx := context.Background()
+	t.Run("ListByUserNewestFirst", func(t *testing.T) {
+		repo := newRepo(t)
+		base := time.Now().Add(-time.Hour)
+		first := mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 1, IP: "10.0.0.1", Success: true, CreatedAt: base})
+		second := mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 1, IP: "10.0.0.2", FailureReason: user.LoginFailureWrongPassword, CreatedAt: base.Add(time.Minute)})
+		third := mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 1, IP: "10.0.0.1", Success: true, NewIP: true, CreatedAt: base.Add(2 * time.Minute)})
+		mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 2, IP: "10.0.0.9", Success: true, CreatedAt: base})
+
+		histories, err := repo.ListByUser(ctx, 1, 0, 10)
+		if err != nil {
+			t.Fatalf("ListByUser: %v", err)
+		}
+		assertLoginHistoryIDs(t, histories, third.ID, second.ID, first.ID)
+		if !histories[0].NewIP || histories[1].FailureReason != user.LoginFailureWrongPassword {
+			t.Errorf("stored history mismatch: %+v / %+v", histories[0], histories[1])
+		}
+
+		page, err := repo.ListByUser(ctx, 1, 1, 1)
+		if err != nil {
+			t.Fatalf("ListByUser: %v", err)
+		}
+		assertLoginHistoryIDs(t, page, second.ID)
+
+		count, err := repo.CountByUser(ctx, 1)
+		if err != nil {
+			t.Fatalf("CountByUser: %v", err)
+		}
+		if count != 3 {
+			t.Errorf("expected 3 attempts for user 1, got %d", count)
+		}
+	})
+
+	t.Run("KnownIPsOnlyFromSuccess", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 1, IP: "10.0.0.1", Success: true})
+		mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 1, IP: "10.0.0.1", Success: true})
+		mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 1, IP: "10.0.0.2", Success: true})
+		mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 1, IP: "10.0.0.3", FailureReason: user.LoginFailureWrongPassword})
+		mustCreateLoginHistory(t, repo, &user.LoginHistory{UserID: 2, IP: "10.0.0.4", Success: true})
+
+		ips, err := repo.KnownIPs(ctx, 1)
+		if err != nil {
+			t.Fatalf("KnownIPs: %v", err)
+		}
+		sort.Strings(ips)
+		if len(ips) != 2 || ips[0] != "10.0.0.1" || ips[1] != "10.0.0.2" {
+			t.Errorf("expected successful login IPs only, got %v", ips)
+		}
+
+		if ips, err := repo.KnownIPs(ctx, 3); err != nil || len(ips) != 0 {
+			t.Errorf("expected no IPs for a user without logins, got %v, %v", ips, err)
+		}
+	})
+}
+
+func mustCreateLoginHistory(t *testing.T, repo repository.LoginHistoryRepository, history *user.LoginHistory) *user.LoginHistory {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, history); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	return history
+}
+
+func assertLoginHistoryIDs(t *testing.T, histories []*user.LoginHistory, want ...uint) {
+	t.Helper()
+	got := make([]uint, len(histories))
+	for i, history := range histories {
+		got[i] = history.ID
+	}
+	if !equalIDs(got, want) {
+		t.Errorf("expected login history IDs %v, got %v", want, got)
+	}
+}
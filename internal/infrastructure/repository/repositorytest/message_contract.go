@@ -0,0 +1,283 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunMessageRepositoryContract - MessageRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunMessageRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.MessageRepository) {
+	ctx := context.Background()
+	t.Run("CreateAndGetByID", func(t *testing.T) {
+		repo := newRepo(t)
+		msg := newMessage(1, "안녕하세요", time.Now(), nil)
+		mustCreateMessage(t, repo, msg)
+
+		if msg.ID == 0 {
+			t.Fatal("expected ID to be assigned")
+		}
+		got, err := repo.GetByID(ctx, msg.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.Content != "안녕하세요" || got.ChatRoomID != 1 || got.ExpiresAt != nil {
+			t.Errorf("stored message mismatch: got %+v", got)
+		}
+
+		if _, err := repo.GetByID(ctx, 9999); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound, got %v", err)
+		}
+	})
+
+	t.Run("GetByChatRoomNewestFirstWithLimit", func(t *testing.T) {
+		repo := newRepo(t)
+		base := time.Now().Add(-time.Hour)
+		var ids []uint
+		for i := 0; i < 4; i++ {
+			msg := newMessage(1, "메시지", base.Add(time.Duration(i)*time.Minute), nil)
+			mustCreateMessage(t, repo, msg)
+			ids = append(ids, msg.ID)
+		}
+		mustCreateMessage(t, repo, newMessage(2, "다른 채팅방", base, nil))
+
+		messages, err := repo.GetByChatRoom(ctx, 1, nil, 3)
+		if err != nil {
+			t.Fatalf("GetByChatRoom: %v", err)
+		}
+		assertMessageIDs(t, messages, ids[3], ids[2], ids[1])
+	})
+
+	t.Run("GetByChatRoomExcludesExpiredAndDeleted", func(t *testing.T) {
+		repo := newRepo(t)
+		now := time.Now()
+		future := now.Add(time.Hour)
+		past := now.Add(-time.Minute)
+
+		live := newMessage(1, "유효", now.Add(-3*time.Minute), &future)
+		permanent := newMessage(1, "만료 없음", now.Add(-2*time.Minute), nil)
+		expired := newMessage(1, "만료", now.Add(-time.Hour), &past)
+		mustCreateMessage(t, repo, live)
+		mustCreateMessage(t, repo, permanent)
+		mustCreateMessage(t, repo, expired)
+
+		messages, err := repo.GetByChatRoom(ctx, 1, nil, 10)
+		if err != nil {
+			t.Fatalf("GetByChatRoom: %v", err)
+		}
+		assertMessageIDs(t, messages, permanent.ID, live.ID)
+
+		if err := repo.DeleteExpiredBefore(ctx, now.Add(2*time.Hour)); err != nil {
+			t.Fatalf("DeleteExpiredBefore: %v", err)
+		}
+		messages, err = repo.GetByChatRoom(ctx, 1, nil, 10)
+		if err != nil {
+			t.Fatalf("GetByChatRoom: %v", err)
+		}
+		assertMessageIDs(t, messages, permanent.ID)
+	})
+
+	t.Run("GetByChatRoomExcludesUsersBeforeLimit", func(t *testing.T) {
+		repo := newRepo(t)
+		base := time.Now().Add(-time.Hour)
+		var kept []uint
+		for i := 0; i < 2; i++ {
+			msg := newMessage(1, "안녕하세요", base.Add(time.Duration(i)*time.Minute), nil)
+			mustCreateMessage(t, repo, msg)
+			kept = append(kept, msg.ID)
+		}
+		// 제외할 사용자가 더 최근에 많이 보낸 경우
+		for i := 0; i < 5; i++ {
+			spam := newMessage(1, "광고", base.Add(time.Duration(10+i)*time.Minute), nil)
+			spam.UserID = 2
+			mustCreateMessage(t, repo, spam)
+		}
+
+		messages, err := repo.GetByChatRoom(ctx, 1, []uint{2}, 2)
+		if err != nil {
+			t.Fatalf("GetByChatRoom: %v", err)
+		}
+		assertMessageIDs(t, messages, kept[1], kept[0])
+
+		messages, err = repo.GetByChatRoom(ctx, 1, []uint{}, 2)
+		if err != nil {
+			t.Fatalf("GetByChatRoom: %v", err)
+		}
+		if len(messages) != 2 || messages[0].UserID != 2 {
+			t.Errorf("expected empty exclusion to return the newest messages, got %+v", messages)
+		}
+	})
+
+	t.Run("DeleteExpiredIsSoft", func(t *testing.T) {
+		repo := newRepo(t)
+		now := time.Now()
+		past := now.Add(-time.Minute)
+		future := now.Add(time.Hour)
+
+		expired := newMessage(1, "만료", now.Add(-time.Hour), &past)
+		live := newMessage(1, "유효", now, &future)
+		mustCreateMessage(t, repo, expired)
+		mustCreateMessage(t, repo, live)
+
+		if err := repo.DeleteExpired(ctx); err != nil {
+			t.Fatalf("DeleteExpired: %v", err)
+		}
+
+		if _, err := repo.GetByID(ctx, expired.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected expired message to be deleted, got %v", err)
+		}
+		if _, err := repo.GetByID(ctx, live.ID); err != nil {
+			t.Errorf("expected live message to remain, got %v", err)
+		}
+		assertMessageCount(t, repo, 1)
+
+		// 소프트 삭제된 메시지도 일괄 영구 삭제 대상
+		deleted, err := repo.DeleteExpiredBatch(ctx, now, 10)
+		if err != nil {
+			t.Fatalf("DeleteExpiredBatch: %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("expected soft-deleted expired message to be purged, got %d", deleted)
+		}
+	})
+
+	t.Run("PurgeByRoomAndUser", func(t *testing.T) {
+		repo := newRepo(t)
+		now := time.Now()
+		spam := newMessage(1, "광고", now, nil)
+		spam.UserID = 2
+		mustCreateMessage(t, repo, spam)
+		otherRoomSpam := newMessage(2, "광고", now, nil)
+		otherRoomSpam.UserID = 2
+		mustCreateMessage(t, repo, otherRoomSpam)
+		keep := newMessage(1, "안녕하세요", now, nil)
+		mustCreateMessage(t, repo, keep)
+		mustCreateMessage(t, repo, newMessage(3, "다른 방", now, nil))
+
+		deleted, err := repo.Purge(ctx, 0, 0)
+		if err != nil {
+			t.Fatalf("Purge: %v", err)
+		}
+		if deleted != 0 {
+			t.Errorf("expected purge without conditions to delete nothing, got %d", deleted)
+		}
+
+		deleted, err = repo.Purge(ctx, 1, 2)
+		if err != nil {
+			t.Fatalf("Purge: %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("expected 1 message purged by room and user, got %d", deleted)
+		}
+
+		deleted, err = repo.Purge(ctx, 0, 2)
+		if err != nil {
+			t.Fatalf("Purge: %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("expected 1 message purged by user, got %d", deleted)
+		}
+
+		deleted, err = repo.Purge(ctx, 3, 0)
+		if err != nil {
+			t.Fatalf("Purge: %v", err)
+		}
+		if deleted != 1 {
+			t.Errorf("expected 1 message purged by room, got %d", deleted)
+		}
+
+		assertMessageCount(t, repo, 1)
+		if _, err := repo.GetByID(ctx, keep.ID); err != nil {
+			t.Errorf("expected unrelated message to remain, got %v", err)
+		}
+	})
+
+	t.Run("DeleteExpiredBatchRespectsBatchSize", func(t *testing.T) {
+		repo := newRepo(t)
+		now := time.Now()
+		for i := 0; i < 5; i++ {
+			expiresAt := now.Add(-time.Duration(i+1) * time.Minute)
+			mustCreateMessage(t, repo, newMessage(1, "만료", now.Add(-time.Hour), &expiresAt))
+		}
+		future := now.Add(time.Hour)
+		live := newMessage(1, "유효", now, &future)
+		mustCreateMessage(t, repo, live)
+		mustCreateMessage(t, repo, newMessage(1, "만료 없음", now, nil))
+
+		deleted, err := repo.DeleteExpiredBatch(ctx, now, 3)
+		if err != nil {
+			t.Fatalf("DeleteExpiredBatch: %v", err)
+		}
+		if deleted != 3 {
+			t.Errorf("expected 3 deleted, got %d", deleted)
+		}
+
+		deleted, err = repo.DeleteExpiredBatch(ctx, now, 3)
+		if err != nil {
+			t.Fatalf("DeleteExpiredBatch: %v", err)
+		}
+		if deleted != 2 {
+			t.Errorf("expected 2 deleted, got %d", deleted)
+		}
+
+		deleted, err = repo.DeleteExpiredBatch(ctx, now, 3)
+		if err != nil {
+			t.Fatalf("DeleteExpiredBatch: %v", err)
+		}
+		if deleted != 0 {
+			t.Errorf("expected nothing left to delete, got %d", deleted)
+		}
+
+		assertMessageCount(t, repo, 2)
+		if _, err := repo.GetByID(ctx, live.ID); err != nil {
+			t.Errorf("expected live message to remain, got %v", err)
+		}
+	})
+}
+
+func newMessage(chatRoomID uint, content string, createdAt time.Time, expiresAt *time.Time) *message.Message {
+	return &message.Message{
+		Content:     content,
+		UserID:      1,
+		ChatRoomID:  chatRoomID,
+		MessageType: message.MessageTypeText,
+		CreatedAt:   createdAt,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+func mustCreateMessage(t *testing.T, repo repository.MessageRepository, msg *message.Message) {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, msg); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+}
+
+func assertMessageIDs(t *testing.T, messages []*message.Message, want ...uint) {
+	t.Helper()
+	got := make([]uint, len(messages))
+	for i, msg := range messages {
+		got[i] = msg.ID
+	}
+	if !equalIDs(got, want) {
+		t.Errorf("expected message IDs %v, got %v", want, got)
+	}
+}
+
+func assertMessageCount(t *testing.T, repo repository.MessageRepository, want int64) {
+	ctx := context.Background()
+	t.Helper()
+	count, err := repo.Count(ctx)
+	if err != nil {
+		t.Fatalf("Count: %v", err)
+	}
+	if count != want {
+		t.Errorf("expected %d messages, got %d", want, count)
+	}
+}
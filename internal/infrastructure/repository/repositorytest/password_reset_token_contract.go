@@ -0,0 +1,106 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunPasswordResetTokenRepositoryContract - PasswordResetTokenRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunPasswordResetTokenRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.PasswordResetTokenRepository) {
+	ctx := context.Background()
+	t.Run("CreateAndGetByHash", func(t *testing.T) {
+		repo := newRepo(t)
+		created := newPasswordResetToken("hash-1", 1)
+		mustCreatePasswordResetToken(t, repo, created)
+		if created.ID == 0 {
+			t.Fatal("expected Create to assign an ID")
+		}
+
+		got, err := repo.GetByHash(ctx, "hash-1")
+		if err != nil {
+			t.Fatalf("GetByHash: %v", err)
+		}
+		if got.ID != created.ID || got.UserID != 1 || got.IsUsed() || got.IsExpired(time.Now()) {
+			t.Errorf("stored token mismatch: got %+v", got)
+		}
+
+		if _, err := repo.GetByHash(ctx, "missing"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound, got %v", err)
+		}
+		if err := repo.Create(ctx, newPasswordResetToken("hash-1", 2)); err == nil {
+			t.Error("expected duplicate hash to be rejected")
+		}
+	})
+
+	t.Run("MarkUsedIfActiveOnlyOnce", func(t *testing.T) {
+		repo := newRepo(t)
+		created := newPasswordResetToken("hash-1", 1)
+		mustCreatePasswordResetToken(t, repo, created)
+
+		used, err := repo.MarkUsedIfActive(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("MarkUsedIfActive: %v", err)
+		}
+		if !used {
+			t.Fatal("expected first use to succeed")
+		}
+
+		used, err = repo.MarkUsedIfActive(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("MarkUsedIfActive: %v", err)
+		}
+		if used {
+			t.Error("expected second use to be rejected")
+		}
+
+		got, err := repo.GetByHash(ctx, "hash-1")
+		if err != nil {
+			t.Fatalf("GetByHash: %v", err)
+		}
+		if !got.IsUsed() {
+			t.Errorf("expected token to be marked used, got %+v", got)
+		}
+	})
+
+	t.Run("InvalidateAllByUser", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreatePasswordResetToken(t, repo, newPasswordResetToken("a1", 1))
+		mustCreatePasswordResetToken(t, repo, newPasswordResetToken("a2", 1))
+		mustCreatePasswordResetToken(t, repo, newPasswordResetToken("b1", 2))
+
+		if err := repo.InvalidateAllByUser(ctx, 1); err != nil {
+			t.Fatalf("InvalidateAllByUser: %v", err)
+		}
+		for hash, used := range map[string]bool{"a1": true, "a2": true, "b1": false} {
+			got, err := repo.GetByHash(ctx, hash)
+			if err != nil {
+				t.Fatalf("GetByHash(%s): %v", hash, err)
+			}
+			if got.IsUsed() != used {
+				t.Errorf("token %s used = %v, want %v", hash, got.IsUsed(), used)
+			}
+		}
+	})
+}
+
+func newPasswordResetToken(hash string, userID uint) *token.PasswordResetToken {
+	return &token.PasswordResetToken{
+		UserID:    userID,
+		TokenHash: hash,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func mustCreatePasswordResetToken(t *testing.T, repo repository.PasswordResetTokenRepository, resetToken *token.PasswordResetToken) {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, resetToken); err != nil {
+		t.Fatalf("Create(%s): %v", resetToken.TokenHash, err)
+	}
+}
@@ -0,0 +1,114 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunRefreshTokenRepositoryContract - RefreshTokenRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunRefreshTokenRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.RefreshTokenRepository) {
+	ctx := context.Background()
+	t.Run("CreateAndGetByJTI", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreateRefreshToken(t, repo, newRefreshToken("jti-1", 1, "family-1"))
+
+		got, err := repo.GetByJTI(ctx, "jti-1")
+		if err != nil {
+			t.Fatalf("GetByJTI: %v", err)
+		}
+		if got.UserID != 1 || got.FamilyID != "family-1" || got.IsRevoked() || got.IsRotated() {
+			t.Errorf("stored token mismatch: got %+v", got)
+		}
+
+		if _, err := repo.GetByJTI(ctx, "missing"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound, got %v", err)
+		}
+		if err := repo.Create(ctx, newRefreshToken("jti-1", 2, "family-2")); err == nil {
+			t.Error("expected duplicate JTI to be rejected")
+		}
+	})
+
+	t.Run("RevokeIfActiveOnlyOnce", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreateRefreshToken(t, repo, newRefreshToken("jti-1", 1, "family-1"))
+
+		revoked, err := repo.RevokeIfActive(ctx, "jti-1", "jti-2")
+		if err != nil {
+			t.Fatalf("RevokeIfActive: %v", err)
+		}
+		if !revoked {
+			t.Fatal("expected first revoke to succeed")
+		}
+
+		revoked, err = repo.RevokeIfActive(ctx, "jti-1", "jti-3")
+		if err != nil {
+			t.Fatalf("RevokeIfActive: %v", err)
+		}
+		if revoked {
+			t.Error("expected second revoke to be rejected")
+		}
+
+		got, err := repo.GetByJTI(ctx, "jti-1")
+		if err != nil {
+			t.Fatalf("GetByJTI: %v", err)
+		}
+		if !got.IsRevoked() || got.ReplacedBy != "jti-2" {
+			t.Errorf("expected token rotated to jti-2, got %+v", got)
+		}
+	})
+
+	t.Run("RevokeFamilyAndUser", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreateRefreshToken(t, repo, newRefreshToken("a1", 1, "family-a"))
+		mustCreateRefreshToken(t, repo, newRefreshToken("a2", 1, "family-a"))
+		mustCreateRefreshToken(t, repo, newRefreshToken("b1", 1, "family-b"))
+		mustCreateRefreshToken(t, repo, newRefreshToken("c1", 2, "family-c"))
+
+		if err := repo.RevokeFamily(ctx, "family-a"); err != nil {
+			t.Fatalf("RevokeFamily: %v", err)
+		}
+		assertRevoked(t, repo, map[string]bool{"a1": true, "a2": true, "b1": false, "c1": false})
+
+		if err := repo.RevokeAllByUser(ctx, 1); err != nil {
+			t.Fatalf("RevokeAllByUser: %v", err)
+		}
+		assertRevoked(t, repo, map[string]bool{"a1": true, "a2": true, "b1": true, "c1": false})
+	})
+}
+
+func newRefreshToken(jti string, userID uint, familyID string) *token.RefreshToken {
+	return &token.RefreshToken{
+		JTI:       jti,
+		UserID:    userID,
+		FamilyID:  familyID,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func mustCreateRefreshToken(t *testing.T, repo repository.RefreshTokenRepository, refreshToken *token.RefreshToken) {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, refreshToken); err != nil {
+		t.Fatalf("Create(%s): %v", refreshToken.JTI, err)
+	}
+}
+
+func assertRevoked(t *testing.T, repo repository.RefreshTokenRepository, want map[string]bool) {
+	ctx := context.Background()
+	t.Helper()
+	for jti, revoked := range want {
+		got, err := repo.GetByJTI(ctx, jti)
+		if err != nil {
+			t.Fatalf("GetByJTI(%s): %v", jti, err)
+		}
+		if got.IsRevoked() != revoked {
+			t.Errorf("token %s revoked = %v, want %v", jti, got.IsRevoked(), revoked)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package repositorytest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunUserBlockRepositoryContract - UserBlockRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunUserBlockRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.UserBlockRepository) {
+	ctx := context.Background()
+	t.Run("CreateExistsDelete", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreateUserBlock(t, repo, &user.UserBlock{BlockerID: 1, BlockedID: 2})
+
+		if ok, err := repo.Exists(ctx, 1, 2); err != nil || !ok {
+			t.Fatalf("expected block 1→2 to exist, got %v, %v", ok, err)
+		}
+		if ok, err := repo.Exists(ctx, 2, 1); err != nil || ok {
+			t.Errorf("expected block to be one-directional, got %v, %v", ok, err)
+		}
+		if err := repo.Create(ctx, &user.UserBlock{BlockerID: 1, BlockedID: 2}); err == nil {
+			t.Error("expected duplicate block to fail")
+		}
+
+		if err := repo.Delete(ctx, 1, 2); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if ok, _ := repo.Exists(ctx, 1, 2); ok {
+			t.Error("expected block to be removed")
+		}
+		if err := repo.Delete(ctx, 1, 2); err != gorm.ErrRecordNotFound {
+			t.Errorf("expected ErrRecordNotFound for a missing block, got %v", err)
+		}
+	})
+
+	t.Run("ListAndIDs", func(t *testing.T) {
+		repo := newRepo(t)
+		base := time.Now().Add(-time.Hour)
+		first := mustCreateUserBlock(t, repo, &user.UserBlock{BlockerID: 1, BlockedID: 2, CreatedAt: base})
+		second := mustCreateUserBlock(t, repo, &user.UserBlock{BlockerID: 1, BlockedID: 3, CreatedAt: base.Add(time.Minute)})
+		mustCreateUserBlock(t, repo, &user.UserBlock{BlockerID: 4, BlockedID: 1, CreatedAt: base})
+		mustCreateUserBlock(t, repo, &user.UserBlock{BlockerID: 2, BlockedID: 1, CreatedAt: base})
+		mustCreateUserBlock(t, repo, &user.UserBlock{BlockerID: 5, BlockedID: 6, CreatedAt: base})
+
+		blocks, err := repo.ListByBlocker(ctx, 1)
+		if err != nil {
+			t.Fatalf("ListByBlocker: %v", err)
+		}
+		if len(blocks) != 2 || blocks[0].ID != second.ID || blocks[1].ID != first.ID {
+			t.Errorf("expected newest block first, got %+v", blocks)
+		}
+
+		blocked, err := repo.GetBlockedIDs(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetBlockedIDs: %v", err)
+		}
+		assertSortedIDs(t, blocked, 2, 3)
+
+		related, err := repo.GetRelatedIDs(ctx, 1)
+		if err != nil {
+			t.Fatalf("GetRelatedIDs: %v", err)
+		}
+		assertSortedIDs(t, related, 2, 3, 4)
+
+		if ids, err := repo.GetRelatedIDs(ctx, 7); err != nil || len(ids) != 0 {
+			t.Errorf("expected no related users, got %v, %v", ids, err)
+		}
+	})
+}
+
+func mustCreateUserBlock(t *testing.T, repo repository.UserBlockRepository, block *user.UserBlock) *user.UserBlock {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, block); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	return block
+}
+
+// assertSortedIDs - 순서와 무관하게 ID 집합 비교
+func assertSortedIDs(t *testing.T, got []uint, want ...uint) {
+	t.Helper()
+	sorted := append([]uint(nil), got...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	if !equalIDs(sorted, want) {
+		t.Errorf("expected IDs %v, got %v", want, got)
+	}
+}
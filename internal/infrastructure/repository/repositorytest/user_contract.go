@@ -0,0 +1,586 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+// RunUserRepositoryContract - UserRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunUserRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.UserRepository) {
+	ctx := context.Background()
+	t.Run("CreateAssignsIDAndTimestamps", func(t *testing.T) {
+		repo := newRepo(t)
+		before := time.Now().Add(-time.Second)
+
+		u := newUser("create@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, u)
+
+		if u.ID == 0 {
+			t.Fatal("expected ID to be assigned")
+		}
+		got, err := repo.GetByID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.Email != u.Email || got.Name != u.Name || got.Country != u.Country || got.City != u.City {
+			t.Errorf("stored user mismatch: got %+v", got)
+		}
+		if got.CreatedAt.Before(before) || got.LastActive.Before(before) {
+			t.Errorf("expected CreatedAt/LastActive to be set, got %v / %v", got.CreatedAt, got.LastActive)
+		}
+	})
+
+	t.Run("GetByIDNotFound", func(t *testing.T) {
+		repo := newRepo(t)
+
+		if _, err := repo.GetByID(ctx, 9999); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Fatalf("expected ErrRecordNotFound, got %v", err)
+		}
+		if _, err := repo.GetByEmail(ctx, "missing@example.com"); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Fatalf("expected ErrRecordNotFound, got %v", err)
+		}
+	})
+
+	t.Run("DuplicateEmailRejected", func(t *testing.T) {
+		repo := newRepo(t)
+		mustCreateUser(t, repo, newUser("dup@example.com", "일본", "도쿄"))
+
+		if err := repo.Create(ctx, newUser("dup@example.com", "프랑스", "파리")); err == nil {
+			t.Fatal("expected duplicate email to be rejected")
+		}
+	})
+
+	t.Run("GetByEmail", func(t *testing.T) {
+		repo := newRepo(t)
+		u := newUser("email@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, u)
+
+		got, err := repo.GetByEmail(ctx, "email@example.com")
+		if err != nil {
+			t.Fatalf("GetByEmail: %v", err)
+		}
+		if got.ID != u.ID {
+			t.Errorf("expected user %d, got %d", u.ID, got.ID)
+		}
+	})
+
+	t.Run("GetByIDsOrderedByID", func(t *testing.T) {
+		repo := newRepo(t)
+		a := newUser("a@example.com", "일본", "도쿄")
+		b := newUser("b@example.com", "일본", "도쿄")
+		c := newUser("c@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, a)
+		mustCreateUser(t, repo, b)
+		mustCreateUser(t, repo, c)
+
+		users, err := repo.GetByIDs(ctx, []uint{c.ID, a.ID, 9999})
+		if err != nil {
+			t.Fatalf("GetByIDs: %v", err)
+		}
+		assertUserIDs(t, users, a.ID, c.ID)
+
+		empty, err := repo.GetByIDs(ctx, nil)
+		if err != nil {
+			t.Fatalf("GetByIDs(nil): %v", err)
+		}
+		assertUserIDs(t, empty)
+	})
+
+	t.Run("UpdatePersistsFields", func(t *testing.T) {
+		repo := newRepo(t)
+		u := newUser("update@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, u)
+
+		u.Name = "변경된 이름"
+		u.City = "오사카"
+		u.TravelBudget = 300
+		if err := repo.Update(ctx, u); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+
+		got, err := repo.GetByID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.Name != "변경된 이름" || got.City != "오사카" || got.TravelBudget != 300 {
+			t.Errorf("update not persisted: got %+v", got)
+		}
+	})
+
+	t.Run("DeleteIsSoft", func(t *testing.T) {
+		repo := newRepo(t)
+		keep := newUser("keep@example.com", "일본", "도쿄")
+		gone := newUser("gone@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, keep)
+		mustCreateUser(t, repo, gone)
+
+		if err := repo.Delete(ctx, gone.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if err := repo.Delete(ctx, 9999); err != nil {
+			t.Fatalf("Delete of missing user should not fail: %v", err)
+		}
+
+		if _, err := repo.GetByID(ctx, gone.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected deleted user to be hidden from GetByID, got %v", err)
+		}
+		if _, err := repo.GetByEmail(ctx, gone.Email); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected deleted user to be hidden from GetByEmail, got %v", err)
+		}
+
+		users, err := repo.GetByDestination(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetByDestination: %v", err)
+		}
+		assertUserIDSet(t, users, keep.ID)
+
+		byIDs, err := repo.GetByIDs(ctx, []uint{keep.ID, gone.ID})
+		if err != nil {
+			t.Fatalf("GetByIDs: %v", err)
+		}
+		assertUserIDs(t, byIDs, keep.ID)
+
+		count, err := repo.Count(ctx, nil)
+		if err != nil {
+			t.Fatalf("Count: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("expected count 1, got %d", count)
+		}
+
+		// 이메일 유니크 제약은 삭제된 사용자에게도 유지
+		if err := repo.Create(ctx, newUser("gone@example.com", "일본", "도쿄")); err == nil {
+			t.Error("expected email of soft-deleted user to stay reserved")
+		}
+	})
+
+	t.Run("ListPaginatesByID", func(t *testing.T) {
+		repo := newRepo(t)
+		var ids []uint
+		for _, email := range []string{"l1@example.com", "l2@example.com", "l3@example.com", "l4@example.com"} {
+			u := newUser(email, "일본", "도쿄")
+			mustCreateUser(t, repo, u)
+			ids = append(ids, u.ID)
+		}
+
+		page, err := repo.List(ctx, nil, 1, 2)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserIDs(t, page, ids[1], ids[2])
+
+		last, err := repo.List(ctx, nil, 3, 10)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserIDs(t, last, ids[3])
+	})
+
+	t.Run("ListAndCountExcludeIDs", func(t *testing.T) {
+		repo := newRepo(t)
+		var ids []uint
+		for _, email := range []string{"x1@example.com", "x2@example.com", "x3@example.com"} {
+			u := newUser(email, "일본", "도쿄")
+			mustCreateUser(t, repo, u)
+			ids = append(ids, u.ID)
+		}
+
+		users, err := repo.List(ctx, []uint{ids[1]}, 0, 10)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserIDs(t, users, ids[0], ids[2])
+
+		if count, err := repo.Count(ctx, []uint{ids[1]}); err != nil || count != 2 {
+			t.Errorf("expected count 2 without the excluded user, got %d, %v", count, err)
+		}
+		if count, err := repo.Count(ctx, []uint{}); err != nil || count != 3 {
+			t.Errorf("expected empty exclusion to count everyone, got %d, %v", count, err)
+		}
+	})
+
+	t.Run("GetByDestinationAndTrip", func(t *testing.T) {
+		repo := newRepo(t)
+		tokyo := newUser("tokyo@example.com", "일본", "도쿄")
+		osaka := newUser("osaka@example.com", "일본", "오사카")
+		paris := newUser("paris@example.com", "프랑스", "파리")
+		mustCreateUser(t, repo, tokyo)
+		mustCreateUser(t, repo, osaka)
+		mustCreateUser(t, repo, paris)
+
+		byDestination, err := repo.GetByDestination(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetByDestination: %v", err)
+		}
+		assertUserIDSet(t, byDestination, tokyo.ID)
+
+		byCountry, err := repo.GetByTrip(ctx, upcomingTrips("일본"), nil)
+		if err != nil {
+			t.Fatalf("GetByTrip: %v", err)
+		}
+		assertUserIDSet(t, byCountry, tokyo.ID, osaka.ID)
+	})
+
+	t.Run("GetByTripFiltersDates", func(t *testing.T) {
+		repo := newRepo(t)
+		now := time.Now()
+		trip := func(email string, startDays, endDays int) *user.User {
+			u := newUser(email, "일본", "도쿄")
+			u.TravelStart = now.Add(time.Duration(startDays) * 24 * time.Hour)
+			u.TravelEnd = now.Add(time.Duration(endDays) * 24 * time.Hour)
+			mustCreateUser(t, repo, u)
+			return u
+		}
+		overlapping := trip("overlap@example.com", 3, 12)
+		inside := trip("inside@example.com", 6, 8)
+		excluded := trip("excluded@example.com", 5, 9)
+		soon := trip("soon@example.com", 1, 4)
+		trip("after@example.com", 11, 15)
+		trip("ended@example.com", -5, -1)
+		paris := newUser("paris@example.com", "프랑스", "파리")
+		mustCreateUser(t, repo, paris)
+
+		filter := repository.TripFilter{
+			Country:   "일본",
+			Start:     now.Add(5 * 24 * time.Hour),
+			End:       now.Add(10 * 24 * time.Hour),
+			EndsAfter: now,
+		}
+		users, err := repo.GetByTrip(ctx, filter, []uint{excluded.ID})
+		if err != nil {
+			t.Fatalf("GetByTrip: %v", err)
+		}
+		assertUserIDs(t, users, overlapping.ID, inside.ID)
+
+		// 조건 기간이 이미 끝난 여행과 겹쳐도 EndsAfter 이전에 끝난 여행은 제외
+		filter.Start = now.Add(-4 * 24 * time.Hour)
+		filter.End = now.Add(2 * 24 * time.Hour)
+		users, err = repo.GetByTrip(ctx, filter, nil)
+		if err != nil {
+			t.Fatalf("GetByTrip: %v", err)
+		}
+		assertUserIDs(t, users, soon.ID)
+	})
+
+	t.Run("DiscoveryHidesUnverified", func(t *testing.T) {
+		repo := newRepo(t)
+		verified := newUser("verified@example.com", "일본", "도쿄")
+		unverified := newUser("unverified@example.com", "일본", "도쿄")
+		unverified.EmailVerified = false
+		mustCreateUser(t, repo, verified)
+		mustCreateUser(t, repo, unverified)
+
+		byDestination, err := repo.GetByDestination(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetByDestination: %v", err)
+		}
+		assertUserIDSet(t, byDestination, verified.ID)
+
+		byCountry, err := repo.GetByTrip(ctx, upcomingTrips("일본"), nil)
+		if err != nil {
+			t.Fatalf("GetByTrip: %v", err)
+		}
+		assertUserIDSet(t, byCountry, verified.ID)
+
+		listed, err := repo.List(ctx, nil, 0, 10)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserIDSet(t, listed, verified.ID)
+
+		if count, err := repo.Count(ctx, nil); err != nil || count != 1 {
+			t.Errorf("expected Count to skip unverified users, got %d (%v)", count, err)
+		}
+
+		// 인증하면 탐색에 나타남
+		got, err := repo.GetByID(ctx, unverified.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.EmailVerified || got.EmailVerifiedAt != nil {
+			t.Fatalf("expected user to be unverified, got %+v", got)
+		}
+		got.MarkEmailVerified(time.Now())
+		if err := repo.Update(ctx, got); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		byDestination, err = repo.GetByDestination(ctx, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetByDestination: %v", err)
+		}
+		assertUserIDSet(t, byDestination, verified.ID, unverified.ID)
+	})
+
+	t.Run("DeletedHiddenAndLastActive", func(t *testing.T) {
+		repo := newRepo(t)
+		active := newUser("active@example.com", "일본", "도쿄")
+		deleted := newUser("deleted@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, active)
+		mustCreateUser(t, repo, deleted)
+		if err := repo.Delete(ctx, deleted.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+
+		users, err := repo.List(ctx, nil, 0, 10)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserIDSet(t, users, active.ID)
+
+		before, err := repo.GetByID(ctx, active.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		if err := repo.UpdateLastActive(ctx, active.ID); err != nil {
+			t.Fatalf("UpdateLastActive: %v", err)
+		}
+		after, err := repo.GetByID(ctx, active.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if !after.LastActive.After(before.LastActive) {
+			t.Errorf("expected LastActive to advance: %v -> %v", before.LastActive, after.LastActive)
+		}
+
+		if err := repo.UpdateLastActive(ctx, 9999); err != nil {
+			t.Errorf("UpdateLastActive of missing user should not fail: %v", err)
+		}
+	})
+
+	t.Run("AdminListIncludesSuspendedAndRestores", func(t *testing.T) {
+		repo := newRepo(t)
+		active := newUser("active@example.com", "일본", "도쿄")
+		unverified := newUser("unverified@example.com", "일본", "도쿄")
+		unverified.EmailVerified = false
+		suspended := newUser("suspended@example.com", "일본", "도쿄")
+		suspended.Role = user.RoleModerator
+		mustCreateUser(t, repo, active)
+		mustCreateUser(t, repo, unverified)
+		mustCreateUser(t, repo, suspended)
+		if err := repo.Delete(ctx, suspended.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+
+		all, err := repo.ListForAdmin(ctx, repository.UserFilter{}, 0, 10)
+		if err != nil {
+			t.Fatalf("ListForAdmin: %v", err)
+		}
+		assertUserIDs(t, all, active.ID, unverified.ID, suspended.ID)
+		if !all[2].IsSuspended() {
+			t.Error("expected suspended user to be marked as suspended")
+		}
+
+		isSuspended := true
+		onlySuspended, err := repo.ListForAdmin(ctx, repository.UserFilter{Suspended: &isSuspended}, 0, 10)
+		if err != nil {
+			t.Fatalf("ListForAdmin: %v", err)
+		}
+		assertUserIDs(t, onlySuspended, suspended.ID)
+
+		moderator := user.RoleModerator
+		count, err := repo.CountForAdmin(ctx, repository.UserFilter{Role: &moderator})
+		if err != nil {
+			t.Fatalf("CountForAdmin: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("expected 1 moderator, got %d", count)
+		}
+
+		if _, err := repo.GetByIDIncludingSuspended(ctx, suspended.ID); err != nil {
+			t.Errorf("GetByIDIncludingSuspended: %v", err)
+		}
+		if err := repo.Restore(ctx, active.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound when restoring an active user, got %v", err)
+		}
+		if err := repo.Restore(ctx, suspended.ID); err != nil {
+			t.Fatalf("Restore: %v", err)
+		}
+		if _, err := repo.GetByID(ctx, suspended.ID); err != nil {
+			t.Errorf("expected restored user to be visible, got %v", err)
+		}
+	})
+
+	t.Run("UpdateRole", func(t *testing.T) {
+		repo := newRepo(t)
+		u := newUser("role@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, u)
+
+		if err := repo.UpdateRole(ctx, u.ID, user.RoleAdmin); err != nil {
+			t.Fatalf("UpdateRole: %v", err)
+		}
+		got, err := repo.GetByID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.Role != user.RoleAdmin {
+			t.Errorf("expected admin role, got %v", got.Role)
+		}
+
+		if err := repo.UpdateRole(ctx, 9999, user.RoleAdmin); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound for missing user, got %v", err)
+		}
+	})
+
+	t.Run("UpdateAvatarIfUnchanged", func(t *testing.T) {
+		repo := newRepo(t)
+		u := newUser("avatar@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, u)
+
+		avatarID := "0123456789abcdef0123456789abcdef"
+		otherID := "fedcba9876543210fedcba9876543210"
+		if ok, err := repo.UpdateAvatarIfUnchanged(ctx, u.ID, nil, &avatarID); err != nil || !ok {
+			t.Fatalf("UpdateAvatarIfUnchanged: %v, %v", ok, err)
+		}
+		if got, err := repo.GetByID(ctx, u.ID); err != nil || got.AvatarID == nil || *got.AvatarID != avatarID {
+			t.Errorf("expected avatar %s, got %+v (%v)", avatarID, got, err)
+		}
+
+		// 읽은 뒤 다른 요청이 먼저 바꿨으면 교체하지 않음
+		if ok, err := repo.UpdateAvatarIfUnchanged(ctx, u.ID, nil, &otherID); err != nil || ok {
+			t.Errorf("expected swap from a stale empty avatar to fail, got %v, %v", ok, err)
+		}
+		if ok, err := repo.UpdateAvatarIfUnchanged(ctx, u.ID, &otherID, nil); err != nil || ok {
+			t.Errorf("expected swap from a stale avatar to fail, got %v, %v", ok, err)
+		}
+		if got, err := repo.GetByID(ctx, u.ID); err != nil || got.AvatarID == nil || *got.AvatarID != avatarID {
+			t.Errorf("expected avatar %s to be kept, got %+v (%v)", avatarID, got, err)
+		}
+
+		if ok, err := repo.UpdateAvatarIfUnchanged(ctx, u.ID, &avatarID, nil); err != nil || !ok {
+			t.Fatalf("UpdateAvatarIfUnchanged(nil): %v, %v", ok, err)
+		}
+		if got, err := repo.GetByID(ctx, u.ID); err != nil || got.AvatarID != nil {
+			t.Errorf("expected avatar to be cleared, got %+v (%v)", got, err)
+		}
+
+		if ok, err := repo.UpdateAvatarIfUnchanged(ctx, 9999, nil, &avatarID); err != nil || ok {
+			t.Errorf("expected no swap for missing user, got %v, %v", ok, err)
+		}
+	})
+
+	t.Run("FailedLoginsAndLock", func(t *testing.T) {
+		repo := newRepo(t)
+		u := newUser("lock@example.com", "일본", "도쿄")
+		mustCreateUser(t, repo, u)
+
+		for want := 1; want <= 2; want++ {
+			failures, err := repo.IncrementFailedLogins(ctx, u.ID)
+			if err != nil {
+				t.Fatalf("IncrementFailedLogins: %v", err)
+			}
+			if failures != want {
+				t.Errorf("expected %d failures, got %d", want, failures)
+			}
+		}
+		if _, err := repo.IncrementFailedLogins(ctx, 9999); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("expected ErrRecordNotFound for missing user, got %v", err)
+		}
+
+		until := time.Now().Add(time.Minute)
+		if err := repo.LockUntil(ctx, u.ID, until); err != nil {
+			t.Fatalf("LockUntil: %v", err)
+		}
+		got, err := repo.GetByID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.FailedLoginAttempts != 2 || !got.IsLocked(time.Now()) || got.IsLocked(until.Add(time.Second)) {
+			t.Errorf("expected 2 failures and a one minute lock, got %d / %v", got.FailedLoginAttempts, got.LockedUntil)
+		}
+
+		// 로그인 성공 시 잠금 관련 컬럼만 초기화 (그사이 바뀐 다른 컬럼은 유지)
+		if err := repo.UpdateRole(ctx, u.ID, user.RoleModerator); err != nil {
+			t.Fatalf("UpdateRole: %v", err)
+		}
+		lastActive := time.Now().Add(time.Hour).Truncate(time.Second)
+		if err := repo.ResetLoginFailures(ctx, u.ID, lastActive); err != nil {
+			t.Fatalf("ResetLoginFailures: %v", err)
+		}
+		got, err = repo.GetByID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("GetByID: %v", err)
+		}
+		if got.FailedLoginAttempts != 0 || got.LockedUntil != nil || !got.LastActive.Equal(lastActive) || got.Role != user.RoleModerator {
+			t.Errorf("expected failures to be reset, got %d / %v / %v / %v", got.FailedLoginAttempts, got.LockedUntil, got.LastActive, got.Role)
+		}
+	})
+}
+
+// upcomingTrips - 앞으로 한 달 안에 country로 떠나는 여행 (newUser의 여행 기간 포함)
+func upcomingTrips(country string) repository.TripFilter {
+	now := time.Now()
+	return repository.TripFilter{Country: country, Start: now, End: now.Add(30 * 24 * time.Hour), EndsAfter: now}
+}
+
+func newUser(email, country, city string) *user.User {
+	now := time.Now()
+	return &user.User{
+		Email:       email,
+		Password:    "hashed-password",
+		Name:        "테스트 " + email,
+		Age:         28,
+		Country:     country,
+		City:        city,
+		TravelStart: now.Add(24 * time.Hour),
+		TravelEnd:   now.Add(7 * 24 * time.Hour),
+
+		EmailVerified: true,
+	}
+}
+
+func mustCreateUser(t *testing.T, repo repository.UserRepository, u *user.User) {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, u); err != nil {
+		t.Fatalf("Create(%s): %v", u.Email, err)
+	}
+}
+
+func assertUserIDs(t *testing.T, users []*user.User, want ...uint) {
+	t.Helper()
+	got := make([]uint, len(users))
+	for i, u := range users {
+		got[i] = u.ID
+	}
+	if !equalIDs(got, want) {
+		t.Errorf("expected user IDs %v, got %v", want, got)
+	}
+}
+
+// assertUserIDSet - 순서와 무관하게 사용자 ID 비교 (정렬 기준이 없는 조회용)
+func assertUserIDSet(t *testing.T, users []*user.User, want ...uint) {
+	t.Helper()
+	got := make([]uint, len(users))
+	for i, u := range users {
+		got[i] = u.ID
+	}
+	if !equalIDs(sortedIDs(got), sortedIDs(want)) {
+		t.Errorf("expected user IDs %v, got %v", want, got)
+	}
+}
+
+func sortedIDs(ids []uint) []uint {
+	sorted := append([]uint(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+func equalIDs(got, want []uint) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
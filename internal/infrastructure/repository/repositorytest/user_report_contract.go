@@ -0,0 +1,71 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+)
+
+// RunUserReportRepositoryContract - UserReportRepository 구현체가 지켜야 할 동작 검증 (newRepo는 매번 빈 저장소 반환)
+func RunUserReportRepositoryContract(t *testing.T, newRepo func(t *testing.T) repository.UserReportRepository) {
+	ctx := context.Background()
+	t.Run("ListNewestFirstByReported", func(t *testing.T) {
+		repo := newRepo(t)
+		base := time.Now().Add(-time.Hour)
+		messageID := uint(42)
+		first := mustCreateUserReport(t, repo, &user.UserReport{ReporterID: 1, ReportedID: 2, Reason: user.ReportReasonSpam, CreatedAt: base})
+		second := mustCreateUserReport(t, repo, &user.UserReport{ReporterID: 3, ReportedID: 2, Reason: user.ReportReasonHarassment, Description: "욕설", MessageID: &messageID, CreatedAt: base.Add(time.Minute)})
+		other := mustCreateUserReport(t, repo, &user.UserReport{ReporterID: 1, ReportedID: 4, Reason: user.ReportReasonScam, CreatedAt: base.Add(2 * time.Minute)})
+
+		reports, err := repo.List(ctx, 2, 0, 10)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserReportIDs(t, reports, second.ID, first.ID)
+		if reports[0].Reason != user.ReportReasonHarassment || reports[0].MessageID == nil || *reports[0].MessageID != messageID {
+			t.Errorf("stored report mismatch: %+v", reports[0])
+		}
+
+		all, err := repo.List(ctx, 0, 0, 10)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserReportIDs(t, all, other.ID, second.ID, first.ID)
+
+		page, err := repo.List(ctx, 0, 1, 1)
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		assertUserReportIDs(t, page, second.ID)
+
+		if count, err := repo.Count(ctx, 2); err != nil || count != 2 {
+			t.Errorf("expected 2 reports for user 2, got %d, %v", count, err)
+		}
+		if count, err := repo.Count(ctx, 0); err != nil || count != 3 {
+			t.Errorf("expected 3 reports in total, got %d, %v", count, err)
+		}
+	})
+}
+
+func mustCreateUserReport(t *testing.T, repo repository.UserReportRepository, report *user.UserReport) *user.UserReport {
+	ctx := context.Background()
+	t.Helper()
+	if err := repo.Create(ctx, report); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	return report
+}
+
+func assertUserReportIDs(t *testing.T, reports []*user.UserReport, want ...uint) {
+	t.Helper()
+	got := make([]uint, len(reports))
+	for i, report := range reports {
+		got[i] = report.ID
+	}
+	if !equalIDs(got, want) {
+		t.Errorf("expected report IDs %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type userBlockRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUserBlockRepository(db *gorm.DB) repository.UserBlockRepository {
+	return &userBlockRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *userBlockRepositoryImpl) Create(ctx context.Context, block *user.UserBlock) error {
+	return r.db.WithContext(ctx).Create(block).Error
+}
+
+func (r *userBlockRepositoryImpl) Delete(ctx context.Context, blockerID, blockedID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Delete(&user.UserBlock{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *userBlockRepositoryImpl) Exists(ctx context.Context, blockerID, blockedID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&user.UserBlock{}).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ListByBlocker - 최근 차단부터 조회
+func (r *userBlockRepositoryImpl) ListByBlocker(ctx context.Context, blockerID uint) ([]*user.UserBlock, error) {
+	var blocks []*user.UserBlock
+	err := r.db.WithContext(ctx).Where("blocker_id = ?", blockerID).
+		Order("created_at DESC, id DESC").Find(&blocks).Error
+	return blocks, err
+}
+
+func (r *userBlockRepositoryImpl) GetBlockedIDs(ctx context.Context, blockerID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Model(&user.UserBlock{}).
+		Where("blocker_id = ?", blockerID).Pluck("blocked_id", &ids).Error
+	return ids, err
+}
+
+// GetRelatedIDs - 양방향 차단 관계의 상대 사용자 ID (중복 제거)
+func (r *userBlockRepositoryImpl) GetRelatedIDs(ctx context.Context, userID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.WithContext(ctx).Raw(
+		"SELECT blocked_id FROM user_blocks WHERE blocker_id = ? UNION SELECT blocker_id FROM user_blocks WHERE blocked_id = ?",
+		userID, userID,
+	).Scan(&ids).Error
+	return ids, err
+}
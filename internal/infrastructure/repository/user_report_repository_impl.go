@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"gorm.io/gorm"
+)
+
+type userReportRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUserReportRepository(db *gorm.DB) repository.UserReportRepository {
+	return &userReportRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *userReportRepositoryImpl) Create(ctx context.Context, report *user.UserReport) error {
+	return r.db.WithContext(ctx).Create(report).Error
+}
+
+// List - 최근 신고부터 조회
+func (r *userReportRepositoryImpl) List(ctx context.Context, reportedID uint, offset, limit int) ([]*user.UserReport, error) {
+	var reports []*user.UserReport
+	err := r.byReported(ctx, reportedID).
+		Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&reports).Error
+	return reports, err
+}
+
+func (r *userReportRepositoryImpl) Count(ctx context.Context, reportedID uint) (int64, error) {
+	var count int64
+	err := r.byReported(ctx, reportedID).Count(&count).Error
+	return count, err
+}
+
+// byReported - 신고 대상 조건 (0이면 전체)
+func (r *userReportRepositoryImpl) byReported(ctx context.Context, reportedID uint) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&user.UserReport{})
+	if reportedID != 0 {
+		query = query.Where("reported_id = ?", reportedID)
+	}
+	return query
+}
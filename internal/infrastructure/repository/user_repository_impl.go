@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/chris910512/travel-chat/internal/domain/entity/user"
 	"github.com/chris910512/travel-chat/internal/domain/repository"
 	"gorm.io/gorm"
@@ -17,63 +18,195 @@ func NewUserRepository(db *gorm.DB) repository.UserRepository {
 	}
 }
 
-func (r *userRepositoryImpl) Create(user *user.User) error {
+func (r *userRepositoryImpl) Create(ctx context.Context, user *user.User) error {
 	user.LastActive = time.Now()
-	return r.db.Create(user).Error
+	return r.db.WithContext(ctx).Create(user).Error
 }
 
-func (r *userRepositoryImpl) GetByID(id uint) (*user.User, error) {
+func (r *userRepositoryImpl) GetByID(ctx context.Context, id uint) (*user.User, error) {
 	var u user.User
-	err := r.db.First(&u, id).Error
+	err := r.db.WithContext(ctx).First(&u, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &u, nil
 }
 
-func (r *userRepositoryImpl) GetByEmail(email string) (*user.User, error) {
+func (r *userRepositoryImpl) GetByIDs(ctx context.Context, ids []uint) ([]*user.User, error) {
+	var users []*user.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Order("id").Find(&users).Error
+	return users, err
+}
+
+func (r *userRepositoryImpl) GetByEmail(ctx context.Context, email string) (*user.User, error) {
 	var u user.User
-	err := r.db.Where("email = ?", email).First(&u).Error
+	err := r.db.WithContext(ctx).Where("email = ?", email).First(&u).Error
 	if err != nil {
 		return nil, err
 	}
 	return &u, nil
 }
 
-func (r *userRepositoryImpl) Update(user *user.User) error {
-	return r.db.Save(user).Error
+func (r *userRepositoryImpl) Update(ctx context.Context, user *user.User) error {
+	return r.db.WithContext(ctx).Save(user).Error
 }
 
-func (r *userRepositoryImpl) Delete(id uint) error {
-	return r.db.Delete(&user.User{}, id).Error
+func (r *userRepositoryImpl) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&user.User{}, id).Error
 }
 
-func (r *userRepositoryImpl) List(offset, limit int) ([]*user.User, error) {
+func (r *userRepositoryImpl) List(ctx context.Context, excludeIDs []uint, offset, limit int) ([]*user.User, error) {
 	var users []*user.User
-	err := r.db.Offset(offset).Limit(limit).Find(&users).Error
+	err := excluding(r.discoverable(ctx), excludeIDs).Order("id").Offset(offset).Limit(limit).Find(&users).Error
 	return users, err
 }
 
-func (r *userRepositoryImpl) GetByDestination(country, city string) ([]*user.User, error) {
+func (r *userRepositoryImpl) GetByDestination(ctx context.Context, country, city string) ([]*user.User, error) {
 	var users []*user.User
-	err := r.db.Where("country = ? AND city = ?", country, city).Find(&users).Error
+	err := r.discoverable(ctx).Where("country = ? AND city = ?", country, city).Find(&users).Error
 	return users, err
 }
 
-func (r *userRepositoryImpl) GetActiveUsers() ([]*user.User, error) {
+func (r *userRepositoryImpl) GetByTrip(ctx context.Context, filter repository.TripFilter, excludeIDs []uint) ([]*user.User, error) {
 	var users []*user.User
-	tenMinutesAgo := time.Now().Add(-10 * time.Minute)
-	err := r.db.Where("last_active > ?", tenMinutesAgo).Find(&users).Error
+	err := excluding(r.discoverable(ctx), excludeIDs).
+		Where("country = ? AND travel_start < ? AND travel_end > ? AND travel_end >= ?",
+			filter.Country, filter.End, filter.Start, filter.EndsAfter).
+		Order("id").Find(&users).Error
 	return users, err
 }
 
-func (r *userRepositoryImpl) UpdateLastActive(userID uint) error {
-	return r.db.Model(&user.User{}).Where("id = ?", userID).
+func (r *userRepositoryImpl) UpdateLastActive(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Model(&user.User{}).Where("id = ?", userID).
 		Update("last_active", time.Now()).Error
 }
 
-func (r *userRepositoryImpl) Count() (int64, error) {
+func (r *userRepositoryImpl) Count(ctx context.Context, excludeIDs []uint) (int64, error) {
+	var count int64
+	err := excluding(r.discoverable(ctx), excludeIDs).Model(&user.User{}).Count(&count).Error
+	return count, err
+}
+
+// IncrementFailedLogins - 연속 실패 횟수를 DB에서 증가 (동시에 실패해도 누락 없음)
+func (r *userRepositoryImpl) IncrementFailedLogins(ctx context.Context, userID uint) (int, error) {
+	var failures int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&user.User{}).Where("id = ?", userID).
+			UpdateColumn("failed_login_attempts", gorm.Expr("failed_login_attempts + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Model(&user.User{}).Where("id = ?", userID).
+			Select("failed_login_attempts").Scan(&failures).Error
+	})
+	return failures, err
+}
+
+func (r *userRepositoryImpl) LockUntil(ctx context.Context, userID uint, until time.Time) error {
+	return r.db.WithContext(ctx).Model(&user.User{}).Where("id = ?", userID).
+		UpdateColumn("locked_until", until).Error
+}
+
+// ResetLoginFailures - 로그인 성공 시 잠금 관련 컬럼과 마지막 활동 시간만 갱신 (다른 요청이 바꾼 컬럼을 덮어쓰지 않음)
+func (r *userRepositoryImpl) ResetLoginFailures(ctx context.Context, userID uint, lastActive time.Time) error {
+	return r.db.WithContext(ctx).Model(&user.User{}).Where("id = ?", userID).
+		UpdateColumns(map[string]interface{}{
+			"failed_login_attempts": 0,
+			"locked_until":          nil,
+			"last_active":           lastActive,
+		}).Error
+}
+
+// GetByIDIncludingSuspended - 정지(소프트 삭제)된 사용자도 조회
+func (r *userRepositoryImpl) GetByIDIncludingSuspended(ctx context.Context, id uint) (*user.User, error) {
+	var u user.User
+	err := r.db.WithContext(ctx).Unscoped().First(&u, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *userRepositoryImpl) ListForAdmin(ctx context.Context, filter repository.UserFilter, offset, limit int) ([]*user.User, error) {
+	var users []*user.User
+	err := r.adminScope(ctx, filter).Order("id").Offset(offset).Limit(limit).Find(&users).Error
+	return users, err
+}
+
+func (r *userRepositoryImpl) CountForAdmin(ctx context.Context, filter repository.UserFilter) (int64, error) {
 	var count int64
-	err := r.db.Model(&user.User{}).Count(&count).Error
+	err := r.adminScope(ctx, filter).Model(&user.User{}).Count(&count).Error
 	return count, err
 }
+
+// Restore - 정지된 사용자 복구 (deleted_at 해제)
+func (r *userRepositoryImpl) Restore(ctx context.Context, id uint) error {
+	result := r.db.WithContext(ctx).Unscoped().Model(&user.User{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateAvatarIfUnchanged - 읽은 뒤 다른 요청이 바꾸지 않았을 때만 프로필 사진 교체 (교체된 사진을 한 요청만 지우도록)
+func (r *userRepositoryImpl) UpdateAvatarIfUnchanged(ctx context.Context, userID uint, previousID, avatarID *string) (bool, error) {
+	query := r.db.WithContext(ctx).Model(&user.User{}).Where("id = ?", userID)
+	if previousID == nil {
+		query = query.Where("avatar_id IS NULL")
+	} else {
+		query = query.Where("avatar_id = ?", *previousID)
+	}
+	result := query.Update("avatar_id", avatarID)
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *userRepositoryImpl) UpdateRole(ctx context.Context, id uint, role user.Role) error {
+	result := r.db.WithContext(ctx).Model(&user.User{}).Where("id = ?", id).Update("role", role)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// adminScope - 관리자 목록 공통 조건 (정지된 사용자 포함)
+func (r *userRepositoryImpl) adminScope(ctx context.Context, filter repository.UserFilter) *gorm.DB {
+	db := r.db.WithContext(ctx).Unscoped()
+	if filter.Role != nil {
+		db = db.Where("role = ?", *filter.Role)
+	}
+	if filter.Suspended != nil {
+		if *filter.Suspended {
+			db = db.Where("deleted_at IS NOT NULL")
+		} else {
+			db = db.Where("deleted_at IS NULL")
+		}
+	}
+	return db
+}
+
+// discoverable - 탐색 조회 공통 조건 (이메일 인증을 마친 사용자만)
+func (r *userRepositoryImpl) discoverable(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Where("email_verified = ?", true)
+}
+
+// excluding - 지정한 사용자 제외 조건 (비어 있으면 조건 없음)
+func excluding(query *gorm.DB, excludeIDs []uint) *gorm.DB {
+	if len(excludeIDs) == 0 {
+		return query
+	}
+	return query.Where("id NOT IN ?", excludeIDs)
+}
@@ -17,3 +17,11 @@ func HashPassword(password string) (string, error) {
 func CheckPassword(password, hashedPassword string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
 }
+
+// dummyHash - 없는 계정의 로그인에도 비교할 해시 (HashPassword와 같은 bcrypt.DefaultCost)
+const dummyHash = "$2a$10$yqJVdT3ynqFMqvzmhtqT8uFk/qSSAj.ukP8PdCHOQlJ4gIfpvLmje"
+
+// SimulatePasswordCheck - CheckPassword와 같은 시간이 걸리는 가짜 검증 (응답 시간으로 가입 여부를 알 수 없도록)
+func SimulatePasswordCheck(password string) {
+	_ = bcrypt.CompareHashAndPassword([]byte(dummyHash), []byte(password))
+}
@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// NewSecretToken - 메일 링크 등으로 전달하는 1회용 토큰 생성 (원문과 저장용 해시 반환)
+//
+// 원문은 사용자에게만 전달하고 저장소에는 HashToken 결과만 저장한다.
+func NewSecretToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+// HashToken - 토큰 원문의 SHA-256 해시 (무작위 256비트 토큰이므로 bcrypt 없이도 역산 불가)
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,83 @@
+package authz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+)
+
+// Authorizer - 역할 기반 권한 검사 (ADMIN_USER_IDS에 등록된 사용자는 저장된 역할과 관계없이 관리자)
+type Authorizer struct {
+	adminIDs map[uint]struct{}
+}
+
+// NewAuthorizer - Authorizer 생성자
+func NewAuthorizer(adminIDs []uint) *Authorizer {
+	admins := make(map[uint]struct{}, len(adminIDs))
+	for _, id := range adminIDs {
+		admins[id] = struct{}{}
+	}
+
+	return &Authorizer{
+		adminIDs: admins,
+	}
+}
+
+// ParseAdminIDs - 쉼표로 구분된 관리자 사용자 ID 목록 파싱 (예: "1,2,3")
+func ParseAdminIDs(value string) ([]uint, error) {
+	var ids []uint
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		id, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || id == 0 {
+			return nil, fmt.Errorf("invalid admin user id %q", part)
+		}
+		ids = append(ids, uint(id))
+	}
+
+	return ids, nil
+}
+
+// RoleOf - 사용자의 실제 역할 (설정으로 지정된 관리자는 항상 admin)
+func (a *Authorizer) RoleOf(u *user.User) user.Role {
+	if _, ok := a.adminIDs[u.ID]; ok {
+		return user.RoleAdmin
+	}
+	return u.Role
+}
+
+// HasRole - 사용자가 required 이상의 역할인지 확인
+func (a *Authorizer) HasRole(u *user.User, required user.Role) bool {
+	role := a.RoleOf(u)
+	return role.AtLeast(required)
+}
+
+// CanModifyUser - 요청자가 대상 사용자 정보를 변경할 수 있는지 확인 (본인 또는 관리자)
+func (a *Authorizer) CanModifyUser(actor *user.User, targetID uint) bool {
+	if actor == nil || actor.ID == 0 {
+		return false
+	}
+	return actor.ID == targetID || a.HasRole(actor, user.RoleAdmin)
+}
+
+// CanModerateUser - 요청자가 대상 사용자를 정지/복구할 수 있는지 확인 (운영자 이상, 자신보다 낮은 역할만)
+func (a *Authorizer) CanModerateUser(actor, target *user.User) bool {
+	if actor == nil || target == nil || actor.ID == target.ID {
+		return false
+	}
+	return a.HasRole(actor, user.RoleModerator) && a.RoleOf(actor) > a.RoleOf(target)
+}
+
+// CanChangeRole - 요청자가 대상 사용자의 역할을 바꿀 수 있는지 확인 (관리자만, 본인 역할은 변경 불가)
+func (a *Authorizer) CanChangeRole(actor, target *user.User) bool {
+	if actor == nil || target == nil || actor.ID == target.ID {
+		return false
+	}
+	return a.HasRole(actor, user.RoleAdmin)
+}
@@ -0,0 +1,245 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // 디코더 등록 (GIF는 첫 프레임만 사용)
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // 디코더 등록
+)
+
+// 허용하는 이미지 형식 (확장자나 헤더가 아니라 내용으로 판별)
+const (
+	TypeJPEG = "image/jpeg"
+	TypePNG  = "image/png"
+	TypeGIF  = "image/gif"
+	TypeWebP = "image/webp"
+)
+
+// maxPixels - 디코딩 전에 거부하는 픽셀 수 (작은 파일이 거대한 이미지로 풀리는 것 방지)
+const maxPixels = 50_000_000
+
+// jpegQuality - 다시 인코딩할 때 JPEG 품질
+const jpegQuality = 85
+
+var (
+	ErrTooLarge          = errors.New("imaging: file too large")
+	ErrUnsupportedFormat = errors.New("imaging: unsupported image format")
+	ErrTooManyPixels     = errors.New("imaging: image dimensions too large")
+	ErrInvalidImage      = errors.New("imaging: invalid image")
+)
+
+// Image - 다시 인코딩한 이미지 (원본의 EXIF 등 메타데이터는 남지 않음)
+type Image struct {
+	ContentType string
+	Data        []byte
+	Width       int
+	Height      int
+}
+
+// Config - 이미지 처리 설정
+type Config struct {
+	MaxBytes      int64 `yaml:"max_bytes"`      // 업로드 최대 크기
+	MaxDimension  int   `yaml:"max_dimension"`  // 저장할 이미지의 긴 변 최대 픽셀 (넘으면 축소)
+	ThumbnailSize int   `yaml:"thumbnail_size"` // 썸네일의 긴 변 픽셀
+}
+
+// DefaultConfig - 기본 설정 (10MB, 긴 변 2048px, 썸네일 320px)
+func DefaultConfig() Config {
+	return Config{
+		MaxBytes:      10 << 20,
+		MaxDimension:  2048,
+		ThumbnailSize: 320,
+	}
+}
+
+// Validate - 설정 값 검증
+func (c Config) Validate() error {
+	var errs []error
+	if c.MaxBytes <= 0 {
+		errs = append(errs, errors.New("max bytes must be positive"))
+	}
+	if c.MaxDimension <= 0 {
+		errs = append(errs, errors.New("max dimension must be positive"))
+	}
+	if c.ThumbnailSize <= 0 || c.ThumbnailSize > c.MaxDimension {
+		errs = append(errs, fmt.Errorf("thumbnail size must be between 1 and max dimension (%d)", c.MaxDimension))
+	}
+	return errors.Join(errs...)
+}
+
+// Processor - 업로드 이미지 검증, 방향 보정, 축소, 재인코딩
+type Processor struct {
+	cfg Config
+}
+
+// NewProcessor - Processor 생성자 (설정 값이 0 이하이면 기본값)
+func NewProcessor(cfg Config) *Processor {
+	defaults := DefaultConfig()
+	if cfg.MaxBytes <= 0 {
+		cfg.MaxBytes = defaults.MaxBytes
+	}
+	if cfg.MaxDimension <= 0 {
+		cfg.MaxDimension = defaults.MaxDimension
+	}
+	if cfg.ThumbnailSize <= 0 {
+		cfg.ThumbnailSize = defaults.ThumbnailSize
+	}
+	return &Processor{cfg: cfg}
+}
+
+// MaxBytes - 업로드 최대 크기
+func (p *Processor) MaxBytes() int64 {
+	return p.cfg.MaxBytes
+}
+
+// Read - 최대 크기까지 읽기 (넘으면 ErrTooLarge, 나머지는 읽지 않음)
+func (p *Processor) Read(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, p.cfg.MaxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > p.cfg.MaxBytes {
+		return nil, ErrTooLarge
+	}
+	return data, nil
+}
+
+// Decode - 내용으로 형식을 판별해 디코딩하고 EXIF 방향을 적용 (긴 변은 MaxDimension 이하로 축소)
+func (p *Processor) Decode(data []byte) (image.Image, string, error) {
+	if int64(len(data)) > p.cfg.MaxBytes {
+		return nil, "", ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case TypeJPEG, TypePNG, TypeGIF, TypeWebP:
+	default:
+		return nil, "", ErrUnsupportedFormat
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width <= 0 || config.Height <= 0 {
+		return nil, "", ErrInvalidImage
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, "", ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", ErrInvalidImage
+	}
+
+	img = Fit(img, p.cfg.MaxDimension)
+	if contentType == TypeJPEG {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+	return img, contentType, nil
+}
+
+// Process - 저장할 이미지와 썸네일 생성 (JPEG는 JPEG로, 나머지는 투명도를 유지하도록 PNG로 인코딩)
+func (p *Processor) Process(data []byte) (original, thumbnail *Image, err error) {
+	img, contentType, err := p.Decode(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outputType := outputTypeFor(contentType)
+	if original, err = Encode(img, outputType); err != nil {
+		return nil, nil, err
+	}
+	if thumbnail, err = Encode(Fit(img, p.cfg.ThumbnailSize), outputType); err != nil {
+		return nil, nil, err
+	}
+	return original, thumbnail, nil
+}
+
+// ProcessSquare - 가운데를 정사각형으로 잘라 크기별 이미지 생성 (프로필 사진용, sizes 순서대로 반환)
+func (p *Processor) ProcessSquare(data []byte, sizes []int) ([]*Image, error) {
+	img, contentType, err := p.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	square := CropSquare(img)
+	outputType := outputTypeFor(contentType)
+	images := make([]*Image, len(sizes))
+	for i, size := range sizes {
+		if images[i], err = Encode(Fit(square, size), outputType); err != nil {
+			return nil, err
+		}
+	}
+	return images, nil
+}
+
+// outputTypeFor - 저장 형식 (JPEG는 JPEG로, 나머지는 투명도를 유지하도록 PNG)
+func outputTypeFor(contentType string) string {
+	if contentType == TypeJPEG {
+		return TypeJPEG
+	}
+	return TypePNG
+}
+
+// CropSquare - 짧은 변 길이의 정사각형으로 가운데 부분 자르기
+func CropSquare(img image.Image) image.Image {
+	bounds := img.Bounds()
+	if bounds.Dx() == bounds.Dy() {
+		return img
+	}
+	side := min(bounds.Dx(), bounds.Dy())
+
+	x := bounds.Min.X + (bounds.Dx()-side)/2
+	y := bounds.Min.Y + (bounds.Dy()-side)/2
+	dst := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(dst, dst.Bounds(), img, image.Point{X: x, Y: y}, draw.Src)
+	return dst
+}
+
+// Fit - 긴 변이 size 이하가 되도록 비율을 유지해 축소 (이미 작으면 그대로)
+func Fit(img image.Image, size int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= size && height <= size {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*size/width)
+		width = size
+	} else {
+		width = max(1, width*size/height)
+		height = size
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Src, nil)
+	return dst
+}
+
+// Encode - JPEG 또는 PNG로 인코딩
+func Encode(img image.Image, contentType string) (*Image, error) {
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case TypeJPEG:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	case TypePNG:
+		err = png.Encode(&buf, img)
+	default:
+		return nil, fmt.Errorf("imaging: cannot encode %s", contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	return &Image{ContentType: contentType, Data: buf.Bytes(), Width: bounds.Dx(), Height: bounds.Dy()}, nil
+}
@@ -0,0 +1,167 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// twoColorImage - 왼쪽 절반은 빨강, 오른쪽 절반은 파랑
+func twoColorImage(width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= width/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+// withExif - SOI 바로 뒤에 방향 값과 위치 정보 흉내를 담은 APP1 세그먼트 삽입
+func withExif(t *testing.T, jpegData []byte, orientation uint16) []byte {
+	t.Helper()
+	var tiff bytes.Buffer
+	tiff.WriteString("MM\x00\x2a")
+	binary.Write(&tiff, binary.BigEndian, uint32(8))
+	binary.Write(&tiff, binary.BigEndian, uint16(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{exifOrientationTag, 3})
+	binary.Write(&tiff, binary.BigEndian, uint32(1))
+	binary.Write(&tiff, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(&tiff, binary.BigEndian, uint32(0))
+	tiff.WriteString("GPS 37.5665N 126.9780E")
+
+	segment := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+	var out bytes.Buffer
+	out.Write(jpegData[:2])
+	out.Write([]byte{0xFF, 0xE1})
+	binary.Write(&out, binary.BigEndian, uint16(len(segment)+2))
+	out.Write(segment)
+	out.Write(jpegData[2:])
+	return out.Bytes()
+}
+
+func TestProcessAppliesOrientationAndStripsExif(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, twoColorImage(40, 20), nil); err != nil {
+		t.Fatal(err)
+	}
+	data := withExif(t, buf.Bytes(), 6)
+	if jpegOrientation(data) != 6 {
+		t.Fatalf("expected orientation 6, got %d", jpegOrientation(data))
+	}
+
+	processor := NewProcessor(Config{MaxBytes: 1 << 20, MaxDimension: 100, ThumbnailSize: 10})
+	original, thumbnail, err := processor.Process(data)
+	if err != nil {
+		t.Fatalf("Process: %v", err)
+	}
+	if original.ContentType != TypeJPEG || original.Width != 20 || original.Height != 40 {
+		t.Fatalf("expected rotated 20x40 JPEG, got %s %dx%d", original.ContentType, original.Width, original.Height)
+	}
+	if thumbnail.Width != 5 || thumbnail.Height != 10 {
+		t.Errorf("expected 5x10 thumbnail, got %dx%d", thumbnail.Width, thumbnail.Height)
+	}
+	for _, out := range [][]byte{original.Data, thumbnail.Data} {
+		if bytes.Contains(out, []byte("Exif")) || bytes.Contains(out, []byte("GPS")) {
+			t.Error("expected metadata to be stripped")
+		}
+	}
+
+	// 시계 방향으로 돌리면 왼쪽(빨강)이 위로 감
+	decoded, err := jpeg.Decode(bytes.NewReader(original.Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	top, _, _, _ := decoded.At(10, 5).RGBA()
+	_, _, bottomBlue, _ := decoded.At(10, 35).RGBA()
+	if top < 0x8000 || bottomBlue < 0x8000 {
+		t.Errorf("unexpected colors after rotation: top red %x, bottom blue %x", top, bottomBlue)
+	}
+}
+
+func TestProcessKeepsTransparencyAsPNG(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 600, 300))
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	original, thumbnail, err := NewProcessor(Config{MaxBytes: 1 << 20, MaxDimension: 300, ThumbnailSize: 30}).Process(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Process: %v", err)
+	}
+	if original.ContentType != TypePNG || original.Width != 300 || original.Height != 150 {
+		t.Errorf("expected downscaled 300x150 PNG, got %s %dx%d", original.ContentType, original.Width, original.Height)
+	}
+	if thumbnail.Width != 30 || thumbnail.Height != 15 {
+		t.Errorf("expected 30x15 thumbnail, got %dx%d", thumbnail.Width, thumbnail.Height)
+	}
+}
+
+func TestProcessSquareCropsCenter(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, twoColorImage(80, 40)); err != nil {
+		t.Fatal(err)
+	}
+
+	images, err := NewProcessor(DefaultConfig()).ProcessSquare(buf.Bytes(), []int{10, 30, 100})
+	if err != nil {
+		t.Fatalf("ProcessSquare: %v", err)
+	}
+	for i, want := range []int{10, 30, 40} {
+		if images[i].Width != want || images[i].Height != want || images[i].ContentType != TypePNG {
+			t.Errorf("expected %dx%d PNG, got %s %dx%d", want, want, images[i].ContentType, images[i].Width, images[i].Height)
+		}
+	}
+
+	// 가운데를 자르면 빨강/파랑 경계가 가운데에 옴 (확대하지 않으므로 40x40)
+	decoded, err := png.Decode(bytes.NewReader(images[2].Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, _, _, _ := decoded.At(0, 20).RGBA(); r>>8 != 255 {
+		t.Error("expected left edge to be red")
+	}
+	if _, _, b, _ := decoded.At(39, 20).RGBA(); b>>8 != 255 {
+		t.Error("expected right edge to be blue")
+	}
+}
+
+func TestRejectsInvalidUploads(t *testing.T) {
+	processor := NewProcessor(Config{MaxBytes: 64, MaxDimension: 100, ThumbnailSize: 10})
+
+	if _, err := processor.Read(strings.NewReader(strings.Repeat("a", 65))); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("expected ErrTooLarge, got %v", err)
+	}
+	if _, _, err := processor.Process([]byte("<html>not an image</html>")); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("expected ErrUnsupportedFormat, got %v", err)
+	}
+	if _, _, err := processor.Process([]byte("\x89PNG\r\n\x1a\ngarbage")); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("expected ErrInvalidImage, got %v", err)
+	}
+
+	// 헤더만 있는 100000x100000 PNG (디코딩 전에 거부)
+	var ihdr bytes.Buffer
+	binary.Write(&ihdr, binary.BigEndian, []uint32{100000, 100000})
+	ihdr.Write([]byte{8, 6, 0, 0, 0})
+	chunk := append([]byte("IHDR"), ihdr.Bytes()...)
+	var bomb bytes.Buffer
+	bomb.WriteString("\x89PNG\r\n\x1a\n")
+	binary.Write(&bomb, binary.BigEndian, uint32(ihdr.Len()))
+	bomb.Write(chunk)
+	binary.Write(&bomb, binary.BigEndian, crc32.ChecksumIEEE(chunk))
+	if _, _, err := processor.Process(bomb.Bytes()); !errors.Is(err, ErrTooManyPixels) {
+		t.Errorf("expected ErrTooManyPixels, got %v", err)
+	}
+}
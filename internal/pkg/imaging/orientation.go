@@ -0,0 +1,127 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+// exifOrientationTag - EXIF Orientation 태그 (1: 정상, 2~8: 반전/회전 필요)
+const exifOrientationTag = 0x0112
+
+// jpegOrientation - JPEG의 EXIF(APP1) 세그먼트에서 방향 값 읽기 (없거나 읽을 수 없으면 1)
+//
+// 재인코딩하면 EXIF가 사라지므로 세로로 찍은 사진이 눕지 않게 방향을 미리 적용해야 함
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF: // 채움 바이트
+			i++
+			continue
+		case marker == 0x01 || marker >= 0xD0 && marker <= 0xD7: // 길이 없는 마커
+			i += 2
+			continue
+		case marker == 0xDA || marker == 0xD9: // 이미지 데이터 시작 (이후에는 EXIF 없음)
+			return 1
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// tiffOrientation - EXIF의 TIFF 헤더와 첫 IFD에서 방향 태그 찾기
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	if order.Uint16(tiff[2:]) != 42 {
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[ifd:]))
+	for n := 0; n < count; n++ {
+		entry := ifd + 2 + n*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) != exifOrientationTag {
+			continue
+		}
+		// SHORT 값은 값 필드의 앞 2바이트에 들어 있음
+		if orientation := int(order.Uint16(tiff[entry+8:])); orientation >= 1 && orientation <= 8 {
+			return orientation
+		}
+		return 1
+	}
+	return 1
+}
+
+// applyOrientation - EXIF 방향 값대로 반전/회전한 이미지 반환
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 { // 90도 회전이 들어가면 가로/세로가 바뀜
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // 좌우 반전
+				dx, dy = width-1-x, y
+			case 3: // 180도 회전
+				dx, dy = width-1-x, height-1-y
+			case 4: // 상하 반전
+				dx, dy = x, height-1-y
+			case 5: // 좌우 반전 후 반시계 90도
+				dx, dy = y, x
+			case 6: // 시계 방향 90도
+				dx, dy = height-1-y, x
+			case 7: // 좌우 반전 후 시계 90도
+				dx, dy = height-1-y, width-1-x
+			case 8: // 반시계 방향 90도
+				dx, dy = y, width-1-x
+			}
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+	return dst
+}
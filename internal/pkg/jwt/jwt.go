@@ -1,76 +1,138 @@
 package jwt
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// 토큰 종류 (typ 클레임)
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// 기본 토큰 유효 기간
+const (
+	DefaultAccessTokenTTL  = 15 * time.Minute
+	DefaultRefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrWrongTokenType = errors.New("unexpected token type")
+)
+
 // JWTClaims - JWT 클레임 구조체
 type JWTClaims struct {
-	UserID uint   `json:"user_id"`
-	Email  string `json:"email"`
+	UserID    uint   `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role,omitempty"` // 액세스 토큰에만 포함 (발급 시점의 역할)
+	TokenType string `json:"typ"`
 	jwt.RegisteredClaims
 }
 
 // JWTService - JWT 서비스
 type JWTService struct {
-	secretKey []byte
-	issuer    string
+	secretKey  []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
 }
 
-// NewJWTService - JWT 서비스 생성자
-func NewJWTService(secretKey, issuer string) *JWTService {
+// NewJWTService - JWT 서비스 생성자 (유효 기간이 0 이하이면 기본값 사용)
+func NewJWTService(secretKey, issuer string, accessTTL, refreshTTL time.Duration) *JWTService {
+	if accessTTL <= 0 {
+		accessTTL = DefaultAccessTokenTTL
+	}
+	if refreshTTL <= 0 {
+		refreshTTL = DefaultRefreshTokenTTL
+	}
+
 	return &JWTService{
-		secretKey: []byte(secretKey),
-		issuer:    issuer,
+		secretKey:  []byte(secretKey),
+		issuer:     issuer,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
 	}
 }
 
-// GenerateToken - JWT 토큰 생성
-func (j *JWTService) GenerateToken(userID uint, email string) (string, error) {
-	claims := &JWTClaims{
-		UserID: userID,
-		Email:  email,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)), // 24시간 유효
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
-			Issuer:    j.issuer,
-		},
-	}
+// AccessTokenTTL - 액세스 토큰 유효 기간
+func (j *JWTService) AccessTokenTTL() time.Duration {
+	return j.accessTTL
+}
+
+// GenerateToken - 액세스 토큰 생성 (역할 포함)
+func (j *JWTService) GenerateToken(userID uint, email, role string) (string, error) {
+	token, _, err := j.generate(userID, email, role, TokenTypeAccess, j.accessTTL)
+	return token, err
+}
+
+// GenerateRefreshToken - 리프레시 토큰 생성 (저장소에 기록할 수 있도록 JTI가 담긴 클레임도 반환)
+//
+// 역할은 갱신할 때 저장된 사용자 정보에서 다시 읽으므로 리프레시 토큰에는 넣지 않는다.
+func (j *JWTService) GenerateRefreshToken(userID uint, email string) (string, *JWTClaims, error) {
+	return j.generate(userID, email, "", TokenTypeRefresh, j.refreshTTL)
+}
+
+// ValidateAccessToken - 액세스 토큰 검증 (리프레시 토큰은 거부)
+func (j *JWTService) ValidateAccessToken(tokenString string) (*JWTClaims, error) {
+	return j.validate(tokenString, TokenTypeAccess)
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(j.secretKey)
+// ValidateRefreshToken - 리프레시 토큰 검증 (액세스 토큰은 거부)
+func (j *JWTService) ValidateRefreshToken(tokenString string) (*JWTClaims, error) {
+	return j.validate(tokenString, TokenTypeRefresh)
 }
 
-// GenerateRefreshToken - 리프레시 토큰 생성 (7일 유효)
-func (j *JWTService) GenerateRefreshToken(userID uint, email string) (string, error) {
+// NewTokenID - 무작위 토큰 식별자 생성 (JTI, 토큰 계열 ID 등)
+func NewTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (j *JWTService) generate(userID uint, email, role, tokenType string, ttl time.Duration) (string, *JWTClaims, error) {
+	jti, err := NewTokenID()
+	if err != nil {
+		return "", nil, err
+	}
+
+	now := time.Now()
 	claims := &JWTClaims{
-		UserID: userID,
-		Email:  email,
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		TokenType: tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)), // 7일 유효
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-			NotBefore: jwt.NewNumericDate(time.Now()),
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    j.issuer,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(j.secretKey)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(j.secretKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, claims, nil
 }
 
-// ValidateToken - JWT 토큰 검증
-func (j *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
+func (j *JWTService) validate(tokenString, tokenType string) (*JWTClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
 		// 서명 방법 확인
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.New("unexpected signing method")
 		}
 		return j.secretKey, nil
-	})
+	}, jwt.WithIssuer(j.issuer))
 
 	if err != nil {
 		return nil, err
@@ -78,30 +140,13 @@ func (j *JWTService) ValidateToken(tokenString string) (*JWTClaims, error) {
 
 	claims, ok := token.Claims.(*JWTClaims)
 	if !ok || !token.Valid {
-		return nil, errors.New("invalid token")
-	}
-
-	return claims, nil
-}
-
-// RefreshToken - 토큰 갱신
-func (j *JWTService) RefreshToken(refreshTokenString string) (string, string, error) {
-	claims, err := j.ValidateToken(refreshTokenString)
-	if err != nil {
-		return "", "", err
+		return nil, ErrInvalidToken
 	}
 
-	// 새로운 액세스 토큰 생성
-	newAccessToken, err := j.GenerateToken(claims.UserID, claims.Email)
-	if err != nil {
-		return "", "", err
+	// 토큰 종류 확인 (typ 클레임이 없는 이전 토큰도 거부)
+	if claims.TokenType != tokenType {
+		return nil, ErrWrongTokenType
 	}
 
-	// 새로운 리프레시 토큰 생성
-	newRefreshToken, err := j.GenerateRefreshToken(claims.UserID, claims.Email)
-	if err != nil {
-		return "", "", err
-	}
-
-	return newAccessToken, newRefreshToken, nil
+	return claims, nil
 }
@@ -0,0 +1,132 @@
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 요청 ID 전달 키 (HTTP 헤더, gRPC 메타데이터)
+const (
+	RequestIDHeader      = "X-Request-ID"
+	RequestIDMetadataKey = "x-request-id"
+)
+
+// 외부에서 전달된 요청 ID 최대 길이
+const maxRequestIDLength = 128
+
+type contextKey struct{}
+
+// requestInfo - 요청 하나의 로그 공통 필드 (인증 후 사용자 ID가 채워짐)
+type requestInfo struct {
+	mu        sync.RWMutex
+	requestID string
+	userID    uint
+}
+
+// NewContext - 요청 ID를 가진 컨텍스트 생성 (요청 시작 시 한 번 호출)
+func NewContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, &requestInfo{requestID: requestID})
+}
+
+// RequestID - 컨텍스트의 요청 ID (없으면 빈 문자열)
+func RequestID(ctx context.Context) string {
+	info := infoFromContext(ctx)
+	if info == nil {
+		return ""
+	}
+	info.mu.RLock()
+	defer info.mu.RUnlock()
+	return info.requestID
+}
+
+// SetUserID - 인증된 사용자 ID 기록 (이후 같은 요청의 로그에 포함)
+func SetUserID(ctx context.Context, userID uint) {
+	info := infoFromContext(ctx)
+	if info == nil {
+		return
+	}
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.userID = userID
+}
+
+// UserID - 컨텍스트에 기록된 사용자 ID (인증 전이면 0)
+func UserID(ctx context.Context) uint {
+	info := infoFromContext(ctx)
+	if info == nil {
+		return 0
+	}
+	info.mu.RLock()
+	defer info.mu.RUnlock()
+	return info.userID
+}
+
+// FromContext - 요청 ID, 사용자 ID, 추적 ID가 포함된 로거
+func FromContext(ctx context.Context) *slog.Logger {
+	log := slog.Default()
+	if ctx == nil {
+		return log
+	}
+
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		log = log.With("trace_id", spanContext.TraceID().String(), "span_id", spanContext.SpanID().String())
+	}
+
+	info := infoFromContext(ctx)
+	if info == nil {
+		return log
+	}
+
+	info.mu.RLock()
+	defer info.mu.RUnlock()
+	if info.requestID != "" {
+		log = log.With("request_id", info.requestID)
+	}
+	if info.userID != 0 {
+		log = log.With("user_id", info.userID)
+	}
+	return log
+}
+
+// NewRequestID - 새 요청 ID 생성 (128비트 랜덤 hex)
+func NewRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// RequestIDOrNew - 전달받은 요청 ID가 안전한 형식이면 그대로, 아니면 새로 생성
+func RequestIDOrNew(requestID string) string {
+	if isValidRequestID(requestID) {
+		return requestID
+	}
+	return NewRequestID()
+}
+
+// isValidRequestID - 로그 주입을 막기 위해 길이와 문자 제한
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range requestID {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func infoFromContext(ctx context.Context) *requestInfo {
+	info, _ := ctx.Value(contextKey{}).(*requestInfo)
+	return info
+}
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// 로그 출력 형식
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Config - 로거 설정
+type Config struct {
+	Level  string `yaml:"level"`  // debug | info | warn | error
+	Format string `yaml:"format"` // json | text
+}
+
+// DefaultConfig - 기본 설정 (info 이상, JSON)
+func DefaultConfig() Config {
+	return Config{
+		Level:  "info",
+		Format: FormatJSON,
+	}
+}
+
+// Validate - 레벨과 형식 검증
+func (c Config) Validate() error {
+	if _, err := ParseLevel(c.Level); err != nil {
+		return err
+	}
+	if c.Format != FormatJSON && c.Format != FormatText {
+		return fmt.Errorf("unknown log format %q (use %s or %s)", c.Format, FormatJSON, FormatText)
+	}
+	return nil
+}
+
+// New - 설정에 맞는 slog 로거 생성
+func New(w io.Writer, cfg Config) (*slog.Logger, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	level, _ := ParseLevel(cfg.Level)
+	opts := &slog.HandlerOptions{Level: level}
+
+	var handler slog.Handler
+	if cfg.Format == FormatText {
+		handler = slog.NewTextHandler(w, opts)
+	} else {
+		handler = slog.NewJSONHandler(w, opts)
+	}
+	return slog.New(handler), nil
+}
+
+// ParseLevel - 로그 레벨 문자열 파싱
+func ParseLevel(value string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.TrimSpace(value))); err != nil {
+		return 0, fmt.Errorf("unknown log level %q (use debug, info, warn or error)", value)
+	}
+	return level, nil
+}
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRequestIDOrNew(t *testing.T) {
+	if got := RequestIDOrNew("abc-123_x.y:z"); got != "abc-123_x.y:z" {
+		t.Errorf("expected safe request id to be kept, got %q", got)
+	}
+
+	for _, unsafe := range []string{"", "has space", "line\nbreak", `quote"`, strings.Repeat("a", maxRequestIDLength+1)} {
+		got := RequestIDOrNew(unsafe)
+		if got == unsafe || len(got) != 32 {
+			t.Errorf("expected %q to be replaced with a generated id, got %q", unsafe, got)
+		}
+	}
+}
+
+func TestFromContextAddsRequestFields(t *testing.T) {
+	var buf bytes.Buffer
+	log, err := New(&buf, Config{Level: "debug", Format: FormatJSON})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	previous := slog.Default()
+	slog.SetDefault(log)
+	t.Cleanup(func() { slog.SetDefault(previous) })
+
+	ctx := NewContext(context.Background(), "req-1")
+	SetUserID(ctx, 42)
+	FromContext(ctx).Info("hello")
+	FromContext(context.Background()).Info("no request")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 log lines, got %d: %s", len(lines), buf.String())
+	}
+
+	var entry map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("log line is not JSON: %v", err)
+	}
+	if entry["request_id"] != "req-1" || entry["user_id"] != float64(42) {
+		t.Errorf("expected request_id and user_id fields, got %v", entry)
+	}
+	if strings.Contains(lines[1], "request_id") {
+		t.Errorf("expected no request fields without request context, got %s", lines[1])
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Errorf("default config should be valid: %v", err)
+	}
+	if err := (Config{Level: "verbose", Format: FormatJSON}).Validate(); err == nil {
+		t.Error("expected unknown level to be rejected")
+	}
+	if err := (Config{Level: "warn", Format: "xml"}).Validate(); err == nil {
+		t.Error("expected unknown format to be rejected")
+	}
+}
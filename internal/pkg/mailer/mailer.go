@@ -0,0 +1,125 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// 메일 발송 방식
+const (
+	DriverConsole = "console" // 표준 출력에 메일 내용 출력 (로컬 개발용)
+	DriverFile    = "file"    // 파일에 메일 내용 추가 (로컬 개발/테스트용)
+)
+
+// Message - 보낼 메일
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer - 메일 발송 (SMTP, 외부 메일 API 등은 이 인터페이스로 구현)
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Config - 메일 발송 설정
+type Config struct {
+	Driver   string `yaml:"driver"`    // console | file
+	FilePath string `yaml:"file_path"` // driver가 file일 때 출력 파일
+	From     string `yaml:"from"`      // 보내는 사람 주소
+}
+
+// DefaultConfig - 기본 설정 (표준 출력)
+func DefaultConfig() Config {
+	return Config{
+		Driver:   DriverConsole,
+		FilePath: "mail.log",
+		From:     "Travel Chat <no-reply@travel-chat.local>",
+	}
+}
+
+// Validate - 발송 방식 검증
+func (c Config) Validate() error {
+	switch c.Driver {
+	case DriverConsole:
+	case DriverFile:
+		if c.FilePath == "" {
+			return errors.New("file path is required for the file driver")
+		}
+	default:
+		return fmt.Errorf("unknown driver %q (use %s or %s)", c.Driver, DriverConsole, DriverFile)
+	}
+	if c.From == "" {
+		return errors.New("from address must not be empty")
+	}
+	return nil
+}
+
+// WriterMailer - 메일을 실제로 보내지 않고 Writer에 기록
+type WriterMailer struct {
+	mu     sync.Mutex
+	w      io.Writer
+	from   string
+	closer io.Closer
+}
+
+// New - 설정에 맞는 Mailer 생성 (서버 종료 시 Close 호출)
+func New(cfg Config) (*WriterMailer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.Driver == DriverFile {
+		file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		m := NewWriterMailer(file, cfg.From)
+		m.closer = file
+		return m, nil
+	}
+	return NewWriterMailer(os.Stdout, cfg.From), nil
+}
+
+// NewWriterMailer - WriterMailer 생성자
+func NewWriterMailer(w io.Writer, from string) *WriterMailer {
+	return &WriterMailer{
+		w:    w,
+		from: from,
+	}
+}
+
+// Send - 메일 한 통을 헤더와 본문 형식으로 기록
+func (m *WriterMailer) Send(ctx context.Context, msg Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Date: %s\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "From: %s\n", m.from)
+	fmt.Fprintf(&b, "To: %s\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\n\n", msg.Subject)
+	b.WriteString(strings.TrimRight(msg.Body, "\n"))
+	b.WriteString("\n\n")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, err := io.WriteString(m.w, b.String())
+	return err
+}
+
+// Close - 파일에 기록 중이면 파일 닫기
+func (m *WriterMailer) Close() error {
+	if m.closer == nil {
+		return nil
+	}
+	return m.closer.Close()
+}
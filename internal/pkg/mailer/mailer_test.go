@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFileMailerAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.log")
+	m, err := New(Config{Driver: DriverFile, FilePath: path, From: "no-reply@example.com"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for _, to := range []string{"a@example.com", "b@example.com"} {
+		if err := m.Send(context.Background(), Message{To: to, Subject: "비밀번호 재설정", Body: "링크: https://example.com\n"}); err != nil {
+			t.Fatalf("Send: %v", err)
+		}
+	}
+	if err := m.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	out := string(data)
+	for _, want := range []string{"From: no-reply@example.com", "To: a@example.com", "To: b@example.com", "Subject: 비밀번호 재설정", "링크: https://example.com"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected mail log to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Errorf("default config should be valid: %v", err)
+	}
+	for _, cfg := range []Config{
+		{Driver: "smtp", From: "x"},
+		{Driver: DriverFile, From: "x"},
+		{Driver: DriverConsole},
+	} {
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("expected %+v to be rejected", cfg)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "travel_chat"
+
+// Registry - 서버 지표 저장소 (/metrics에서 노출)
+var Registry = prometheus.NewRegistry()
+
+var factory = promauto.With(Registry)
+
+var (
+	httpRequestsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by route and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	grpcRequestsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "gRPC calls by method and status code.",
+	}, []string{"method", "type", "code"})
+
+	grpcRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "gRPC call latency by method (streams: until the stream closes).",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "type"})
+
+	dbQueryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "GORM query latency by operation and table.",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 2, 14), // 0.5ms ~ 4s
+	}, []string{"operation", "table"})
+
+	dbQueryErrorsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_query_errors_total",
+		Help:      "Failed GORM queries by operation and table (record not found excluded).",
+	}, []string{"operation", "table"})
+
+	websocketConnections = factory.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "websocket_connections",
+		Help:      "Currently open WebSocket chat connections.",
+	})
+
+	messagesSentTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_sent_total",
+		Help:      "Chat messages stored by room.",
+	}, []string{"room_id"})
+
+	expiredMessagesPurgedTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "expired_messages_purged_total",
+		Help:      "Expired chat messages permanently deleted by the janitor.",
+	})
+
+	messagesModeratedTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "messages_moderated_total",
+		Help:      "Chat messages masked or rejected by the moderation pipeline, by action and stage.",
+	}, []string{"action", "stage"})
+
+	imageUploadsTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "image_uploads_total",
+		Help:      "Image uploads by result (stored, rejected, failed).",
+	}, []string{"result"})
+
+	expiredBlobsPurgedTotal = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "expired_blobs_purged_total",
+		Help:      "Expired uploaded files deleted from blob storage by the janitor.",
+	})
+
+	rateLimitedTotal = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_requests_total",
+		Help:      "Requests and messages rejected by the rate limiter, by policy.",
+	}, []string{"policy"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler - Prometheus 텍스트 형식으로 지표를 내려주는 HTTP 핸들러
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// ObserveHTTPRequest - HTTP 요청 하나 기록 (route는 /api/users/:id 같은 경로 템플릿)
+func ObserveHTTPRequest(method, route string, status int, elapsed time.Duration) {
+	httpRequestsTotal.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	httpRequestDuration.WithLabelValues(method, route).Observe(elapsed.Seconds())
+}
+
+// ObserveGRPCRequest - gRPC 호출 하나 기록 (callType: unary | stream)
+func ObserveGRPCRequest(method, callType, code string, elapsed time.Duration) {
+	grpcRequestsTotal.WithLabelValues(method, callType, code).Inc()
+	grpcRequestDuration.WithLabelValues(method, callType).Observe(elapsed.Seconds())
+}
+
+// ObserveDBQuery - DB 쿼리 하나 기록
+func ObserveDBQuery(operation, table string, elapsed time.Duration, failed bool) {
+	dbQueryDuration.WithLabelValues(operation, table).Observe(elapsed.Seconds())
+	if failed {
+		dbQueryErrorsTotal.WithLabelValues(operation, table).Inc()
+	}
+}
+
+// WebSocketConnected - WebSocket 연결 수 증가
+func WebSocketConnected() {
+	websocketConnections.Inc()
+}
+
+// WebSocketDisconnected - WebSocket 연결 수 감소
+func WebSocketDisconnected() {
+	websocketConnections.Dec()
+}
+
+// MessageSent - 채팅방에 메시지 저장됨
+func MessageSent(roomID uint) {
+	messagesSentTotal.WithLabelValues(strconv.FormatUint(uint64(roomID), 10)).Inc()
+}
+
+// MessageModerated - 메시지 검사 결과 기록 (그대로 통과한 메시지는 기록하지 않음)
+func MessageModerated(action string, stages []string) {
+	for _, stage := range stages {
+		messagesModeratedTotal.WithLabelValues(action, stage).Inc()
+	}
+}
+
+// RateLimited - 요청 수 제한으로 거부됨
+func RateLimited(policy string) {
+	rateLimitedTotal.WithLabelValues(policy).Inc()
+}
+
+// ExpiredMessagesPurged - 만료 메시지 영구 삭제 개수 추가
+func ExpiredMessagesPurged(count int64) {
+	if count > 0 {
+		expiredMessagesPurgedTotal.Add(float64(count))
+	}
+}
+
+// ImageUploaded - 이미지 업로드 결과 기록 (result: stored | rejected | failed)
+func ImageUploaded(result string) {
+	imageUploadsTotal.WithLabelValues(result).Inc()
+}
+
+// ExpiredBlobsPurged - 만료된 업로드 파일 삭제 개수 추가
+func ExpiredBlobsPurged(count int) {
+	if count > 0 {
+		expiredBlobsPurgedTotal.Add(float64(count))
+	}
+}
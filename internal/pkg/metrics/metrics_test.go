@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type metricsTestRow struct {
+	ID   uint
+	Name string
+}
+
+func TestGormPluginObservesQueries(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.Use(GormPlugin{}); err != nil {
+		t.Fatalf("Use: %v", err)
+	}
+	if err := db.AutoMigrate(&metricsTestRow{}); err != nil {
+		t.Fatalf("AutoMigrate: %v", err)
+	}
+
+	if err := db.Create(&metricsTestRow{Name: "a"}).Error; err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	var row metricsTestRow
+	if err := db.First(&row, 9999).Error; err == nil {
+		t.Fatal("expected record not found")
+	}
+	if err := db.Table("missing_table").Create(map[string]interface{}{"name": "b"}).Error; err == nil {
+		t.Fatal("expected insert into missing table to fail")
+	}
+
+	table := "metrics_test_rows"
+	if got := testutil.CollectAndCount(dbQueryDuration, "travel_chat_db_query_duration_seconds"); got < 2 {
+		t.Errorf("expected query and create series, got %d", got)
+	}
+	if got := testutil.ToFloat64(dbQueryErrorsTotal.WithLabelValues("query", table)); got != 0 {
+		t.Errorf("record not found should not count as an error, got %v", got)
+	}
+	if got := testutil.ToFloat64(dbQueryErrorsTotal.WithLabelValues("create", "missing_table")); got != 1 {
+		t.Errorf("expected failed insert to be counted, got %v", got)
+	}
+}
+
+func TestHandlerExposesCounters(t *testing.T) {
+	ObserveHTTPRequest("POST", "/api/auth/login", 401, 120*time.Millisecond)
+	ObserveGRPCRequest("/user.UserService/Login", "unary", "Unauthenticated", 90*time.Millisecond)
+	MessageSent(7)
+	ExpiredMessagesPurged(3)
+	ExpiredMessagesPurged(0)
+	WebSocketConnected()
+	WebSocketConnected()
+	WebSocketDisconnected()
+
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(recorder.Body)
+
+	for _, want := range []string{
+		`travel_chat_http_requests_total{method="POST",route="/api/auth/login",status="401"} 1`,
+		`travel_chat_grpc_requests_total{code="Unauthenticated",method="/user.UserService/Login",type="unary"} 1`,
+		`travel_chat_messages_sent_total{room_id="7"} 1`,
+		`travel_chat_expired_messages_purged_total 3`,
+		`travel_chat_websocket_connections 1`,
+		`go_goroutines`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected /metrics output to contain %q", want)
+		}
+	}
+}
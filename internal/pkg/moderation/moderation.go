@@ -0,0 +1,181 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Action - 단계별 검사 결과 (Allow < Mask < Reject 순으로 강함)
+type Action int
+
+const (
+	ActionAllow  Action = iota // 그대로 전송
+	ActionMask                 // 문제 부분을 가리고 전송
+	ActionReject               // 전송 거부
+)
+
+// String - 설정 파일과 같은 형식으로 출력
+func (a Action) String() string {
+	switch a {
+	case ActionMask:
+		return "mask"
+	case ActionReject:
+		return "reject"
+	default:
+		return "allow"
+	}
+}
+
+// ParseAction - "allow", "mask", "reject" 파싱
+func ParseAction(value string) (Action, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "allow":
+		return ActionAllow, nil
+	case "mask":
+		return ActionMask, nil
+	case "reject":
+		return ActionReject, nil
+	default:
+		return ActionAllow, fmt.Errorf("unknown action %q (use allow, mask or reject)", value)
+	}
+}
+
+// 기본 단계 이름 (Result.Stage, Result.Flags에 기록)
+const (
+	StageMaxLength = "max_length"
+	StageURL       = "url"
+	StageContact   = "contact"
+	StageProfanity = "profanity"
+)
+
+// Input - 검사할 메시지
+type Input struct {
+	Content    string
+	PublicRoom bool // 전체 채팅방 메시지 여부 (1:1 채팅방이면 false)
+}
+
+// Verdict - 한 단계의 검사 결과
+type Verdict struct {
+	Action  Action
+	Content string // Mask일 때 가린 내용
+	Reason  string // 로그용 사유 (메시지 원문은 담지 않음)
+}
+
+// Stage - 파이프라인의 검사 단계 (새 검사는 이 인터페이스로 추가)
+type Stage interface {
+	Name() string
+	Check(in Input) Verdict
+}
+
+// Result - 파이프라인 전체 결과
+type Result struct {
+	Action  Action   // 가장 강한 단계 결과
+	Content string   // 가린 부분이 반영된 최종 내용
+	Stage   string   // 거부한 단계 (Reject일 때)
+	Reason  string   // 거부 사유 (Reject일 때)
+	Flags   []string // Mask/Reject한 단계 이름 (실행 순서)
+}
+
+// Pipeline - 단계를 순서대로 실행 (Mask는 가린 내용으로 다음 단계 진행, Reject는 즉시 중단)
+type Pipeline struct {
+	stages []Stage
+}
+
+// NewPipeline - 주어진 단계로 Pipeline 생성
+func NewPipeline(stages ...Stage) *Pipeline {
+	return &Pipeline{stages: stages}
+}
+
+// New - 설정으로 기본 단계(길이 → URL → 연락처 → 욕설) Pipeline 생성
+func New(cfg Config) (*Pipeline, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	profanityAction, _ := ParseAction(cfg.ProfanityAction)
+	contactAction, _ := ParseAction(cfg.ContactAction)
+
+	return NewPipeline(
+		NewMaxLengthStage(cfg.MaxLength),
+		NewURLStage(cfg.URLAllowlist, cfg.URLDenylist),
+		NewContactStage(contactAction),
+		NewProfanityStage(append(DefaultProfanityWords(), cfg.ProfanityWords...), profanityAction),
+	), nil
+}
+
+// MaxLength - 최대 글자 수 단계의 한도 (단계가 없으면 0)
+func (p *Pipeline) MaxLength() int {
+	for _, stage := range p.stages {
+		if maxLength, ok := stage.(*MaxLengthStage); ok {
+			return maxLength.Max()
+		}
+	}
+	return 0
+}
+
+// Run - 모든 단계 실행
+func (p *Pipeline) Run(in Input) Result {
+	result := Result{Action: ActionAllow, Content: in.Content}
+
+	for _, stage := range p.stages {
+		verdict := stage.Check(Input{Content: result.Content, PublicRoom: in.PublicRoom})
+		switch verdict.Action {
+		case ActionReject:
+			result.Action = ActionReject
+			result.Stage = stage.Name()
+			result.Reason = verdict.Reason
+			result.Flags = append(result.Flags, stage.Name())
+			return result
+		case ActionMask:
+			result.Action = ActionMask
+			result.Content = verdict.Content
+			result.Flags = append(result.Flags, stage.Name())
+		}
+	}
+	return result
+}
+
+// Config - 메시지 검사 설정
+type Config struct {
+	MaxLength       int      `yaml:"max_length"`       // 최대 글자 수 (넘으면 거부)
+	ProfanityAction string   `yaml:"profanity_action"` // 욕설: allow | mask | reject
+	ProfanityWords  []string `yaml:"profanity_words"`  // 기본 목록(한국어/영어)에 추가할 단어
+	ContactAction   string   `yaml:"contact_action"`   // 전체 채팅방의 전화번호/이메일/메신저 ID: allow | mask | reject
+	URLAllowlist    []string `yaml:"url_allowlist"`    // 허용 도메인 (비우면 거부 목록 외 모두 허용, 목록 밖의 링크는 가림)
+	URLDenylist     []string `yaml:"url_denylist"`     // 거부 도메인 (하위 도메인 포함)
+}
+
+// DefaultConfig - 기본 설정 (1000자, 욕설과 전체 채팅방 연락처는 가림)
+func DefaultConfig() Config {
+	return Config{
+		MaxLength:       1000,
+		ProfanityAction: ActionMask.String(),
+		ContactAction:   ActionMask.String(),
+	}
+}
+
+// Validate - 설정 값 검증
+func (c Config) Validate() error {
+	var errs []error
+	if c.MaxLength <= 0 {
+		errs = append(errs, errors.New("max length must be positive"))
+	}
+	if _, err := ParseAction(c.ProfanityAction); err != nil {
+		errs = append(errs, fmt.Errorf("profanity action: %w", err))
+	}
+	if _, err := ParseAction(c.ContactAction); err != nil {
+		errs = append(errs, fmt.Errorf("contact action: %w", err))
+	}
+	for _, domain := range append(append([]string{}, c.URLAllowlist...), c.URLDenylist...) {
+		if normalizeDomain(domain) == "" || strings.ContainsAny(domain, "/ ") {
+			errs = append(errs, fmt.Errorf("invalid url domain %q", domain))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// maskRunes - 문자 수만큼 '*'로 가림
+func maskRunes(s string) string {
+	return strings.Repeat("*", len([]rune(s)))
+}
@@ -0,0 +1,112 @@
+package moderation
+
+import (
+	"strings"
+	"testing"
+)
+
+func newDefaultPipeline(t *testing.T, modify func(*Config)) *Pipeline {
+	t.Helper()
+	cfg := DefaultConfig()
+	if modify != nil {
+		modify(&cfg)
+	}
+	pipeline, err := New(cfg)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return pipeline
+}
+
+func TestPipelineStages(t *testing.T) {
+	pipeline := newDefaultPipeline(t, func(cfg *Config) {
+		cfg.MaxLength = 50
+		cfg.URLDenylist = []string{"scam.example"}
+	})
+
+	cases := []struct {
+		name       string
+		content    string
+		public     bool
+		wantAction Action
+		wantStage  string
+		want       string
+	}{
+		{"clean", "내일 시부야에서 만나요", true, ActionAllow, "", "내일 시부야에서 만나요"},
+		{"too long", strings.Repeat("가", 51), true, ActionReject, StageMaxLength, ""},
+		{"korean profanity with separators", "이 씨.발 날씨", false, ActionMask, "", "이 *** 날씨"},
+		{"english profanity", "what the Fuck", false, ActionMask, "", "what the ****"},
+		{"no scunthorpe", "first class assistant", false, ActionAllow, "", "first class assistant"},
+		{"phone in public room", "연락주세요 010-1234-5678", true, ActionMask, "", "연락주세요 *************"},
+		{"phone in private room", "연락주세요 010-1234-5678", false, ActionAllow, "", "연락주세요 010-1234-5678"},
+		{"email in public room", "mail me a@b.com", true, ActionMask, "", "mail me *******"},
+		{"messenger id", "카톡 아이디: traveler99", true, ActionMask, "", "******************"},
+		{"denied domain", "여기 https://login.scam.example/x", false, ActionReject, StageURL, ""},
+	}
+	for _, tc := range cases {
+		result := pipeline.Run(Input{Content: tc.content, PublicRoom: tc.public})
+		if result.Action != tc.wantAction || result.Stage != tc.wantStage {
+			t.Errorf("%s: got %s/%q, want %s/%q", tc.name, result.Action, result.Stage, tc.wantAction, tc.wantStage)
+			continue
+		}
+		if tc.wantAction != ActionReject && result.Content != tc.want {
+			t.Errorf("%s: content = %q, want %q", tc.name, result.Content, tc.want)
+		}
+	}
+}
+
+func TestPipelineCombinesMasks(t *testing.T) {
+	pipeline := newDefaultPipeline(t, func(cfg *Config) {
+		cfg.URLAllowlist = []string{"*.travel-chat.example"}
+	})
+
+	result := pipeline.Run(Input{
+		Content:    "병신 www.other.example 010-1111-2222 https://m.travel-chat.example/a",
+		PublicRoom: true,
+	})
+	if result.Action != ActionMask {
+		t.Fatalf("expected mask, got %s", result.Action)
+	}
+	want := "** ***************** ************* https://m.travel-chat.example/a"
+	if result.Content != want {
+		t.Errorf("content = %q, want %q", result.Content, want)
+	}
+	if got := strings.Join(result.Flags, ","); got != "url,contact,profanity" {
+		t.Errorf("flags = %q", got)
+	}
+}
+
+func TestRejectActions(t *testing.T) {
+	pipeline := newDefaultPipeline(t, func(cfg *Config) {
+		cfg.ProfanityAction = "reject"
+		cfg.ContactAction = "reject"
+		cfg.ProfanityWords = []string{"멍청이"}
+	})
+
+	if result := pipeline.Run(Input{Content: "멍 청 이야", PublicRoom: false}); result.Stage != StageProfanity {
+		t.Errorf("expected custom word to be rejected, got %+v", result)
+	}
+	if result := pipeline.Run(Input{Content: "+82 10-1234-5678", PublicRoom: true}); result.Stage != StageContact {
+		t.Errorf("expected contact to be rejected, got %+v", result)
+	}
+	if pipeline.MaxLength() != DefaultConfig().MaxLength {
+		t.Errorf("MaxLength = %d", pipeline.MaxLength())
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.MaxLength = 0
+	cfg.ProfanityAction = "delete"
+	cfg.URLDenylist = []string{"https://bad.example/"}
+
+	err := cfg.Validate()
+	if err == nil {
+		t.Fatal("expected invalid config")
+	}
+	for _, want := range []string{"max length", "profanity action", "invalid url domain"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package moderation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxLengthStage - 최대 글자 수를 넘으면 거부
+type MaxLengthStage struct {
+	max int
+}
+
+// NewMaxLengthStage - MaxLengthStage 생성자
+func NewMaxLengthStage(max int) *MaxLengthStage {
+	return &MaxLengthStage{max: max}
+}
+
+func (s *MaxLengthStage) Name() string { return StageMaxLength }
+
+// Max - 최대 글자 수
+func (s *MaxLengthStage) Max() int { return s.max }
+
+func (s *MaxLengthStage) Check(in Input) Verdict {
+	if length := utf8.RuneCountInString(in.Content); length > s.max {
+		return Verdict{Action: ActionReject, Reason: fmt.Sprintf("%d characters (max %d)", length, s.max)}
+	}
+	return Verdict{Action: ActionAllow}
+}
+
+// urlPattern - http(s):// 또는 www.으로 시작하는 링크
+var urlPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+
+// URLStage - 거부 도메인 링크는 거부, 허용 목록이 있으면 목록 밖의 링크는 가림
+type URLStage struct {
+	allow []string
+	deny  []string
+}
+
+// NewURLStage - URLStage 생성자 (도메인은 하위 도메인까지 포함)
+func NewURLStage(allow, deny []string) *URLStage {
+	return &URLStage{allow: normalizeDomains(allow), deny: normalizeDomains(deny)}
+}
+
+func (s *URLStage) Name() string { return StageURL }
+
+func (s *URLStage) Check(in Input) Verdict {
+	if len(s.allow) == 0 && len(s.deny) == 0 {
+		return Verdict{Action: ActionAllow}
+	}
+
+	masked := false
+	for _, link := range urlPattern.FindAllString(in.Content, -1) {
+		host := linkHost(link)
+		if matchesDomain(host, s.deny) {
+			return Verdict{Action: ActionReject, Reason: "denied domain " + host}
+		}
+		if len(s.allow) > 0 && !matchesDomain(host, s.allow) {
+			masked = true
+		}
+	}
+	if !masked {
+		return Verdict{Action: ActionAllow}
+	}
+
+	content := urlPattern.ReplaceAllStringFunc(in.Content, func(link string) string {
+		if matchesDomain(linkHost(link), s.allow) {
+			return link
+		}
+		return maskRunes(link)
+	})
+	return Verdict{Action: ActionMask, Content: content, Reason: "link outside allowlist"}
+}
+
+// linkHost - 링크의 호스트 (소문자, 포트 제외)
+func linkHost(link string) string {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(parsed.Hostname())
+}
+
+// matchesDomain - host가 도메인 목록의 도메인이거나 그 하위 도메인인지 확인
+func matchesDomain(host string, domains []string) bool {
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeDomains(domains []string) []string {
+	normalized := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		if domain = normalizeDomain(domain); domain != "" {
+			normalized = append(normalized, domain)
+		}
+	}
+	return normalized
+}
+
+// normalizeDomain - "*.Example.com." 같은 값을 "example.com"으로 정리
+func normalizeDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "*.")
+	return strings.Trim(domain, ".")
+}
+
+// 연락처 패턴 (전화번호, 이메일, 메신저 ID)
+var contactPatterns = []*regexp.Regexp{
+	// 휴대전화 (010-1234-5678, 010 1234 5678, 01012345678)
+	regexp.MustCompile(`\b01[016789][\s.-]?\d{3,4}[\s.-]?\d{4}\b`),
+	// 국제/지역 번호 (+82 10-1234-5678, 02-123-4567)
+	regexp.MustCompile(`\+\d{1,3}[\s.-]?\d{1,4}[\s.-]?\d{3,4}[\s.-]?\d{4}\b`),
+	regexp.MustCompile(`\b0\d{1,2}-\d{3,4}-\d{4}\b`),
+	// 이메일
+	regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b`),
+	// 메신저 ID (카톡 아이디: abc, kakao id abc, 라인 ID: abc)
+	regexp.MustCompile(`(?i)(?:카톡|카카오톡|kakao(?:talk)?|라인|line|텔레그램|telegram|위챗|wechat)\s*(?:id|아이디)\s*[:：]?\s*[a-z0-9._-]+`),
+}
+
+// ContactStage - 전체 채팅방에 올린 전화번호/이메일/메신저 ID 검사 (1:1 채팅방은 허용)
+type ContactStage struct {
+	action Action
+}
+
+// NewContactStage - ContactStage 생성자 (action: 발견했을 때 결과)
+func NewContactStage(action Action) *ContactStage {
+	return &ContactStage{action: action}
+}
+
+func (s *ContactStage) Name() string { return StageContact }
+
+func (s *ContactStage) Check(in Input) Verdict {
+	if !in.PublicRoom || s.action == ActionAllow {
+		return Verdict{Action: ActionAllow}
+	}
+
+	content := in.Content
+	for _, pattern := range contactPatterns {
+		content = pattern.ReplaceAllStringFunc(content, maskRunes)
+	}
+	if content == in.Content {
+		return Verdict{Action: ActionAllow}
+	}
+	if s.action == ActionReject {
+		return Verdict{Action: ActionReject, Reason: "contact info in public room"}
+	}
+	return Verdict{Action: ActionMask, Content: content, Reason: "contact info in public room"}
+}
+
+// DefaultProfanityWords - 기본 욕설 목록 (한국어/영어)
+func DefaultProfanityWords() []string {
+	return []string{
+		// 한국어 (다른 단어 안에 있어도 검사)
+		"씨발", "씨바", "씨빨", "시발놈", "ㅅㅂ", "ㅆㅂ", "병신", "ㅂㅅ", "ㅄ",
+		"개새끼", "개새기", "개색기", "개색히", "좆", "존나", "졸라", "지랄", "미친놈", "미친년", "썅",
+		// 영어 (단어 단위로 검사)
+		"fuck", "fucking", "fucker", "motherfucker", "shit", "bullshit", "bitch",
+		"asshole", "bastard", "cunt", "dick", "pussy", "slut", "whore",
+	}
+}
+
+// profanitySeparators - 단어 사이에 끼워 필터를 피하는 문자 (공백, 기호)
+const profanitySeparators = `[\s.\-_*~!@#]*`
+
+// ProfanityStage - 욕설 단어 검사 (한국어는 글자 사이의 공백/기호도 무시)
+type ProfanityStage struct {
+	pattern *regexp.Regexp
+	action  Action
+}
+
+// NewProfanityStage - ProfanityStage 생성자 (action: 발견했을 때 결과)
+func NewProfanityStage(words []string, action Action) *ProfanityStage {
+	alternatives := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			alternatives = append(alternatives, wordPattern(word))
+		}
+	}
+
+	stage := &ProfanityStage{action: action}
+	if len(alternatives) > 0 {
+		stage.pattern = regexp.MustCompile(`(?i)(?:` + strings.Join(alternatives, "|") + `)`)
+	}
+	return stage
+}
+
+func (s *ProfanityStage) Name() string { return StageProfanity }
+
+func (s *ProfanityStage) Check(in Input) Verdict {
+	if s.pattern == nil || s.action == ActionAllow || !s.pattern.MatchString(in.Content) {
+		return Verdict{Action: ActionAllow}
+	}
+	if s.action == ActionReject {
+		return Verdict{Action: ActionReject, Reason: "profanity"}
+	}
+	return Verdict{Action: ActionMask, Content: s.pattern.ReplaceAllStringFunc(in.Content, maskRunes), Reason: "profanity"}
+}
+
+// wordPattern - 단어 하나의 정규식 (ASCII 단어는 단어 경계, 그 외는 글자 사이 구분자 허용)
+func wordPattern(word string) string {
+	if isASCII(word) {
+		return `\b` + regexp.QuoteMeta(word) + `\b`
+	}
+
+	runes := []rune(word)
+	parts := make([]string, len(runes))
+	for i, r := range runes {
+		parts[i] = regexp.QuoteMeta(string(r))
+	}
+	return strings.Join(parts, profanitySeparators)
+}
+
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,214 @@
+package presence
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status - 접속 상태
+type Status string
+
+const (
+	StatusOnline  Status = "online"  // 최근 활동 중
+	StatusAway    Status = "away"    // 접속 중이지만 한동안 활동 없음
+	StatusOffline Status = "offline" // 접속 종료
+)
+
+// Config - 상태 전환 기준
+type Config struct {
+	AwayAfter    time.Duration // 마지막 활동 후 자리 비움으로 바뀌는 시간
+	OfflineAfter time.Duration // 연결 없이 마지막 활동 후 오프라인으로 바뀌는 시간
+}
+
+// DefaultConfig - 기본 설정 (5분 후 자리 비움, 연결이 없으면 10분 후 오프라인)
+func DefaultConfig() Config {
+	return Config{
+		AwayAfter:    5 * time.Minute,
+		OfflineAfter: 10 * time.Minute,
+	}
+}
+
+// Change - 상태 변경 알림
+type Change struct {
+	UserID uint
+	Status Status
+}
+
+// entry - 사용자 한 명의 접속 정보
+type entry struct {
+	connections  int       // 실시간 연결 수 (WebSocket, gRPC 스트림)
+	lastSeen     time.Time // 마지막 활동 시간 (연결, heartbeat, 메시지 전송)
+	disconnected bool      // 마지막 연결이 종료된 이후 활동 없음
+	status       Status
+	dirty        bool // DB에 반영되지 않은 활동 있음
+}
+
+// Registry - 사용자별 접속 상태를 메모리에서 관리 (동시 접근 안전)
+type Registry struct {
+	mu      sync.Mutex
+	config  Config
+	entries map[uint]*entry
+	now     func() time.Time
+}
+
+// NewRegistry - Registry 생성자
+func NewRegistry(config Config) *Registry {
+	defaults := DefaultConfig()
+	if config.AwayAfter <= 0 {
+		config.AwayAfter = defaults.AwayAfter
+	}
+	if config.OfflineAfter <= 0 {
+		config.OfflineAfter = defaults.OfflineAfter
+	}
+
+	return &Registry{
+		config:  config,
+		entries: make(map[uint]*entry),
+		now:     time.Now,
+	}
+}
+
+// Connect - 실시간 연결 시작
+func (r *Registry) Connect(userID uint) (Change, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e := r.touch(userID)
+	e.connections++
+	return r.update(userID, e)
+}
+
+// Disconnect - 실시간 연결 종료 (마지막 연결이면 오프라인)
+func (r *Registry) Disconnect(userID uint) (Change, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.entries[userID]
+	if !ok || e.connections == 0 {
+		return Change{}, false
+	}
+
+	e.connections--
+	if e.connections == 0 {
+		e.disconnected = true
+	}
+	return r.update(userID, e)
+}
+
+// Heartbeat - 사용자 활동 기록 (heartbeat, 메시지 전송 등)
+func (r *Registry) Heartbeat(userID uint) (Change, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e := r.touch(userID)
+	return r.update(userID, e)
+}
+
+// Status - 현재 접속 상태
+func (r *Registry) Status(userID uint) Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	e, ok := r.entries[userID]
+	if !ok {
+		return StatusOffline
+	}
+	return r.statusOf(e)
+}
+
+// ActiveUserIDs - 온라인 또는 자리 비움 상태인 사용자 ID 목록 (오름차순)
+func (r *Registry) ActiveUserIDs() []uint {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	ids := make([]uint, 0, len(r.entries))
+	for userID, e := range r.entries {
+		if r.statusOf(e) != StatusOffline {
+			ids = append(ids, userID)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Sweep - 시간 경과에 따른 상태 변경 계산 (DB 반영이 끝난 오프라인 사용자는 정리)
+func (r *Registry) Sweep() []Change {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var changes []Change
+	for userID, e := range r.entries {
+		if change, ok := r.update(userID, e); ok {
+			changes = append(changes, change)
+		}
+		if e.status == StatusOffline && e.connections == 0 && !e.dirty {
+			delete(r.entries, userID)
+		}
+	}
+	return changes
+}
+
+// DrainActivity - 마지막 호출 이후 활동한 사용자 ID 목록 (DB 반영용)
+func (r *Registry) DrainActivity() []uint {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []uint
+	for userID, e := range r.entries {
+		if e.dirty {
+			ids = append(ids, userID)
+			e.dirty = false
+		}
+	}
+	return ids
+}
+
+// touch - 활동 시간 갱신 (mu 보유 상태에서 호출)
+func (r *Registry) touch(userID uint) *entry {
+	e, ok := r.entries[userID]
+	if !ok {
+		e = &entry{status: StatusOffline}
+		r.entries[userID] = e
+	}
+	e.lastSeen = r.now()
+	e.disconnected = false
+	e.dirty = true
+	return e
+}
+
+// update - 상태를 다시 계산하고 바뀌었으면 변경 내용 반환 (mu 보유 상태에서 호출)
+func (r *Registry) update(userID uint, e *entry) (Change, bool) {
+	status := r.statusOf(e)
+	if status == e.status {
+		return Change{}, false
+	}
+	e.status = status
+	return Change{UserID: userID, Status: status}, true
+}
+
+// statusOf - 연결 수와 마지막 활동 시간으로 상태 계산
+func (r *Registry) statusOf(e *entry) Status {
+	idle := r.now().Sub(e.lastSeen)
+
+	if e.connections > 0 {
+		if idle < r.config.AwayAfter {
+			return StatusOnline
+		}
+		return StatusAway
+	}
+
+	if e.disconnected {
+		return StatusOffline
+	}
+
+	// 연결 없이 heartbeat만 보내는 클라이언트 (REST)
+	switch {
+	case idle < r.config.AwayAfter:
+		return StatusOnline
+	case idle < r.config.OfflineAfter:
+		return StatusAway
+	default:
+		return StatusOffline
+	}
+}
@@ -0,0 +1,153 @@
+package presence
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock - 테스트에서 시간을 직접 진행시키는 시계
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newTestRegistry() (*Registry, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	r := NewRegistry(Config{AwayAfter: 5 * time.Minute, OfflineAfter: 10 * time.Minute})
+	r.now = clock.Now
+	return r, clock
+}
+
+func expectChange(t *testing.T, change Change, ok bool, want Status) {
+	t.Helper()
+	if !ok || change.Status != want {
+		t.Fatalf("expected change to %s, got %+v (changed=%v)", want, change, ok)
+	}
+}
+
+func expectNoChange(t *testing.T, change Change, ok bool) {
+	t.Helper()
+	if ok {
+		t.Fatalf("expected no status change, got %+v", change)
+	}
+}
+
+func TestHeartbeatTransitions(t *testing.T) {
+	r, clock := newTestRegistry()
+
+	change, ok := r.Heartbeat(1)
+	expectChange(t, change, ok, StatusOnline)
+
+	clock.Advance(5*time.Minute - time.Second)
+	if changes := r.Sweep(); len(changes) != 0 {
+		t.Fatalf("expected user to stay online before AwayAfter, got %+v", changes)
+	}
+
+	clock.Advance(time.Second)
+	if changes := r.Sweep(); !slices.Equal(changes, []Change{{UserID: 1, Status: StatusAway}}) {
+		t.Fatalf("expected away after AwayAfter, got %+v", changes)
+	}
+	if ids := r.ActiveUserIDs(); !slices.Equal(ids, []uint{1}) {
+		t.Errorf("expected away user to be active, got %v", ids)
+	}
+
+	// 자리 비움 중 활동하면 바로 온라인
+	change, ok = r.Heartbeat(1)
+	expectChange(t, change, ok, StatusOnline)
+
+	clock.Advance(10 * time.Minute)
+	if changes := r.Sweep(); !slices.Equal(changes, []Change{{UserID: 1, Status: StatusOffline}}) {
+		t.Fatalf("expected offline after OfflineAfter, got %+v", changes)
+	}
+	if ids := r.ActiveUserIDs(); len(ids) != 0 {
+		t.Errorf("expected no active users, got %v", ids)
+	}
+	if status := r.Status(1); status != StatusOffline {
+		t.Errorf("expected offline status, got %s", status)
+	}
+}
+
+func TestMultipleConnections(t *testing.T) {
+	r, clock := newTestRegistry()
+
+	change, ok := r.Connect(1)
+	expectChange(t, change, ok, StatusOnline)
+	change, ok = r.Connect(1)
+	expectNoChange(t, change, ok)
+
+	// 연결이 남아 있으면 오래 활동이 없어도 오프라인이 아닌 자리 비움
+	clock.Advance(time.Hour)
+	if changes := r.Sweep(); !slices.Equal(changes, []Change{{UserID: 1, Status: StatusAway}}) {
+		t.Fatalf("expected connected user to become away, got %+v", changes)
+	}
+
+	change, ok = r.Disconnect(1)
+	expectNoChange(t, change, ok)
+	if status := r.Status(1); status != StatusAway {
+		t.Errorf("expected user to stay away with one connection left, got %s", status)
+	}
+
+	// 마지막 연결이 끊기면 기다리지 않고 오프라인
+	change, ok = r.Disconnect(1)
+	expectChange(t, change, ok, StatusOffline)
+	change, ok = r.Disconnect(1)
+	expectNoChange(t, change, ok)
+	if _, ok := r.Disconnect(2); ok {
+		t.Error("expected Disconnect of an unknown user to be ignored")
+	}
+
+	// 다시 연결하면 온라인
+	change, ok = r.Connect(1)
+	expectChange(t, change, ok, StatusOnline)
+}
+
+func TestDrainActivity(t *testing.T) {
+	r, clock := newTestRegistry()
+
+	r.Heartbeat(2)
+	r.Connect(1)
+	r.Heartbeat(1)
+	activity := r.DrainActivity()
+	slices.Sort(activity)
+	if !slices.Equal(activity, []uint{1, 2}) {
+		t.Fatalf("expected activity for users 1 and 2, got %v", activity)
+	}
+	if activity := r.DrainActivity(); len(activity) != 0 {
+		t.Fatalf("expected drained activity to be cleared, got %v", activity)
+	}
+
+	r.Heartbeat(2)
+	if activity := r.DrainActivity(); !slices.Equal(activity, []uint{2}) {
+		t.Fatalf("expected new activity for user 2, got %v", activity)
+	}
+
+	// 오프라인이 되어도 DB에 반영하지 않은 활동이 있으면 정리하지 않음
+	r.Disconnect(1)
+	r.Heartbeat(1)
+	clock.Advance(time.Hour)
+	r.Sweep()
+	if _, ok := r.entries[1]; !ok {
+		t.Fatal("expected user with undrained activity to be kept")
+	}
+	if _, ok := r.entries[2]; ok {
+		t.Error("expected drained offline user to be removed")
+	}
+
+	if activity := r.DrainActivity(); !slices.Equal(activity, []uint{1}) {
+		t.Fatalf("expected pending activity for user 1, got %v", activity)
+	}
+	r.Sweep()
+	if len(r.entries) != 0 {
+		t.Errorf("expected all offline users to be removed, got %d entries", len(r.entries))
+	}
+}
+
+func TestNewRegistryDefaults(t *testing.T) {
+	r := NewRegistry(Config{})
+	if r.config != DefaultConfig() {
+		t.Errorf("expected default config, got %+v", r.config)
+	}
+}
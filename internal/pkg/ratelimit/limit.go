@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit - 토큰 버킷 한도 (Per 동안 Requests개 충전, 최대 Burst개까지 연속 허용)
+//
+// 문자열 형식: "요청수/기간[/버스트]" (예: "5/1m", "30/1m/10"), "off"는 제한 없음
+type Limit struct {
+	Requests int
+	Per      time.Duration
+	Burst    int
+}
+
+// Enabled - 제한이 설정되어 있는지 여부
+func (l Limit) Enabled() bool {
+	return l.Requests > 0 && l.Per > 0
+}
+
+// Capacity - 버킷 최대 토큰 수 (Burst 미지정 시 Requests)
+func (l Limit) Capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Requests)
+}
+
+// RatePerSecond - 초당 충전되는 토큰 수
+func (l Limit) RatePerSecond() float64 {
+	return float64(l.Requests) / l.Per.Seconds()
+}
+
+// String - 설정 파일과 같은 형식으로 출력
+func (l Limit) String() string {
+	if !l.Enabled() {
+		return "off"
+	}
+	if l.Burst > 0 {
+		return fmt.Sprintf("%d/%s/%d", l.Requests, l.Per, l.Burst)
+	}
+	return fmt.Sprintf("%d/%s", l.Requests, l.Per)
+}
+
+// ParseLimit - "5/1m", "30/1m/10", "off" 형식 파싱
+func ParseLimit(value string) (Limit, error) {
+	value = strings.TrimSpace(value)
+	if value == "off" || value == "0" {
+		return Limit{}, nil
+	}
+
+	parts := strings.Split(value, "/")
+	if len(parts) != 2 && len(parts) != 3 {
+		return Limit{}, errors.New(`limit must look like "5/1m" or "30/1m/10"`)
+	}
+
+	requests, err := strconv.Atoi(parts[0])
+	if err != nil || requests <= 0 {
+		return Limit{}, fmt.Errorf("invalid request count %q", parts[0])
+	}
+	per, err := time.ParseDuration(parts[1])
+	if err != nil || per <= 0 {
+		return Limit{}, fmt.Errorf("invalid period %q", parts[1])
+	}
+
+	limit := Limit{Requests: requests, Per: per}
+	if len(parts) == 3 {
+		burst, err := strconv.Atoi(parts[2])
+		if err != nil || burst <= 0 {
+			return Limit{}, fmt.Errorf("invalid burst %q", parts[2])
+		}
+		limit.Burst = burst
+	}
+	return limit, nil
+}
+
+// UnmarshalText - YAML/환경변수에서 문자열 형식으로 읽기
+func (l *Limit) UnmarshalText(text []byte) error {
+	parsed, err := ParseLimit(string(text))
+	if err != nil {
+		return err
+	}
+	*l = parsed
+	return nil
+}
+
+// MarshalText - 문자열 형식으로 쓰기
+func (l Limit) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
@@ -0,0 +1,138 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+)
+
+// Policy - 한도를 따로 관리하는 요청 종류
+type Policy string
+
+const (
+	PolicyLogin    Policy = "login"    // 로그인 시도 (IP 기준, 무차별 대입 방지)
+	PolicyRegister Policy = "register" // 회원가입 (IP 기준)
+	PolicyAPI      Policy = "api"      // 그 밖의 API 요청 (경로 + IP 기준)
+	PolicyMessage  Policy = "message"  // 메시지 전송 (사용자 기준, REST/WebSocket/gRPC 스트림 공통)
+	PolicyUpload   Policy = "upload"   // 이미지/프로필 사진 업로드 (사용자 기준, REST/gRPC 공통)
+)
+
+// Config - 요청 수 제한 설정
+type Config struct {
+	Enabled  bool  `yaml:"enabled"`
+	Login    Limit `yaml:"login"`
+	Register Limit `yaml:"register"`
+	API      Limit `yaml:"api"`
+	Message  Limit `yaml:"message"`
+	Upload   Limit `yaml:"upload"`
+}
+
+// DefaultConfig - 기본 한도
+func DefaultConfig() Config {
+	return Config{
+		Enabled:  true,
+		Login:    Limit{Requests: 10, Per: time.Minute},
+		Register: Limit{Requests: 10, Per: time.Hour},
+		API:      Limit{Requests: 120, Per: time.Minute},
+		Message:  Limit{Requests: 30, Per: time.Minute, Burst: 10},
+		Upload:   Limit{Requests: 60, Per: time.Hour, Burst: 10},
+	}
+}
+
+// Limit - 정책별 한도
+func (c Config) Limit(policy Policy) Limit {
+	switch policy {
+	case PolicyLogin:
+		return c.Login
+	case PolicyRegister:
+		return c.Register
+	case PolicyAPI:
+		return c.API
+	case PolicyMessage:
+		return c.Message
+	case PolicyUpload:
+		return c.Upload
+	default:
+		return Limit{}
+	}
+}
+
+// Validate - 한도 값 검증 (제한 없음은 "off")
+func (c Config) Validate() error {
+	for _, policy := range []Policy{PolicyLogin, PolicyRegister, PolicyAPI, PolicyMessage, PolicyUpload} {
+		limit := c.Limit(policy)
+		if limit.Requests < 0 || limit.Per < 0 || limit.Burst < 0 {
+			return fmt.Errorf("%s limit must not be negative", policy)
+		}
+	}
+	return nil
+}
+
+// Limiter - 정책별 토큰 버킷으로 요청 허용 여부 판단
+type Limiter struct {
+	store  Store
+	config Config
+	now    func() time.Time
+}
+
+// NewLimiter - Limiter 생성자 (store: 서버 한 대면 NewMemoryStore, 여러 대면 공유 저장소)
+func NewLimiter(store Store, config Config) *Limiter {
+	return &Limiter{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Allow - 토큰 하나 사용 (저장소 오류 시에는 서비스가 멈추지 않도록 허용)
+func (l *Limiter) Allow(ctx context.Context, policy Policy, key string) Result {
+	if l == nil || !l.config.Enabled {
+		return Result{Allowed: true}
+	}
+	limit := l.config.Limit(policy)
+	if !limit.Enabled() {
+		return Result{Allowed: true}
+	}
+
+	result, err := l.store.Take(ctx, string(policy)+":"+key, limit, l.now())
+	if err != nil {
+		logger.FromContext(ctx).Error("rate limit store failed, allowing request", "policy", policy, "error", err)
+		return Result{Allowed: true}
+	}
+
+	if !result.Allowed {
+		metrics.RateLimited(string(policy))
+		logger.FromContext(ctx).Info("rate limit exceeded", "policy", policy, "key", key, "retry_after", result.RetryAfter)
+	}
+	return result
+}
+
+// 버킷 키 헬퍼
+
+// IPKey - 클라이언트 IP 기준 키
+func IPKey(ip string) string {
+	return "ip:" + ip
+}
+
+// UserKey - 사용자 ID 기준 키
+func UserKey(userID uint) string {
+	return "user:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+// RouteKey - 경로별로 버킷을 나누는 키 (예: RouteKey("GET /api/users", IPKey(ip)))
+func RouteKey(route, key string) string {
+	return "route:" + route + "|" + key
+}
+
+// RetryAfterSeconds - Retry-After 헤더 값 (올림, 최소 1초)
+func RetryAfterSeconds(retryAfter time.Duration) int {
+	seconds := int((retryAfter + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		return 1
+	}
+	return seconds
+}
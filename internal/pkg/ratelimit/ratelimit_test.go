@@ -0,0 +1,127 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	cases := map[string]Limit{
+		"5/1m":     {Requests: 5, Per: time.Minute},
+		"30/1m/10": {Requests: 30, Per: time.Minute, Burst: 10},
+		" off ":    {},
+		"0":        {},
+	}
+	for input, want := range cases {
+		got, err := ParseLimit(input)
+		if err != nil || got != want {
+			t.Errorf("ParseLimit(%q) = %+v, %v; want %+v", input, got, err, want)
+		}
+	}
+
+	for _, input := range []string{"", "5", "5/soon", "-1/1m", "5/1m/0", "5/1m/2/3"} {
+		if _, err := ParseLimit(input); err == nil {
+			t.Errorf("expected ParseLimit(%q) to fail", input)
+		}
+	}
+}
+
+func TestMemoryStoreBurstAndRefill(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Requests: 60, Per: time.Minute, Burst: 2} // 1초에 하나씩 충전
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if result, _ := store.Take(ctx, "k", limit, now); !result.Allowed {
+			t.Fatalf("request %d within burst should be allowed", i+1)
+		}
+	}
+
+	result, _ := store.Take(ctx, "k", limit, now)
+	if result.Allowed || result.RetryAfter != time.Second {
+		t.Fatalf("expected denial with 1s retry, got %+v", result)
+	}
+	if other, _ := store.Take(ctx, "other", limit, now); !other.Allowed {
+		t.Error("buckets should be independent per key")
+	}
+
+	result, _ = store.Take(ctx, "k", limit, now.Add(500*time.Millisecond))
+	if result.Allowed || result.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("expected half refilled bucket to wait 500ms, got %+v", result)
+	}
+	if result, _ = store.Take(ctx, "k", limit, now.Add(time.Second)); !result.Allowed {
+		t.Fatal("expected refilled token to be allowed")
+	}
+}
+
+func TestMemoryStoreSweepsFullBuckets(t *testing.T) {
+	store := NewMemoryStore()
+	limit := Limit{Requests: 10, Per: time.Minute}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	store.Take(ctx, "idle", limit, now)
+	store.Take(ctx, "busy", limit, now)
+	// busy 버킷은 계속 사용되어 가득 차지 않음
+	for i := 0; i < 9; i++ {
+		store.Take(ctx, "busy", limit, now.Add(110*time.Second))
+	}
+
+	store.Take(ctx, "new", limit, now.Add(2*time.Minute))
+	if got := store.Len(); got != 2 {
+		t.Errorf("expected idle bucket to be swept, %d buckets left", got)
+	}
+}
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, Limit, time.Time) (Result, error) {
+	return Result{}, errors.New("store unavailable")
+}
+
+func TestLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+	config := DefaultConfig()
+	config.Login = Limit{Requests: 1, Per: time.Hour}
+	config.API = Limit{}
+
+	limiter := NewLimiter(NewMemoryStore(), config)
+	if !limiter.Allow(ctx, PolicyLogin, IPKey("1.2.3.4")).Allowed {
+		t.Fatal("first login should be allowed")
+	}
+	result := limiter.Allow(ctx, PolicyLogin, IPKey("1.2.3.4"))
+	if result.Allowed || RetryAfterSeconds(result.RetryAfter) != 3600 {
+		t.Fatalf("expected second login to be denied for an hour, got %+v", result)
+	}
+	if !limiter.Allow(ctx, PolicyRegister, IPKey("1.2.3.4")).Allowed {
+		t.Error("policies should not share buckets")
+	}
+
+	// 업로드는 같은 사용자의 메시지 전송 한도를 쓰지 않음
+	for i := 0; i < int(config.Upload.Capacity())+1; i++ {
+		limiter.Allow(ctx, PolicyUpload, UserKey(1))
+	}
+	if !limiter.Allow(ctx, PolicyMessage, UserKey(1)).Allowed {
+		t.Error("uploads should not spend the message budget")
+	}
+	for i := 0; i < 1000; i++ {
+		if !limiter.Allow(ctx, PolicyAPI, IPKey("1.2.3.4")).Allowed {
+			t.Fatal("a policy set to off should never deny")
+		}
+	}
+
+	config.Enabled = false
+	if !NewLimiter(NewMemoryStore(), config).Allow(ctx, PolicyLogin, "k").Allowed {
+		t.Error("disabled limiter should allow")
+	}
+	var nilLimiter *Limiter
+	if !nilLimiter.Allow(ctx, PolicyLogin, "k").Allowed {
+		t.Error("nil limiter should allow")
+	}
+	if !NewLimiter(failingStore{}, DefaultConfig()).Allow(ctx, PolicyLogin, "k").Allowed {
+		t.Error("store errors should fail open")
+	}
+}
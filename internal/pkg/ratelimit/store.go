@@ -0,0 +1,100 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Result - 토큰 하나를 꺼낸 결과
+type Result struct {
+	Allowed    bool
+	Remaining  int           // 남은 토큰 수
+	RetryAfter time.Duration // 거부된 경우 다음 토큰이 충전될 때까지 남은 시간
+}
+
+// Store - 버킷 상태 저장소
+//
+// 여러 서버가 한도를 공유하려면 Redis 등 공유 저장소로 구현한다.
+// Take는 키 하나에 대해 원자적으로 충전/차감해야 한다.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Result, error)
+}
+
+// 가득 찬(오래 쓰지 않은) 버킷을 정리하는 주기
+const memorySweepInterval = time.Minute
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	fullAt  time.Time // 이 시각 이후에는 버킷이 가득 차 있으므로 지워도 같음
+}
+
+// MemoryStore - 프로세스 내 버킷 저장소 (서버 한 대용)
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewMemoryStore - MemoryStore 생성자
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Take - 버킷을 충전한 뒤 토큰 하나 차감
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit, now time.Time) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	capacity := limit.Capacity()
+	rate := limit.RatePerSecond()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: capacity, updated: now}
+		s.buckets[key] = b
+	}
+
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+elapsed.Seconds()*rate)
+		b.updated = now
+	}
+
+	result := Result{Allowed: b.tokens >= 1}
+	if result.Allowed {
+		b.tokens--
+	} else {
+		result.RetryAfter = time.Duration((1 - b.tokens) / rate * float64(time.Second))
+	}
+	result.Remaining = int(b.tokens)
+	b.fullAt = now.Add(time.Duration((capacity - b.tokens) / rate * float64(time.Second)))
+
+	return result, nil
+}
+
+// Len - 저장된 버킷 수
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.buckets)
+}
+
+// sweep - 가득 찬 버킷 삭제 (주기마다 한 번, 호출자가 잠금 보유)
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memorySweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+}
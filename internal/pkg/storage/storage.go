@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+)
+
+// 저장 방식
+const (
+	DriverLocal = "local" // 로컬 디렉터리 (서버 한 대 또는 공유 볼륨)
+	DriverS3    = "s3"    // S3 호환 오브젝트 스토리지 (AWS S3, MinIO, R2 등)
+)
+
+// ErrNotFound - 키에 해당하는 객체가 없음
+var ErrNotFound = errors.New("storage: object not found")
+
+// Store - 키 단위 객체 저장소 (S3와 같은 Put/Get/Delete 모델, 키는 "a/b/c" 형식)
+type Store interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error) // 없으면 ErrNotFound
+	Delete(ctx context.Context, key string) error               // 없어도 오류 아님
+}
+
+// Config - 저장소 설정
+type Config struct {
+	Driver   string   `yaml:"driver"`    // local | s3
+	LocalDir string   `yaml:"local_dir"` // driver가 local일 때 저장 디렉터리
+	S3       S3Config `yaml:"s3"`
+}
+
+// DefaultConfig - 기본 설정 (./data/blobs 디렉터리)
+func DefaultConfig() Config {
+	return Config{
+		Driver:   DriverLocal,
+		LocalDir: "data/blobs",
+		S3: S3Config{
+			Region: "us-east-1",
+		},
+	}
+}
+
+// Validate - 저장 방식별 필수 값 검증
+func (c Config) Validate() error {
+	switch c.Driver {
+	case DriverLocal:
+		if c.LocalDir == "" {
+			return errors.New("local dir is required for the local driver")
+		}
+		return nil
+	case DriverS3:
+		return c.S3.Validate()
+	default:
+		return fmt.Errorf("unknown driver %q (use %s or %s)", c.Driver, DriverLocal, DriverS3)
+	}
+}
+
+// New - 설정에 맞는 Store 생성
+func New(cfg Config) (Store, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Driver == DriverS3 {
+		return NewS3Store(cfg.S3, nil), nil
+	}
+	return NewLocalStore(cfg.LocalDir)
+}
+
+// validateKey - 빈 세그먼트, ".", ".."가 없는 상대 경로 키인지 확인
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("storage: invalid key %q", key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("storage: invalid key %q", key)
+		}
+	}
+	return nil
+}
+
+// S3Config - S3 호환 저장소 설정 (경로 방식 URL: {endpoint}/{bucket}/{key})
+type S3Config struct {
+	Endpoint  string `yaml:"endpoint"` // 예: https://s3.ap-northeast-2.amazonaws.com, http://localhost:9000
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+}
+
+// Validate - S3 접속 정보 검증
+func (c S3Config) Validate() error {
+	var errs []error
+	if parsed, err := url.Parse(c.Endpoint); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		errs = append(errs, fmt.Errorf("s3 endpoint must be an absolute http(s) URL, got %q", c.Endpoint))
+	}
+	if c.Region == "" {
+		errs = append(errs, errors.New("s3 region is required"))
+	}
+	if c.Bucket == "" {
+		errs = append(errs, errors.New("s3 bucket is required"))
+	}
+	if c.AccessKey == "" || c.SecretKey == "" {
+		errs = append(errs, errors.New("s3 access key and secret key are required"))
+	}
+	return errors.Join(errs...)
+}
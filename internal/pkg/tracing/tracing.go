@@ -0,0 +1,140 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// 스팬 내보내기 방식
+const (
+	ExporterNone   = "none"   // 추적 비활성화 (traceparent 전파는 유지)
+	ExporterStdout = "stdout" // 표준 출력에 스팬을 한 줄씩 JSON으로 기록
+	ExporterFile   = "file"   // 파일에 스팬을 한 줄씩 JSON으로 기록 (오프라인 분석용)
+)
+
+// DefaultServiceName - 스팬에 기록되는 기본 서비스 이름
+const DefaultServiceName = "travel-chat"
+
+const instrumentationName = "github.com/chris910512/travel-chat"
+
+var serviceName = DefaultServiceName
+
+// Config - 분산 추적 설정
+type Config struct {
+	Exporter    string  `yaml:"exporter"`     // none | stdout | file
+	FilePath    string  `yaml:"file_path"`    // exporter가 file일 때 출력 파일
+	ServiceName string  `yaml:"service_name"` // 스팬의 service.name
+	SampleRatio float64 `yaml:"sample_ratio"` // 0~1, 상위 스팬이 있으면 그 결정을 따름
+}
+
+// DefaultConfig - 기본 설정 (추적 꺼짐, 켜면 모든 요청 기록)
+func DefaultConfig() Config {
+	return Config{
+		Exporter:    ExporterNone,
+		FilePath:    "traces.jsonl",
+		ServiceName: DefaultServiceName,
+		SampleRatio: 1,
+	}
+}
+
+// Validate - 내보내기 방식과 샘플링 비율 검증
+func (c Config) Validate() error {
+	switch c.Exporter {
+	case ExporterNone, ExporterStdout:
+	case ExporterFile:
+		if c.FilePath == "" {
+			return errors.New("file path is required for the file exporter")
+		}
+	default:
+		return fmt.Errorf("unknown exporter %q (use %s, %s or %s)", c.Exporter, ExporterNone, ExporterStdout, ExporterFile)
+	}
+	if c.ServiceName == "" {
+		return errors.New("service name must not be empty")
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("sample ratio must be between 0 and 1, got %v", c.SampleRatio)
+	}
+	return nil
+}
+
+// Setup - 전역 TracerProvider와 W3C traceparent 전파 설정
+//
+// 반환된 shutdown은 서버 종료 시 호출해서 남은 스팬을 내보낸다.
+func Setup(cfg Config) (shutdown func(context.Context) error, err error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 추적을 끄더라도 들어온 traceparent는 gRPC 호출까지 그대로 전달
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	serviceName = cfg.ServiceName
+
+	if cfg.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var (
+		writer io.Writer = os.Stdout
+		file   *os.File
+	)
+	if cfg.Exporter == ExporterFile {
+		file, err = os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		writer = file
+	}
+
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(writer))
+	if err != nil {
+		if file != nil {
+			file.Close()
+		}
+		return nil, fmt.Errorf("failed to create trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if file != nil {
+			err = errors.Join(err, file.Close())
+		}
+		return err
+	}, nil
+}
+
+// ServiceName - 설정된 서비스 이름 (HTTP/gRPC 계측에 사용)
+func ServiceName() string {
+	return serviceName
+}
+
+// Start - 현재 컨텍스트 아래에 내부 스팬 시작 (usecase 등 계층 구분용)
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// tracer - 현재 전역 TracerProvider의 Tracer (Setup 이후 교체된 Provider 반영)
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type tracingTestRow struct {
+	ID   uint
+	Name string
+}
+
+// useRecorder - 테스트 동안 전역 TracerProvider를 스팬 기록용으로 교체
+func useRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+	return recorder
+}
+
+func TestGormPluginCreatesChildSpans(t *testing.T) {
+	recorder := useRecorder(t)
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("open sqlite: %v", err)
+	}
+	if err := db.Use(GormPlugin{}); err != nil {
+		t.Fatalf("Use: %v", err)
+	}
+	if err := db.AutoMigrate(&tracingTestRow{}); err != nil {
+		t.Fatalf("AutoMigrate: %v", err)
+	}
+
+	// 요청 스팬 없이 실행된 쿼리는 기록하지 않음
+	if err := db.Create(&tracingTestRow{Name: "untraced"}).Error; err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got := len(recorder.Ended()); got != 0 {
+		t.Fatalf("expected no spans without a parent, got %d", got)
+	}
+
+	ctx, parent := Start(context.Background(), "UserUsecase.Test")
+	if err := db.WithContext(ctx).Create(&tracingTestRow{Name: "traced"}).Error; err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	var row tracingTestRow
+	db.WithContext(ctx).First(&row, 9999)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 3 {
+		t.Fatalf("expected create, query and parent spans, got %d", len(spans))
+	}
+	for _, span := range spans[:2] {
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("span %q is not a child of the usecase span", span.Name())
+		}
+	}
+	if spans[0].Name() != "gorm.create tracing_test_rows" {
+		t.Errorf("unexpected span name %q", spans[0].Name())
+	}
+	if status := spans[1].Status(); status.Code.String() == "Error" {
+		t.Errorf("record not found should not mark the span as failed, got %v", status)
+	}
+}
+
+func TestSetupFileExporter(t *testing.T) {
+	t.Cleanup(func() { otel.SetTracerProvider(noop.NewTracerProvider()) })
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+
+	shutdown, err := Setup(Config{Exporter: ExporterFile, FilePath: path, ServiceName: "tracing-test", SampleRatio: 1})
+	if err != nil {
+		t.Fatalf("Setup: %v", err)
+	}
+	_, span := Start(context.Background(), "ChatUsecase.SendMessage")
+	span.End()
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	for _, want := range []string{`"Name":"ChatUsecase.SendMessage"`, `"tracing-test"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected trace file to contain %s, got:\n%s", want, data)
+		}
+	}
+
+	if fields := otel.GetTextMapPropagator().Fields(); !slices.Contains(fields, "traceparent") {
+		t.Errorf("expected W3C trace context propagation, got fields %v", fields)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Errorf("default config should be valid: %v", err)
+	}
+	for _, cfg := range []Config{
+		{Exporter: "jaeger", ServiceName: "x", SampleRatio: 1},
+		{Exporter: ExporterFile, ServiceName: "x", SampleRatio: 1},
+		{Exporter: ExporterStdout, ServiceName: "x", SampleRatio: 1.5},
+	} {
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("expected %+v to be rejected", cfg)
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/authz"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	"github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"gorm.io/gorm"
+)
+
+const (
+	// 관리자 사용자/신고 목록 기본/최대 개수
+	defaultAdminUserLimit = 20
+	maxAdminUserLimit     = 100
+)
+
+type adminUsecase struct {
+	userRepo         repository.UserRepository
+	refreshTokenRepo repository.RefreshTokenRepository
+	chatRoomRepo     repository.ChatRoomRepository
+	messageRepo      repository.MessageRepository
+	reportRepo       repository.UserReportRepository
+	authorizer       *authz.Authorizer
+	roomCloser       usecaseInterface.RoomCloser
+	userDisconnector usecaseInterface.UserDisconnector
+}
+
+// NewAdminUsecase - Admin Usecase 생성자
+func NewAdminUsecase(
+	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	chatRoomRepo repository.ChatRoomRepository,
+	messageRepo repository.MessageRepository,
+	reportRepo repository.UserReportRepository,
+	authorizer *authz.Authorizer,
+	roomCloser usecaseInterface.RoomCloser,
+	userDisconnector usecaseInterface.UserDisconnector,
+) usecaseInterface.AdminUsecase {
+	return &adminUsecase{
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		chatRoomRepo:     chatRoomRepo,
+		messageRepo:      messageRepo,
+		reportRepo:       reportRepo,
+		authorizer:       authorizer,
+		roomCloser:       roomCloser,
+		userDisconnector: userDisconnector,
+	}
+}
+
+// ListUsers - 정지된 사용자와 이메일 미인증 사용자를 포함한 사용자 목록 (운영자 이상)
+func (u *adminUsecase) ListUsers(ctx context.Context, actorID uint, req *dto.AdminListUsersRequest) (*dto.AdminListUsersResponse, error) {
+	ctx, span := tracing.Start(ctx, "AdminUsecase.ListUsers")
+	defer span.End()
+
+	if _, err := u.requireRole(ctx, actorID, user.RoleModerator); err != nil {
+		return nil, err
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultAdminUserLimit
+	}
+	if req.Limit > maxAdminUserLimit {
+		req.Limit = maxAdminUserLimit
+	}
+
+	var filter repository.UserFilter
+	if req.Role != "" {
+		role, err := parseRole(req.Role)
+		if err != nil {
+			return nil, err
+		}
+		filter.Role = &role
+	}
+	switch req.Status {
+	case "", "all":
+	case "active", "suspended":
+		suspended := req.Status == "suspended"
+		filter.Suspended = &suspended
+	default:
+		return nil, errors.ErrInvalidUserStatus
+	}
+
+	totalCount, err := u.userRepo.CountForAdmin(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := u.userRepo.ListForAdmin(ctx, filter, req.GetOffset(), req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AdminListUsersResponse{
+		Users:      dto.FromUserEntitiesForAdmin(users),
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalCount: totalCount,
+		TotalPages: dto.CalculateTotalPages(totalCount, req.Limit),
+	}, nil
+}
+
+// SuspendUser - 사용자 정지 (소프트 삭제 후 모든 기기 로그아웃, 운영자 이상이며 자신보다 낮은 역할만)
+func (u *adminUsecase) SuspendUser(ctx context.Context, actorID, userID uint) (*dto.AdminUserResponse, error) {
+	ctx, span := tracing.Start(ctx, "AdminUsecase.SuspendUser")
+	defer span.End()
+
+	actor, err := u.requireRole(ctx, actorID, user.RoleModerator)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsSuspended() {
+		return nil, errors.ErrUserAlreadySuspended
+	}
+	if !u.authorizer.CanModerateUser(actor, target) {
+		return nil, errors.ErrForbidden
+	}
+
+	if err := u.userRepo.Delete(ctx, target.ID); err != nil {
+		return nil, err
+	}
+	// 이미 발급된 액세스 토큰은 만료까지 남지만, 정지된 사용자는 조회되지 않아 대부분의 기능이 거부됨
+	if err := u.refreshTokenRepo.RevokeAllByUser(ctx, target.ID); err != nil {
+		return nil, err
+	}
+	// 접속 중인 채팅 연결도 끊어 더 이상 메시지를 받지 못하게 함 (재접속은 사용자 조회에서 거부)
+	if u.userDisconnector != nil {
+		u.userDisconnector.DisconnectUser(target.ID)
+	}
+
+	logger.FromContext(ctx).Warn("user suspended", "target_user_id", target.ID)
+	return u.userResponse(ctx, target.ID)
+}
+
+// RestoreUser - 정지된 사용자 복구 (운영자 이상이며 자신보다 낮은 역할만)
+func (u *adminUsecase) RestoreUser(ctx context.Context, actorID, userID uint) (*dto.AdminUserResponse, error) {
+	ctx, span := tracing.Start(ctx, "AdminUsecase.RestoreUser")
+	defer span.End()
+
+	actor, err := u.requireRole(ctx, actorID, user.RoleModerator)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !target.IsSuspended() {
+		return nil, errors.ErrUserNotSuspended
+	}
+	if !u.authorizer.CanModerateUser(actor, target) {
+		return nil, errors.ErrForbidden
+	}
+
+	if err := u.userRepo.Restore(ctx, target.ID); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotSuspended
+		}
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("user restored", "target_user_id", target.ID)
+	return u.userResponse(ctx, target.ID)
+}
+
+// UpdateUserRole - 사용자 역할 변경 (관리자만, 새 역할은 다음 토큰 갱신부터 토큰에 반영)
+func (u *adminUsecase) UpdateUserRole(ctx context.Context, actorID, userID uint, req *dto.UpdateRoleRequest) (*dto.AdminUserResponse, error) {
+	ctx, span := tracing.Start(ctx, "AdminUsecase.UpdateUserRole")
+	defer span.End()
+
+	actor, err := u.requireRole(ctx, actorID, user.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := parseRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsSuspended() {
+		return nil, errors.ErrUserNotFound
+	}
+	if !u.authorizer.CanChangeRole(actor, target) {
+		return nil, errors.ErrForbidden
+	}
+
+	if err := u.userRepo.UpdateRole(ctx, target.ID, role); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("user role changed", "target_user_id", target.ID, "from", target.Role.String(), "to", role.String())
+	return u.userResponse(ctx, target.ID)
+}
+
+// DeleteRoom - 채팅방과 메시지 삭제 후 접속 중인 연결 종료 (운영자 이상)
+func (u *adminUsecase) DeleteRoom(ctx context.Context, actorID, roomID uint) error {
+	ctx, span := tracing.Start(ctx, "AdminUsecase.DeleteRoom")
+	defer span.End()
+
+	if _, err := u.requireRole(ctx, actorID, user.RoleModerator); err != nil {
+		return err
+	}
+
+	room, err := u.chatRoomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrChatRoomNotFound
+		}
+		return err
+	}
+
+	deleted, err := u.messageRepo.Purge(ctx, room.ID, 0)
+	if err != nil {
+		return err
+	}
+	if err := u.chatRoomRepo.Delete(ctx, room.ID); err != nil {
+		return err
+	}
+	if u.roomCloser != nil {
+		u.roomCloser.CloseRoom(room.ID)
+	}
+
+	logger.FromContext(ctx).Warn("chat room deleted", "room_id", room.ID, "messages_deleted", deleted)
+	return nil
+}
+
+// PurgeMessages - 채팅방/작성자 조건에 맞는 메시지 영구 삭제 (운영자 이상)
+func (u *adminUsecase) PurgeMessages(ctx context.Context, actorID uint, req *dto.PurgeMessagesRequest) (*dto.PurgeMessagesResponse, error) {
+	ctx, span := tracing.Start(ctx, "AdminUsecase.PurgeMessages")
+	defer span.End()
+
+	if _, err := u.requireRole(ctx, actorID, user.RoleModerator); err != nil {
+		return nil, err
+	}
+
+	if req.RoomID == 0 && req.UserID == 0 {
+		return nil, errors.ErrPurgeFilterRequired
+	}
+
+	deleted, err := u.messageRepo.Purge(ctx, req.RoomID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Warn("messages purged", "room_id", req.RoomID, "target_user_id", req.UserID, "deleted", deleted)
+	return &dto.PurgeMessagesResponse{Deleted: deleted}, nil
+}
+
+// ListReports - 사용자 신고 목록 조회 (최근 순, 운영자 이상)
+func (u *adminUsecase) ListReports(ctx context.Context, actorID uint, req *dto.ListReportsRequest) (*dto.ListReportsResponse, error) {
+	ctx, span := tracing.Start(ctx, "AdminUsecase.ListReports")
+	defer span.End()
+
+	if _, err := u.requireRole(ctx, actorID, user.RoleModerator); err != nil {
+		return nil, err
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultAdminUserLimit
+	}
+	if req.Limit > maxAdminUserLimit {
+		req.Limit = maxAdminUserLimit
+	}
+
+	totalCount, err := u.reportRepo.Count(ctx, req.ReportedID)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := u.reportRepo.List(ctx, req.ReportedID, req.GetOffset(), req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ListReportsResponse{
+		Reports:    dto.FromUserReportEntities(reports),
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalCount: totalCount,
+		TotalPages: dto.CalculateTotalPages(totalCount, req.Limit),
+	}, nil
+}
+
+// 비공개 헬퍼 메서드들
+
+// requireRole - 요청자를 다시 조회해 required 이상의 역할인지 확인 (토큰 발급 후 강등/정지된 경우 거부)
+func (u *adminUsecase) requireRole(ctx context.Context, actorID uint, required user.Role) (*user.User, error) {
+	actor, err := loadActor(ctx, u.userRepo, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.authorizer.HasRole(actor, required) {
+		return nil, errors.ErrForbidden
+	}
+	return actor, nil
+}
+
+// loadActor - 권한 확인용 요청자 조회 (없거나 정지된 사용자는 ADMIN_USER_IDS에 있어도 ErrForbidden)
+func loadActor(ctx context.Context, userRepo repository.UserRepository, actorID uint) (*user.User, error) {
+	if actorID == 0 {
+		return nil, errors.ErrForbidden
+	}
+
+	actor, err := userRepo.GetByIDIncludingSuspended(ctx, actorID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrForbidden
+		}
+		return nil, err
+	}
+	if actor.IsSuspended() {
+		return nil, errors.ErrForbidden
+	}
+	return actor, nil
+}
+
+// getUser - 정지된 사용자를 포함해 조회
+func (u *adminUsecase) getUser(ctx context.Context, userID uint) (*user.User, error) {
+	target, err := u.userRepo.GetByIDIncludingSuspended(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return target, nil
+}
+
+// userResponse - 변경 후 사용자 정보를 다시 읽어 관리자용 응답으로 변환
+func (u *adminUsecase) userResponse(ctx context.Context, userID uint) (*dto.AdminUserResponse, error) {
+	target, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dto.FromUserEntityForAdmin(target), nil
+}
+
+// parseRole - 역할 문자열 검증 (RoleFromString과 달리 알 수 없는 값은 거부)
+func parseRole(value string) (user.Role, error) {
+	role := user.RoleFromString(value)
+	if role.String() != value {
+		return user.RoleUser, errors.ErrInvalidRole
+	}
+	return role, nil
+}
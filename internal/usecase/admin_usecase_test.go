@@ -0,0 +1,202 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/pkg/authz"
+	"github.com/chris910512/travel-chat/internal/usecase"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+)
+
+// roomCloserFunc - 닫힌 채팅방 ID를 기록하는 RoomCloser
+type roomCloserFunc func(roomID uint)
+
+func (f roomCloserFunc) CloseRoom(roomID uint) { f(roomID) }
+
+// userDisconnectorFunc - 연결을 끊은 사용자 ID를 기록하는 UserDisconnector
+type userDisconnectorFunc func(userID uint)
+
+func (f userDisconnectorFunc) DisconnectUser(userID uint) { f(userID) }
+
+func createUserWithRole(t *testing.T, userRepo repository.UserRepository, email string, role user.Role) *user.User {
+	t.Helper()
+	u := createChatUser(t, userRepo, email, true)
+	if err := userRepo.UpdateRole(context.Background(), u.ID, role); err != nil {
+		t.Fatalf("UpdateRole(%s): %v", email, err)
+	}
+	u.Role = role
+	return u
+}
+
+// createConfigAdmin - ADMIN_USER_IDS로 지정된 관리자 (저장된 역할은 user)
+func createConfigAdmin(t *testing.T, userRepo repository.UserRepository) *user.User {
+	t.Helper()
+	u := &user.User{ID: adminID, Email: "config-admin@example.com", Password: "hashed-password", Name: "config-admin"}
+	if err := userRepo.Create(context.Background(), u); err != nil {
+		t.Fatalf("Create(config admin): %v", err)
+	}
+	return u
+}
+
+func TestAdminSuspendAndRestore(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	var disconnected []uint
+	uc := usecase.NewAdminUsecase(userRepo, memory.NewRefreshTokenRepository(), memory.NewChatRoomRepository(), memory.NewMessageRepository(), memory.NewUserReportRepository(), authz.NewAuthorizer(nil),
+		nil, userDisconnectorFunc(func(userID uint) { disconnected = append(disconnected, userID) }))
+
+	admin := createUserWithRole(t, userRepo, "admin@example.com", user.RoleAdmin)
+	moderator := createUserWithRole(t, userRepo, "moderator@example.com", user.RoleModerator)
+	member := createUserWithRole(t, userRepo, "member@example.com", user.RoleUser)
+
+	if _, err := uc.SuspendUser(ctx, member.ID, moderator.ID); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected regular user to be forbidden, got %v", err)
+	}
+	if _, err := uc.SuspendUser(ctx, moderator.ID, admin.ID); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected moderator to be unable to suspend an admin, got %v", err)
+	}
+	if len(disconnected) != 0 {
+		t.Errorf("expected refused suspensions to keep connections, got %v", disconnected)
+	}
+
+	suspended, err := uc.SuspendUser(ctx, moderator.ID, member.ID)
+	if err != nil {
+		t.Fatalf("SuspendUser: %v", err)
+	}
+	if !suspended.Suspended || suspended.SuspendedAt == nil {
+		t.Errorf("expected user to be marked suspended, got %+v", suspended)
+	}
+	if _, err := userRepo.GetByID(ctx, member.ID); err == nil {
+		t.Error("expected suspended user to be hidden from regular lookups")
+	}
+	if len(disconnected) != 1 || disconnected[0] != member.ID {
+		t.Errorf("expected suspended user's connections to be closed, got %v", disconnected)
+	}
+	if _, err := uc.SuspendUser(ctx, moderator.ID, member.ID); !usecaseErrors.IsUserAlreadySuspended(err) {
+		t.Errorf("expected already suspended error, got %v", err)
+	}
+
+	list, err := uc.ListUsers(ctx, moderator.ID, &dto.AdminListUsersRequest{Status: "suspended"})
+	if err != nil {
+		t.Fatalf("ListUsers: %v", err)
+	}
+	if list.TotalCount != 1 || list.Users[0].ID != member.ID {
+		t.Errorf("expected only the suspended user, got %+v", list.Users)
+	}
+
+	restored, err := uc.RestoreUser(ctx, moderator.ID, member.ID)
+	if err != nil {
+		t.Fatalf("RestoreUser: %v", err)
+	}
+	if restored.Suspended {
+		t.Error("expected user to be restored")
+	}
+	if _, err := uc.RestoreUser(ctx, moderator.ID, member.ID); !usecaseErrors.IsUserNotSuspended(err) {
+		t.Errorf("expected not suspended error, got %v", err)
+	}
+}
+
+func TestAdminUpdateUserRole(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	uc := usecase.NewAdminUsecase(userRepo, memory.NewRefreshTokenRepository(), memory.NewChatRoomRepository(), memory.NewMessageRepository(), memory.NewUserReportRepository(), authz.NewAuthorizer([]uint{adminID}), nil, nil)
+
+	createConfigAdmin(t, userRepo)
+	moderator := createUserWithRole(t, userRepo, "moderator@example.com", user.RoleModerator)
+	member := createUserWithRole(t, userRepo, "member@example.com", user.RoleUser)
+
+	if _, err := uc.UpdateUserRole(ctx, moderator.ID, member.ID, &dto.UpdateRoleRequest{Role: "moderator"}); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected moderator to be unable to change roles, got %v", err)
+	}
+	if _, err := uc.UpdateUserRole(ctx, adminID, member.ID, &dto.UpdateRoleRequest{Role: "owner"}); !usecaseErrors.IsInvalidRole(err) {
+		t.Errorf("expected invalid role error, got %v", err)
+	}
+
+	// ADMIN_USER_IDS로 지정된 관리자는 저장된 역할과 무관하게 관리자
+	updated, err := uc.UpdateUserRole(ctx, adminID, member.ID, &dto.UpdateRoleRequest{Role: "moderator"})
+	if err != nil {
+		t.Fatalf("UpdateUserRole: %v", err)
+	}
+	if updated.Role != "moderator" {
+		t.Errorf("expected role moderator, got %q", updated.Role)
+	}
+
+	// 강등되면 토큰과 무관하게 즉시 권한을 잃음
+	if _, err := uc.UpdateUserRole(ctx, adminID, moderator.ID, &dto.UpdateRoleRequest{Role: "user"}); err != nil {
+		t.Fatalf("UpdateUserRole: %v", err)
+	}
+	if _, err := uc.SuspendUser(ctx, moderator.ID, member.ID); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected demoted moderator to be forbidden, got %v", err)
+	}
+
+	// 정지되거나 저장된 사용자가 없으면 ADMIN_USER_IDS에 있어도 권한 없음
+	if err := userRepo.Delete(ctx, adminID); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := uc.UpdateUserRole(ctx, adminID, member.ID, &dto.UpdateRoleRequest{Role: "user"}); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected suspended config admin to be forbidden, got %v", err)
+	}
+	if _, err := uc.ListUsers(ctx, 9999, &dto.AdminListUsersRequest{}); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected missing actor to be forbidden, got %v", err)
+	}
+}
+
+func TestAdminDeleteRoomAndPurgeMessages(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	chatRoomRepo := memory.NewChatRoomRepository()
+	messageRepo := memory.NewMessageRepository()
+	var closed []uint
+	uc := usecase.NewAdminUsecase(userRepo, memory.NewRefreshTokenRepository(), chatRoomRepo, messageRepo, memory.NewUserReportRepository(), authz.NewAuthorizer(nil),
+		roomCloserFunc(func(roomID uint) { closed = append(closed, roomID) }), nil)
+	chatUC := usecase.NewChatUsecase(userRepo, chatRoomRepo, messageRepo, memory.NewUserBlockRepository(), memory.NewBlobRepository(), nil, nil, usecase.ChatConfig{})
+
+	moderator := createUserWithRole(t, userRepo, "moderator@example.com", user.RoleModerator)
+	member := createUserWithRole(t, userRepo, "member@example.com", user.RoleUser)
+
+	room, err := chatUC.JoinPublicRoom(ctx, member.ID)
+	if err != nil {
+		t.Fatalf("JoinPublicRoom: %v", err)
+	}
+	for _, content := range []string{"첫 메시지", "두 번째 메시지"} {
+		if _, err := chatUC.SendMessage(ctx, member.ID, room.ID, &dto.SendMessageRequest{Content: content}); err != nil {
+			t.Fatalf("SendMessage: %v", err)
+		}
+	}
+
+	if _, err := uc.PurgeMessages(ctx, moderator.ID, &dto.PurgeMessagesRequest{}); !usecaseErrors.IsPurgeFilterRequired(err) {
+		t.Errorf("expected purge filter error, got %v", err)
+	}
+	purged, err := uc.PurgeMessages(ctx, moderator.ID, &dto.PurgeMessagesRequest{UserID: member.ID})
+	if err != nil {
+		t.Fatalf("PurgeMessages: %v", err)
+	}
+	if purged.Deleted != 2 {
+		t.Errorf("expected 2 purged messages, got %d", purged.Deleted)
+	}
+
+	if err := messageRepo.Create(ctx, &message.Message{ChatRoomID: room.ID, UserID: member.ID, Content: "남은 메시지", MessageType: message.MessageTypeText}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if err := uc.DeleteRoom(ctx, member.ID, room.ID); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected regular user to be forbidden, got %v", err)
+	}
+	if err := uc.DeleteRoom(ctx, moderator.ID, room.ID); err != nil {
+		t.Fatalf("DeleteRoom: %v", err)
+	}
+	if len(closed) != 1 || closed[0] != room.ID {
+		t.Errorf("expected room %d to be closed, got %v", room.ID, closed)
+	}
+	if _, err := chatRoomRepo.GetByID(ctx, room.ID); err == nil {
+		t.Error("expected deleted room to be gone")
+	}
+	if err := uc.DeleteRoom(ctx, moderator.ID, room.ID); !usecaseErrors.IsChatRoomNotFound(err) {
+		t.Errorf("expected room not found, got %v", err)
+	}
+}
@@ -0,0 +1,489 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/blob"
+	"github.com/chris910512/travel-chat/internal/domain/entity/chatroom"
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+	"github.com/chris910512/travel-chat/internal/domain/entity/shared"
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/moderation"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	"github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"gorm.io/gorm"
+)
+
+const (
+	// 메시지 기록 조회 기본/최대 개수
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 100
+)
+
+// ChatConfig - 채팅 설정
+type ChatConfig struct {
+	PublicMessageRetention  time.Duration // 전체 채팅 메시지 보관 기간
+	PrivateMessageRetention time.Duration // 1:1 채팅 메시지 보관 기간
+}
+
+// DefaultChatConfig - 기본 설정 (전체 채팅 6시간, 1:1 채팅 24시간 보관)
+func DefaultChatConfig() ChatConfig {
+	return ChatConfig{
+		PublicMessageRetention:  6 * time.Hour,
+		PrivateMessageRetention: 24 * time.Hour,
+	}
+}
+
+type chatUsecase struct {
+	userRepo     repository.UserRepository
+	chatRoomRepo repository.ChatRoomRepository
+	messageRepo  repository.MessageRepository
+	blockRepo    repository.UserBlockRepository
+	blobRepo     repository.BlobRepository
+	moderator    *moderation.Pipeline
+	publisher    usecaseInterface.MessagePublisher
+	config       ChatConfig
+}
+
+// NewChatUsecase - Chat Usecase 생성자 (설정 값이 0 이하이면 기본값, moderator가 nil이면 기본 검사 사용)
+func NewChatUsecase(
+	userRepo repository.UserRepository,
+	chatRoomRepo repository.ChatRoomRepository,
+	messageRepo repository.MessageRepository,
+	blockRepo repository.UserBlockRepository,
+	blobRepo repository.BlobRepository,
+	moderator *moderation.Pipeline,
+	publisher usecaseInterface.MessagePublisher,
+	config ChatConfig,
+) usecaseInterface.ChatUsecase {
+	defaults := DefaultChatConfig()
+	if config.PublicMessageRetention <= 0 {
+		config.PublicMessageRetention = defaults.PublicMessageRetention
+	}
+	if config.PrivateMessageRetention <= 0 {
+		config.PrivateMessageRetention = defaults.PrivateMessageRetention
+	}
+	if moderator == nil {
+		// 기본 설정은 항상 유효함
+		moderator, _ = moderation.New(moderation.DefaultConfig())
+	}
+
+	return &chatUsecase{
+		userRepo:     userRepo,
+		chatRoomRepo: chatRoomRepo,
+		messageRepo:  messageRepo,
+		blockRepo:    blockRepo,
+		blobRepo:     blobRepo,
+		moderator:    moderator,
+		publisher:    publisher,
+		config:       config,
+	}
+}
+
+// SendMessage - 메시지 전송 (저장 후 실시간 연결로 전달, image_id가 있으면 이미지 메시지)
+func (u *chatUsecase) SendMessage(ctx context.Context, userID, roomID uint, req *dto.SendMessageRequest) (*dto.MessageResponse, error) {
+	ctx, span := tracing.Start(ctx, "ChatUsecase.SendMessage")
+	defer span.End()
+
+	// 1. 메시지 내용 검증 (이미지 메시지는 설명 없이 보낼 수 있음)
+	content := strings.TrimSpace(req.Content)
+	imageID := strings.TrimSpace(req.ImageID)
+	if content == "" && imageID == "" {
+		return nil, errors.ErrEmptyMessage
+	}
+
+	// 2. 발신자(이메일 인증 필요)와 채팅방 조회 및 접근 권한 확인
+	sender, err := u.getVerifiedUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.getRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.authorizeRoomAccess(ctx, sender, room); err != nil {
+		return nil, err
+	}
+
+	// 1:1 채팅방은 상대와 차단 관계가 생기면 더 이상 메시지를 보낼 수 없음
+	if partnerID, ok := room.PrivatePartnerID(sender.ID); ok {
+		if err := u.ensureNotBlocked(ctx, sender.ID, partnerID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 첨부 이미지는 본인이 올린 만료되지 않은 이미지만 사용 가능
+	var image *blob.Blob
+	if imageID != "" {
+		if image, err = u.getOwnImage(ctx, sender.ID, imageID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. 전송 전 검사 (거부하면 저장하지 않고, 가린 경우 가린 내용을 저장)
+	result := u.moderator.Run(moderation.Input{Content: content, PublicRoom: room.IsPublic()})
+	metrics.MessageModerated(result.Action.String(), result.Flags)
+	if result.Action == moderation.ActionReject {
+		logger.FromContext(ctx).Info("message rejected by moderation", "room_id", room.ID, "stage", result.Stage, "reason", result.Reason)
+		return nil, u.rejectionError(result)
+	}
+
+	// 4. 메시지 생성 및 채팅방 종류별 보관 기간으로 만료 시간 설정
+	msg := &message.Message{
+		Content:     result.Content,
+		UserID:      sender.ID,
+		ChatRoomID:  room.ID,
+		MessageType: message.MessageTypeText,
+		Moderation:  message.ModerationAllowed,
+		CreatedAt:   time.Now(),
+	}
+	if result.Action == moderation.ActionMask {
+		msg.Moderation = message.ModerationMasked
+		msg.ModeratedBy = strings.Join(result.Flags, ",")
+	}
+	if room.IsPublic() {
+		msg.SetExpiration(u.config.PublicMessageRetention)
+	} else {
+		msg.SetExpiration(u.config.PrivateMessageRetention)
+	}
+
+	// 이미지는 메시지가 만료될 때까지 보관
+	if image != nil {
+		msg.MessageType = message.MessageTypeImage
+		msg.BlobID = &image.ID
+		if err := u.blobRepo.ExtendExpiration(ctx, image.ID, *msg.ExpiresAt); err != nil {
+			return nil, err
+		}
+	}
+
+	// 5. 메시지 저장
+	if err := u.messageRepo.Create(ctx, msg); err != nil {
+		return nil, err
+	}
+	metrics.MessageSent(room.ID)
+
+	// 6. 실시간 연결로 전달
+	resp := dto.FromMessageEntity(msg, sender.Name)
+	if u.publisher != nil {
+		u.publisher.PublishMessage(room.ID, resp)
+	}
+
+	return resp, nil
+}
+
+// GetHistory - 채팅방 메시지 기록 조회 (만료된 메시지, 내가 차단한 사용자의 메시지 제외)
+func (u *chatUsecase) GetHistory(ctx context.Context, userID, roomID uint, req *dto.GetHistoryRequest) (*dto.MessageHistoryResponse, error) {
+	ctx, span := tracing.Start(ctx, "ChatUsecase.GetHistory")
+	defer span.End()
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	requester, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.getRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.authorizeRoomAccess(ctx, requester, room); err != nil {
+		return nil, err
+	}
+
+	// 차단한 사용자의 메시지는 조회 단계에서 제외 (limit개를 채울 수 있도록)
+	blockedIDs, err := u.blockRepo.GetBlockedIDs(ctx, requester.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := u.messageRepo.GetByChatRoom(ctx, room.ID, blockedIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// 최신순으로 조회되므로 오래된 메시지부터 정렬
+	userNames := make(map[uint]string)
+	responses := make([]dto.MessageResponse, 0, len(messages))
+	for i := len(messages) - 1; i >= 0; i-- {
+		msg := messages[i]
+		if msg.IsExpired() {
+			continue
+		}
+		responses = append(responses, *dto.FromMessageEntity(msg, u.lookupUserName(ctx, userNames, msg.UserID)))
+	}
+
+	return &dto.MessageHistoryResponse{
+		ChatRoom: *dto.FromChatRoomEntity(room),
+		Messages: responses,
+	}, nil
+}
+
+// JoinPublicRoom - 내 목적지의 전체 채팅방 참여 (없으면 생성)
+func (u *chatUsecase) JoinPublicRoom(ctx context.Context, userID uint) (*dto.ChatRoomResponse, error) {
+	ctx, span := tracing.Start(ctx, "ChatUsecase.JoinPublicRoom")
+	defer span.End()
+
+	userEntity, err := u.getVerifiedUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !shared.ValidateDestination(userEntity.Country, userEntity.City) {
+		return nil, errors.ErrDestinationNotSet
+	}
+
+	country, city := shared.NormalizeDestination(userEntity.Country, userEntity.City)
+	room, err := u.chatRoomRepo.GetOrCreatePublicRoom(ctx, country, city)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.FromChatRoomEntity(room), nil
+}
+
+// GetRoom - 접근 가능한 채팅방 조회
+func (u *chatUsecase) GetRoom(ctx context.Context, userID, roomID uint) (*dto.ChatRoomResponse, error) {
+	ctx, span := tracing.Start(ctx, "ChatUsecase.GetRoom")
+	defer span.End()
+
+	requester, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	room, err := u.getRoom(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.authorizeRoomAccess(ctx, requester, room); err != nil {
+		return nil, err
+	}
+
+	return dto.FromChatRoomEntity(room), nil
+}
+
+// OpenPrivateRoom - 다른 사용자와의 1:1 채팅방 열기 (같은 사용자 쌍이면 항상 같은 방 반환, 차단 관계면 불가)
+func (u *chatUsecase) OpenPrivateRoom(ctx context.Context, userID, targetUserID uint) (*dto.ChatRoomResponse, error) {
+	ctx, span := tracing.Start(ctx, "ChatUsecase.OpenPrivateRoom")
+	defer span.End()
+
+	if userID == targetUserID {
+		return nil, errors.ErrCannotChatWithSelf
+	}
+
+	requester, err := u.getVerifiedUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 인증하지 않은 사용자는 탐색에서 보이지 않으므로 없는 사용자로 처리
+	target, err := u.getUser(ctx, targetUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !target.EmailVerified {
+		return nil, errors.ErrUserNotFound
+	}
+	if err := u.ensureNotBlocked(ctx, requester.ID, target.ID); err != nil {
+		return nil, err
+	}
+
+	country, city := shared.NormalizeDestination(requester.Country, requester.City)
+	room, err := u.chatRoomRepo.GetOrCreatePrivateRoom(ctx, country, city, requester.ID, target.ID, requester.Name, target.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.FromChatRoomEntity(room), nil
+}
+
+// ListRooms - 내 채팅방 목록 조회 (목적지 전체 채팅방 + 참여 중인 1:1 채팅방)
+func (u *chatUsecase) ListRooms(ctx context.Context, userID uint) ([]dto.ChatRoomResponse, error) {
+	ctx, span := tracing.Start(ctx, "ChatUsecase.ListRooms")
+	defer span.End()
+
+	userEntity, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := listUserRooms(ctx, u.chatRoomRepo, userEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]dto.ChatRoomResponse, len(rooms))
+	for i, room := range rooms {
+		responses[i] = *dto.FromChatRoomEntity(room)
+	}
+
+	return responses, nil
+}
+
+// GetBlockedUserIDs - 내가 차단한 사용자 ID (실시간 연결에서 이 사용자들의 이벤트를 숨김)
+func (u *chatUsecase) GetBlockedUserIDs(ctx context.Context, userID uint) ([]uint, error) {
+	ctx, span := tracing.Start(ctx, "ChatUsecase.GetBlockedUserIDs")
+	defer span.End()
+
+	return u.blockRepo.GetBlockedIDs(ctx, userID)
+}
+
+// 비공개 헬퍼 메서드들
+
+// getUser - 사용자 조회 (없으면 ErrUserNotFound)
+func (u *chatUsecase) getUser(ctx context.Context, userID uint) (*user.User, error) {
+	userEntity, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return userEntity, nil
+}
+
+// getVerifiedUser - 이메일 인증을 마친 사용자 조회 (채팅방 참여, 메시지 전송 전 확인)
+func (u *chatUsecase) getVerifiedUser(ctx context.Context, userID uint) (*user.User, error) {
+	userEntity, err := u.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !userEntity.EmailVerified {
+		return nil, errors.ErrEmailNotVerified
+	}
+	return userEntity, nil
+}
+
+// ensureNotBlocked - 두 사용자 중 한쪽이라도 상대를 차단했으면 ErrUserBlocked (누가 차단했는지는 알리지 않음)
+func (u *chatUsecase) ensureNotBlocked(ctx context.Context, userID, otherUserID uint) error {
+	blocked, err := isBlockedEitherWay(ctx, u.blockRepo, userID, otherUserID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errors.ErrUserBlocked
+	}
+	return nil
+}
+
+// getOwnImage - 본인이 올린 만료되지 않은 채팅 이미지 조회 (아니면 ErrImageNotFound)
+func (u *chatUsecase) getOwnImage(ctx context.Context, userID uint, imageID string) (*blob.Blob, error) {
+	image, err := u.blobRepo.GetByID(ctx, imageID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrImageNotFound
+		}
+		return nil, err
+	}
+	if image.OwnerID != userID || image.Kind != blob.KindImage || image.IsExpired() {
+		return nil, errors.ErrImageNotFound
+	}
+	return image, nil
+}
+
+// rejectionError - 메시지 검사에서 거부한 단계를 usecase 에러로 변환
+func (u *chatUsecase) rejectionError(result moderation.Result) error {
+	switch result.Stage {
+	case moderation.StageMaxLength:
+		return fmt.Errorf("%w (최대 %d자)", errors.ErrMessageTooLong, u.moderator.MaxLength())
+	case moderation.StageProfanity:
+		return errors.ErrProfanityNotAllowed
+	case moderation.StageContact:
+		return errors.ErrContactInfoNotAllowed
+	case moderation.StageURL:
+		return errors.ErrURLNotAllowed
+	default:
+		return errors.ErrMessageRejected
+	}
+}
+
+// getRoom - 채팅방 조회 (없으면 ErrChatRoomNotFound)
+func (u *chatUsecase) getRoom(ctx context.Context, roomID uint) (*chatroom.ChatRoom, error) {
+	room, err := u.chatRoomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrChatRoomNotFound
+		}
+		return nil, err
+	}
+	return room, nil
+}
+
+// authorizeRoomAccess - 채팅방 접근 권한 확인
+func (u *chatUsecase) authorizeRoomAccess(ctx context.Context, userEntity *user.User, room *chatroom.ChatRoom) error {
+	// 전체 채팅방은 같은 목적지로 여행하는 사용자만 참여 가능
+	if room.IsPublic() {
+		if userEntity.GetDestination() != room.GetRoomKey() {
+			return errors.ErrForbidden
+		}
+		return nil
+	}
+
+	// 1:1 채팅방은 참여자로 등록된 두 사용자만 접근 가능
+	isMember, err := u.chatRoomRepo.IsMember(ctx, room.ID, userEntity.ID)
+	if err != nil {
+		return err
+	}
+	if !isMember {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
+// listUserRooms - 사용자의 채팅방 목록 (목적지 전체 채팅방 + 참여 중인 1:1 채팅방)
+func listUserRooms(ctx context.Context, chatRoomRepo repository.ChatRoomRepository, userEntity *user.User) ([]*chatroom.ChatRoom, error) {
+	var rooms []*chatroom.ChatRoom
+
+	// 전체 채팅방은 이미 만들어진 경우에만 포함 (참여 시 JoinPublicRoom에서 생성)
+	if shared.ValidateDestination(userEntity.Country, userEntity.City) {
+		country, city := shared.NormalizeDestination(userEntity.Country, userEntity.City)
+		publicRoom, err := chatRoomRepo.GetByLocation(ctx, country, city, chatroom.RoomTypePublic)
+		switch err {
+		case nil:
+			rooms = append(rooms, publicRoom)
+		case gorm.ErrRecordNotFound:
+		default:
+			return nil, err
+		}
+	}
+
+	privateRooms, err := chatRoomRepo.GetByMember(ctx, userEntity.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(rooms, privateRooms...), nil
+}
+
+// lookupUserName - 메시지 작성자 이름 조회 (조회 결과 캐싱)
+func (u *chatUsecase) lookupUserName(ctx context.Context, cache map[uint]string, userID uint) string {
+	if name, ok := cache[userID]; ok {
+		return name
+	}
+
+	name := ""
+	if userEntity, err := u.userRepo.GetByID(ctx, userID); err == nil {
+		name = userEntity.Name
+	}
+	cache[userID] = name
+	return name
+}
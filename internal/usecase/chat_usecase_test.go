@@ -0,0 +1,124 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/pkg/moderation"
+	"github.com/chris910512/travel-chat/internal/usecase"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+)
+
+// createChatUser - 도쿄로 여행하는 사용자 저장
+func createChatUser(t *testing.T, userRepo repository.UserRepository, email string, verified bool) *user.User {
+	t.Helper()
+	now := time.Now()
+	u := &user.User{
+		Email:         email,
+		Password:      "hashed-password",
+		Name:          email,
+		Country:       "일본",
+		City:          "도쿄",
+		TravelStart:   now.Add(24 * time.Hour),
+		TravelEnd:     now.Add(7 * 24 * time.Hour),
+		EmailVerified: verified,
+	}
+	if err := userRepo.Create(context.Background(), u); err != nil {
+		t.Fatalf("Create(%s): %v", email, err)
+	}
+	return u
+}
+
+func TestChatRequiresVerifiedEmail(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	uc := usecase.NewChatUsecase(userRepo, memory.NewChatRoomRepository(), memory.NewMessageRepository(), memory.NewUserBlockRepository(), memory.NewBlobRepository(), nil, nil, usecase.ChatConfig{})
+
+	verified := createChatUser(t, userRepo, "verified@example.com", true)
+	unverified := createChatUser(t, userRepo, "unverified@example.com", false)
+
+	room, err := uc.JoinPublicRoom(ctx, verified.ID)
+	if err != nil {
+		t.Fatalf("JoinPublicRoom: %v", err)
+	}
+	if _, err := uc.SendMessage(ctx, verified.ID, room.ID, &dto.SendMessageRequest{Content: "안녕하세요"}); err != nil {
+		t.Errorf("expected verified user to send, got %v", err)
+	}
+
+	if _, err := uc.JoinPublicRoom(ctx, unverified.ID); !usecaseErrors.IsEmailNotVerified(err) {
+		t.Errorf("expected unverified user to be blocked from joining, got %v", err)
+	}
+	if _, err := uc.SendMessage(ctx, unverified.ID, room.ID, &dto.SendMessageRequest{Content: "안녕하세요"}); !usecaseErrors.IsEmailNotVerified(err) {
+		t.Errorf("expected unverified user to be blocked from sending, got %v", err)
+	}
+	if _, err := uc.OpenPrivateRoom(ctx, verified.ID, unverified.ID); !usecaseErrors.IsUserNotFound(err) {
+		t.Errorf("expected unverified target to be hidden, got %v", err)
+	}
+}
+
+func TestSendMessageModeration(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	messageRepo := memory.NewMessageRepository()
+
+	cfg := moderation.DefaultConfig()
+	cfg.MaxLength = 20
+	cfg.URLDenylist = []string{"scam.example"}
+	moderator, err := moderation.New(cfg)
+	if err != nil {
+		t.Fatalf("moderation.New: %v", err)
+	}
+	uc := usecase.NewChatUsecase(userRepo, memory.NewChatRoomRepository(), messageRepo, memory.NewUserBlockRepository(), memory.NewBlobRepository(), moderator, nil, usecase.ChatConfig{})
+
+	sender := createChatUser(t, userRepo, "sender@example.com", true)
+	partner := createChatUser(t, userRepo, "partner@example.com", true)
+	publicRoom, err := uc.JoinPublicRoom(ctx, sender.ID)
+	if err != nil {
+		t.Fatalf("JoinPublicRoom: %v", err)
+	}
+	privateRoom, err := uc.OpenPrivateRoom(ctx, sender.ID, partner.ID)
+	if err != nil {
+		t.Fatalf("OpenPrivateRoom: %v", err)
+	}
+
+	// 가린 내용과 검사 결과가 저장됨
+	masked, err := uc.SendMessage(ctx, sender.ID, publicRoom.ID, &dto.SendMessageRequest{Content: "번호 010-1234-5678"})
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if masked.Content != "번호 *************" || masked.Moderation != "masked" {
+		t.Errorf("expected masked phone number, got %q (%s)", masked.Content, masked.Moderation)
+	}
+	stored, err := messageRepo.GetByID(ctx, masked.ID)
+	if err != nil || stored.Content != masked.Content || stored.ModeratedBy != moderation.StageContact {
+		t.Errorf("expected masked message to be stored, got %+v (%v)", stored, err)
+	}
+
+	// 1:1 채팅방에서는 연락처 허용
+	if private, err := uc.SendMessage(ctx, sender.ID, privateRoom.ID, &dto.SendMessageRequest{Content: "번호 010-1234-5678"}); err != nil || private.Moderation != "allowed" {
+		t.Errorf("expected contact info in private room to be allowed, got %+v (%v)", private, err)
+	}
+
+	// 거부된 메시지는 저장하지 않음
+	rejected := []struct {
+		content string
+		check   func(error) bool
+	}{
+		{"이 메시지는 스무 글자를 훌쩍 넘어가는 긴 메시지입니다", usecaseErrors.IsMessageTooLong},
+		{"www.scam.example", usecaseErrors.IsURLNotAllowed},
+	}
+	for _, tc := range rejected {
+		if _, err := uc.SendMessage(ctx, sender.ID, publicRoom.ID, &dto.SendMessageRequest{Content: tc.content}); !tc.check(err) || !usecaseErrors.IsMessageRejected(err) {
+			t.Errorf("%q: unexpected error %v", tc.content, err)
+		}
+	}
+	history, err := uc.GetHistory(ctx, sender.ID, publicRoom.ID, &dto.GetHistoryRequest{})
+	if err != nil || len(history.Messages) != 1 {
+		t.Errorf("expected only the masked message in history, got %d (%v)", len(history.Messages), err)
+	}
+}
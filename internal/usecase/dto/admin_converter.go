@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+)
+
+// FromUserEntityForAdmin - User 엔티티를 관리자용 응답 DTO로 변환
+func FromUserEntityForAdmin(u *user.User) *AdminUserResponse {
+	resp := &AdminUserResponse{
+		UserResponse:        *FromUserEntity(u),
+		FailedLoginAttempts: u.FailedLoginAttempts,
+		LockedUntil:         u.LockedUntil,
+		Suspended:           u.IsSuspended(),
+	}
+	if u.IsSuspended() {
+		suspendedAt := u.DeletedAt.Time
+		resp.SuspendedAt = &suspendedAt
+	}
+	return resp
+}
+
+// FromUserEntitiesForAdmin - User 엔티티 슬라이스를 관리자용 응답 슬라이스로 변환
+func FromUserEntitiesForAdmin(users []*user.User) []AdminUserResponse {
+	responses := make([]AdminUserResponse, len(users))
+	for i, u := range users {
+		responses[i] = *FromUserEntityForAdmin(u)
+	}
+	return responses
+}
@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+)
+
+// AdminListUsersRequest - 관리자 사용자 목록 요청 (정지된 사용자 포함, 페이징)
+type AdminListUsersRequest struct {
+	Page   int    `form:"page" binding:"omitempty,min=1"`                        // 페이지 번호 (1부터 시작)
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`               // 페이지 크기
+	Role   string `form:"role" binding:"omitempty,oneof=user moderator admin"`   // 역할 필터
+	Status string `form:"status" binding:"omitempty,oneof=active suspended all"` // 정지 여부 필터 (기본 all)
+}
+
+// GetOffset - 페이징 계산 헬퍼
+func (req *AdminListUsersRequest) GetOffset() int {
+	return (req.Page - 1) * req.Limit
+}
+
+// AdminUserResponse - 관리자용 사용자 정보 (정지/잠금 상태 포함)
+type AdminUserResponse struct {
+	UserResponse
+	FailedLoginAttempts int        `json:"failed_login_attempts"`
+	LockedUntil         *time.Time `json:"locked_until,omitempty"`
+	Suspended           bool       `json:"suspended"`
+	SuspendedAt         *time.Time `json:"suspended_at,omitempty"`
+}
+
+// AdminListUsersResponse - 관리자 사용자 목록 응답
+type AdminListUsersResponse struct {
+	Users      []AdminUserResponse `json:"users"`
+	Page       int                 `json:"page"`
+	Limit      int                 `json:"limit"`
+	TotalCount int64               `json:"total_count"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+// UpdateRoleRequest - 사용자 역할 변경 요청
+type UpdateRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=user moderator admin"`
+}
+
+// PurgeMessagesRequest - 메시지 일괄 삭제 조건 (채팅방, 작성자 중 하나 이상 필요)
+type PurgeMessagesRequest struct {
+	RoomID uint `form:"room_id" json:"room_id"`
+	UserID uint `form:"user_id" json:"user_id"`
+}
+
+// PurgeMessagesResponse - 메시지 일괄 삭제 결과
+type PurgeMessagesResponse struct {
+	Deleted int64 `json:"deleted"`
+}
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // LoginResponse - 로그인 응답 (JWT 포함)
 type LoginResponse struct {
 	User         UserResponse `json:"user"`
@@ -21,3 +23,61 @@ type RefreshTokenResponse struct {
 	TokenType    string `json:"token_type"`
 	ExpiresIn    int    `json:"expires_in"`
 }
+
+// LogoutRequest - 로그아웃 요청 (현재 기기의 리프레시 토큰)
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// GetLoginHistoryRequest - 로그인 기록 조회 요청 (페이징)
+type GetLoginHistoryRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`          // 페이지 번호 (1부터 시작)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"` // 페이지 크기
+}
+
+// GetOffset - 페이징 계산 헬퍼
+func (req *GetLoginHistoryRequest) GetOffset() int {
+	return (req.Page - 1) * req.Limit
+}
+
+// LoginHistoryResponse - 로그인 시도 기록
+type LoginHistoryResponse struct {
+	ID            uint      `json:"id"`
+	IP            string    `json:"ip"`
+	UserAgent     string    `json:"user_agent"`
+	Success       bool      `json:"success"`
+	FailureReason string    `json:"failure_reason,omitempty"` // wrong_password, locked
+	NewIP         bool      `json:"new_ip"`                   // 처음 보는 IP에서 로그인 성공
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// GetLoginHistoryResponse - 로그인 기록 목록 응답 (최근 순)
+type GetLoginHistoryResponse struct {
+	Logins     []LoginHistoryResponse `json:"logins"`
+	Page       int                    `json:"page"`
+	Limit      int                    `json:"limit"`
+	TotalCount int64                  `json:"total_count"`
+	TotalPages int                    `json:"total_pages"`
+}
+
+// ChangePasswordRequest - 로그인한 사용자의 비밀번호 변경 요청
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required"`
+}
+
+// ForgotPasswordRequest - 비밀번호 재설정 메일 요청
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// ResetPasswordRequest - 메일로 받은 토큰으로 비밀번호 재설정
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+// VerifyEmailRequest - 메일로 받은 토큰으로 이메일 인증
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
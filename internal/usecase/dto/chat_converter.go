@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"github.com/chris910512/travel-chat/internal/domain/entity/chatroom"
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+)
+
+// ChatRoom 엔티티를 ChatRoomResponse로 변환
+func FromChatRoomEntity(room *chatroom.ChatRoom) *ChatRoomResponse {
+	return &ChatRoomResponse{
+		ID:          room.ID,
+		Country:     room.Country,
+		City:        room.City,
+		Destination: room.GetRoomKey(),
+		RoomType:    (&room.RoomType).String(),
+		Name:        room.Name,
+		CreatedAt:   room.CreatedAt,
+	}
+}
+
+// Message 엔티티를 MessageResponse로 변환
+func FromMessageEntity(msg *message.Message, userName string) *MessageResponse {
+	resp := &MessageResponse{
+		ID:          msg.ID,
+		ChatRoomID:  msg.ChatRoomID,
+		UserID:      msg.UserID,
+		UserName:    userName,
+		Content:     msg.Content,
+		MessageType: (&msg.MessageType).String(),
+		Moderation:  string(msg.Moderation),
+		CreatedAt:   msg.CreatedAt,
+		ExpiresAt:   msg.ExpiresAt,
+	}
+	if msg.BlobID != nil {
+		resp.Image = ImageRef(*msg.BlobID)
+	}
+	return resp
+}
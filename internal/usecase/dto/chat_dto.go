@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"time"
+)
+
+// 메시지 전송 요청 (이미지 메시지는 업로드한 이미지 ID를 image_id로 전달, content는 설명으로 선택)
+type SendMessageRequest struct {
+	Content string `json:"content" binding:"required_without=ImageID"`
+	ImageID string `json:"image_id"`
+}
+
+// 메시지 응답
+type MessageResponse struct {
+	ID          uint           `json:"id"`
+	ChatRoomID  uint           `json:"chat_room_id"`
+	UserID      uint           `json:"user_id"`
+	UserName    string         `json:"user_name"`
+	Content     string         `json:"content"`
+	MessageType string         `json:"message_type"`
+	Moderation  string         `json:"moderation"`      // allowed | masked (일부 내용을 가림)
+	Image       *ImageResponse `json:"image,omitempty"` // 이미지 메시지의 첨부 이미지
+	CreatedAt   time.Time      `json:"created_at"`
+	ExpiresAt   *time.Time     `json:"expires_at,omitempty"`
+}
+
+// 메시지 기록 조회 요청
+type GetHistoryRequest struct {
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"` // 조회할 메시지 수
+}
+
+// 메시지 기록 응답 (오래된 메시지부터 정렬)
+type MessageHistoryResponse struct {
+	ChatRoom ChatRoomResponse  `json:"chat_room"`
+	Messages []MessageResponse `json:"messages"`
+}
+
+// 1:1 채팅방 생성 요청
+type OpenPrivateRoomRequest struct {
+	TargetUserID uint `json:"target_user_id" binding:"required"`
+}
+
+// 채팅방 응답
+type ChatRoomResponse struct {
+	ID          uint      `json:"id"`
+	Country     string    `json:"country"`
+	City        string    `json:"city"`
+	Destination string    `json:"destination"` // "국가-도시" 형식
+	RoomType    string    `json:"room_type"`
+	Name        string    `json:"name"`
+	CreatedAt   time.Time `json:"created_at"`
+}
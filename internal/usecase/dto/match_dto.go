@@ -0,0 +1,38 @@
+package dto
+
+// 동행 추천 목록 요청 (페이징)
+type GetMatchesRequest struct {
+	Page  int `form:"page" binding:"omitempty,min=1"`          // 페이지 번호 (1부터 시작)
+	Limit int `form:"limit" binding:"omitempty,min=1,max=100"` // 페이지 크기
+}
+
+// 항목별 점수 (각 0~1, 높을수록 잘 맞음)
+type MatchScoreBreakdown struct {
+	Destination float64 `json:"destination"`  // 같은 도시 1, 같은 국가 다른 도시는 일부 점수
+	DateOverlap float64 `json:"date_overlap"` // 여행 기간이 겹치는 비율
+	Purpose     float64 `json:"purpose"`      // 여행 목적 호환성
+	Style       float64 `json:"style"`        // 여행 스타일 호환성
+	Budget      float64 `json:"budget"`       // 예산 근접도
+	OverlapDays int     `json:"overlap_days"` // 여행 기간이 겹치는 일수
+}
+
+// 동행 추천 결과
+type MatchResponse struct {
+	User      UserResponse        `json:"user"`
+	Score     float64             `json:"score"` // 가중 합산 점수 (0~100)
+	Breakdown MatchScoreBreakdown `json:"breakdown"`
+}
+
+// 동행 추천 목록 응답 (점수 높은 순)
+type GetMatchesResponse struct {
+	Matches    []MatchResponse `json:"matches"`
+	Page       int             `json:"page"`
+	Limit      int             `json:"limit"`
+	TotalCount int64           `json:"total_count"`
+	TotalPages int             `json:"total_pages"`
+}
+
+// 페이징 계산 헬퍼
+func (req *GetMatchesRequest) GetOffset() int {
+	return (req.Page - 1) * req.Limit
+}
@@ -0,0 +1,46 @@
+package dto
+
+import "github.com/chris910512/travel-chat/internal/domain/entity/blob"
+
+// BlobURL - 저장된 파일을 내려받는 서버 경로
+func BlobURL(id, variant string) string {
+	return "/api/blobs/" + id + "/" + variant
+}
+
+// ImageRef - 이미지 ID만으로 만든 ImageResponse (메시지 응답용)
+func ImageRef(id string) *ImageResponse {
+	return &ImageResponse{
+		ID:           id,
+		URL:          BlobURL(id, blob.VariantOriginal),
+		ThumbnailURL: BlobURL(id, blob.VariantThumbnail),
+	}
+}
+
+// AvatarURL - 프로필 사진 URL (업로드하지 않았으면 빈 문자열)
+func AvatarURL(avatarID *string, variant string) string {
+	if avatarID == nil {
+		return ""
+	}
+	return BlobURL(*avatarID, variant)
+}
+
+// AvatarURLs - 크기별 프로필 사진 URL (업로드하지 않았으면 nil)
+func AvatarURLs(avatarID *string) map[string]string {
+	if avatarID == nil {
+		return nil
+	}
+	urls := make(map[string]string)
+	for _, variant := range []string{blob.VariantSmall, blob.VariantMedium, blob.VariantLarge} {
+		urls[variant] = BlobURL(*avatarID, variant)
+	}
+	return urls
+}
+
+// Blob 엔티티를 ImageResponse로 변환
+func FromBlobEntity(b *blob.Blob) *ImageResponse {
+	resp := ImageRef(b.ID)
+	resp.ContentType = b.ContentType
+	resp.Width = b.Width
+	resp.Height = b.Height
+	return resp
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"io"
+	"time"
+)
+
+// 업로드한 이미지 응답 (메시지 전송 시 id를 image_id로 전달)
+type ImageResponse struct {
+	ID           string `json:"id"`
+	URL          string `json:"url"`           // 저장한 이미지 (긴 변 제한, 메타데이터 제거)
+	ThumbnailURL string `json:"thumbnail_url"` // 미리보기용 작은 이미지
+	ContentType  string `json:"content_type,omitempty"`
+	Width        int    `json:"width,omitempty"`
+	Height       int    `json:"height,omitempty"`
+}
+
+// 프로필 사진 업로드 응답
+type AvatarResponse struct {
+	ProfilePic  string            `json:"profile_pic"`  // UserResponse.ProfilePic과 같은 URL (medium)
+	ProfilePics map[string]string `json:"profile_pics"` // 크기별 URL (small, medium, large)
+}
+
+// 저장된 파일 내용 (호출자가 Body를 닫아야 함)
+type BlobContent struct {
+	ID          string
+	Variant     string
+	ContentType string
+	Public      bool       // 누구나 볼 수 있는 파일 (프로필 사진, 공유 캐시 허용)
+	ExpiresAt   *time.Time // 이후 삭제되는 파일 (채팅 이미지, nil이면 계속 보관)
+	Body        io.ReadCloser
+	CreatedAt   time.Time
+}
@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"time"
+)
+
+// 접속 상태 응답
+type PresenceResponse struct {
+	UserID     uint      `json:"user_id"`
+	UserName   string    `json:"user_name"`
+	Status     string    `json:"status"` // "online", "away", "offline"
+	LastActive time.Time `json:"last_active"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+)
+
+// FromUserReportEntity - UserReport 엔티티를 응답 DTO로 변환
+func FromUserReportEntity(r *user.UserReport) *ReportResponse {
+	return &ReportResponse{
+		ID:          r.ID,
+		ReporterID:  r.ReporterID,
+		ReportedID:  r.ReportedID,
+		Reason:      string(r.Reason),
+		Description: r.Description,
+		MessageID:   r.MessageID,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// FromUserReportEntities - UserReport 엔티티 슬라이스를 응답 슬라이스로 변환
+func FromUserReportEntities(reports []*user.UserReport) []ReportResponse {
+	responses := make([]ReportResponse, len(reports))
+	for i, r := range reports {
+		responses[i] = *FromUserReportEntity(r)
+	}
+	return responses
+}
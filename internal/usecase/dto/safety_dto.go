@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+)
+
+// BlockedUserResponse - 차단한 사용자 정보
+type BlockedUserResponse struct {
+	User      UserResponse `json:"user"`
+	BlockedAt time.Time    `json:"blocked_at"`
+}
+
+// ReportUserRequest - 사용자 신고 요청 (기타 사유는 설명 필수)
+type ReportUserRequest struct {
+	Reason      string `json:"reason" binding:"required,oneof=spam harassment inappropriate scam fake_profile other"`
+	Description string `json:"description" binding:"max=1000"`
+	MessageID   *uint  `json:"message_id"` // 문제가 된 메시지 (선택, 신고 대상이 작성한 메시지만)
+}
+
+// ReportResponse - 신고 정보
+type ReportResponse struct {
+	ID          uint      `json:"id"`
+	ReporterID  uint      `json:"reporter_id"`
+	ReportedID  uint      `json:"reported_id"`
+	Reason      string    `json:"reason"`
+	Description string    `json:"description"`
+	MessageID   *uint     `json:"message_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ListReportsRequest - 관리자 신고 목록 요청 (최근 순, 페이징)
+type ListReportsRequest struct {
+	Page       int  `form:"page" binding:"omitempty,min=1"`          // 페이지 번호 (1부터 시작)
+	Limit      int  `form:"limit" binding:"omitempty,min=1,max=100"` // 페이지 크기
+	ReportedID uint `form:"reported_id"`                             // 신고 대상 필터 (0이면 전체)
+}
+
+// GetOffset - 페이징 계산 헬퍼
+func (req *ListReportsRequest) GetOffset() int {
+	return (req.Page - 1) * req.Limit
+}
+
+// ListReportsResponse - 관리자 신고 목록 응답
+type ListReportsResponse struct {
+	Reports    []ReportResponse `json:"reports"`
+	Page       int              `json:"page"`
+	Limit      int              `json:"limit"`
+	TotalCount int64            `json:"total_count"`
+	TotalPages int              `json:"total_pages"`
+}
@@ -1,21 +1,22 @@
 package dto
 
 import (
+	"github.com/chris910512/travel-chat/internal/domain/entity/blob"
 	"github.com/chris910512/travel-chat/internal/domain/entity/user"
-	"golang.org/x/crypto/bcrypt"
+	"github.com/chris910512/travel-chat/internal/pkg/auth"
 )
 
 // CreateUserRequest를 User 엔티티로 변환
 func (req *CreateUserRequest) ToEntity() (*user.User, error) {
 	// 비밀번호 해싱
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	hashedPassword, err := auth.HashPassword(req.Password)
 	if err != nil {
 		return nil, err
 	}
 
 	return &user.User{
 		Email:         req.Email,
-		Password:      string(hashedPassword),
+		Password:      hashedPassword,
 		Name:          req.Name,
 		Age:           req.Age,
 		Gender:        user.GenderFromString(req.Gender),
@@ -35,10 +36,12 @@ func FromUserEntity(u *user.User) *UserResponse {
 	return &UserResponse{
 		ID:             u.ID,
 		Email:          u.Email,
+		EmailVerified:  u.EmailVerified,
+		Role:           (&u.Role).String(),
 		Name:           u.Name,
 		Age:            u.Age,
 		Gender:         (&u.Gender).String(),
-		ProfilePic:     u.ProfilePic,
+		ProfilePic:     AvatarURL(u.AvatarID, blob.VariantMedium),
 		Country:        u.Country,
 		City:           u.City,
 		Destination:    u.GetDestination(),
@@ -74,9 +77,6 @@ func (req *UpdateUserRequest) ApplyToEntity(u *user.User) {
 	if req.Gender != nil {
 		u.Gender = user.GenderFromString(*req.Gender)
 	}
-	if req.ProfilePic != nil {
-		u.ProfilePic = *req.ProfilePic
-	}
 	if req.Country != nil {
 		u.Country = *req.Country
 	}
@@ -115,3 +115,20 @@ func CalculateTotalPages(totalCount int64, limit int) int {
 	}
 	return int((totalCount + int64(limit) - 1) / int64(limit))
 }
+
+// FromLoginHistories - 로그인 기록 엔티티 목록을 응답 DTO로 변환
+func FromLoginHistories(histories []*user.LoginHistory) []LoginHistoryResponse {
+	responses := make([]LoginHistoryResponse, len(histories))
+	for i, history := range histories {
+		responses[i] = LoginHistoryResponse{
+			ID:            history.ID,
+			IP:            history.IP,
+			UserAgent:     history.UserAgent,
+			Success:       history.Success,
+			FailureReason: string(history.FailureReason),
+			NewIP:         history.NewIP,
+			CreatedAt:     history.CreatedAt,
+		}
+	}
+	return responses
+}
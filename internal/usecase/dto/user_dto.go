@@ -25,16 +25,22 @@ type CreateUserRequest struct {
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email"`
 	Password string `json:"password" binding:"required"`
+
+	// 접속 정보 (핸들러가 채움, 로그인 기록용)
+	IP        string `json:"-"`
+	UserAgent string `json:"-"`
 }
 
 // 사용자 응답 (비밀번호 제외)
 type UserResponse struct {
 	ID             uint      `json:"id"`
 	Email          string    `json:"email"`
+	EmailVerified  bool      `json:"email_verified"`
+	Role           string    `json:"role"`
 	Name           string    `json:"name"`
 	Age            int       `json:"age"`
 	Gender         string    `json:"gender"`
-	ProfilePic     string    `json:"profile_pic"`
+	ProfilePic     string    `json:"profile_pic"` // 업로드한 프로필 사진 URL (medium, 없으면 빈 문자열)
 	Country        string    `json:"country"`
 	City           string    `json:"city"`
 	Destination    string    `json:"destination"` // "국가-도시" 형식
@@ -55,7 +61,6 @@ type UpdateUserRequest struct {
 	Name          *string    `json:"name,omitempty"`
 	Age           *int       `json:"age,omitempty"`
 	Gender        *string    `json:"gender,omitempty"`
-	ProfilePic    *string    `json:"profile_pic,omitempty"`
 	Country       *string    `json:"country,omitempty"`
 	City          *string    `json:"city,omitempty"`
 	TravelStart   *time.Time `json:"travel_start,omitempty"`
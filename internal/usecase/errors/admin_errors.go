@@ -0,0 +1,33 @@
+package errors
+
+import "errors"
+
+// 관리자 기능 관련 에러들
+var (
+	ErrInvalidRole          = errors.New("올바르지 않은 역할입니다 (user, moderator, admin)")
+	ErrInvalidUserStatus    = errors.New("올바르지 않은 사용자 상태입니다 (active, suspended, all)")
+	ErrUserAlreadySuspended = errors.New("이미 정지된 사용자입니다")
+	ErrUserNotSuspended     = errors.New("정지된 사용자가 아닙니다")
+	ErrPurgeFilterRequired  = errors.New("메시지를 삭제할 채팅방 또는 사용자를 지정해주세요")
+)
+
+// 에러 타입 체크 헬퍼 함수들
+func IsInvalidRole(err error) bool {
+	return errors.Is(err, ErrInvalidRole)
+}
+
+func IsInvalidUserStatus(err error) bool {
+	return errors.Is(err, ErrInvalidUserStatus)
+}
+
+func IsUserAlreadySuspended(err error) bool {
+	return errors.Is(err, ErrUserAlreadySuspended)
+}
+
+func IsUserNotSuspended(err error) bool {
+	return errors.Is(err, ErrUserNotSuspended)
+}
+
+func IsPurgeFilterRequired(err error) bool {
+	return errors.Is(err, ErrPurgeFilterRequired)
+}
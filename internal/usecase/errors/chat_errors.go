@@ -0,0 +1,62 @@
+package errors
+
+import "errors"
+
+// 채팅 관련 에러들
+var (
+	ErrChatRoomNotFound   = errors.New("채팅방을 찾을 수 없습니다")
+	ErrEmptyMessage       = errors.New("메시지 내용을 입력해주세요")
+	ErrMessageTooLong     = errors.New("메시지가 너무 깁니다")
+	ErrDestinationNotSet  = errors.New("여행 목적지(국가, 도시)를 먼저 설정해주세요")
+	ErrCannotChatWithSelf = errors.New("자기 자신과는 1:1 채팅을 할 수 없습니다")
+)
+
+// 메시지 검사(moderation)에서 거부된 경우의 에러들
+var (
+	ErrProfanityNotAllowed   = errors.New("부적절한 표현이 포함된 메시지는 보낼 수 없습니다")
+	ErrContactInfoNotAllowed = errors.New("전체 채팅방에는 연락처(전화번호, 이메일, 메신저 ID)를 올릴 수 없습니다")
+	ErrURLNotAllowed         = errors.New("허용되지 않은 링크가 포함되어 있습니다")
+	ErrMessageRejected       = errors.New("메시지를 보낼 수 없습니다")
+)
+
+// 에러 타입 체크 헬퍼 함수들
+func IsChatRoomNotFound(err error) bool {
+	return errors.Is(err, ErrChatRoomNotFound)
+}
+
+func IsEmptyMessage(err error) bool {
+	return errors.Is(err, ErrEmptyMessage)
+}
+
+func IsMessageTooLong(err error) bool {
+	return errors.Is(err, ErrMessageTooLong)
+}
+
+func IsDestinationNotSet(err error) bool {
+	return errors.Is(err, ErrDestinationNotSet)
+}
+
+func IsCannotChatWithSelf(err error) bool {
+	return errors.Is(err, ErrCannotChatWithSelf)
+}
+
+func IsProfanityNotAllowed(err error) bool {
+	return errors.Is(err, ErrProfanityNotAllowed)
+}
+
+func IsContactInfoNotAllowed(err error) bool {
+	return errors.Is(err, ErrContactInfoNotAllowed)
+}
+
+func IsURLNotAllowed(err error) bool {
+	return errors.Is(err, ErrURLNotAllowed)
+}
+
+// IsMessageRejected - 메시지 검사에서 거부되었는지 확인 (길이 초과 포함)
+func IsMessageRejected(err error) bool {
+	return errors.Is(err, ErrMessageRejected) ||
+		IsMessageTooLong(err) ||
+		IsProfanityNotAllowed(err) ||
+		IsContactInfoNotAllowed(err) ||
+		IsURLNotAllowed(err)
+}
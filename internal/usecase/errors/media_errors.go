@@ -0,0 +1,46 @@
+package errors
+
+import "errors"
+
+// 이미지 업로드 관련 에러들
+var (
+	ErrImageTooLarge          = errors.New("이미지 파일이 너무 큽니다")
+	ErrUnsupportedImageType   = errors.New("JPEG, PNG, GIF, WebP 이미지만 올릴 수 있습니다")
+	ErrImageDimensionTooLarge = errors.New("이미지 해상도가 너무 큽니다")
+	ErrInvalidImage           = errors.New("이미지 파일을 읽을 수 없습니다")
+	ErrImageNotFound          = errors.New("이미지를 찾을 수 없습니다")
+	ErrAvatarChanged          = errors.New("프로필 사진이 다른 요청으로 먼저 변경되었습니다. 다시 시도해주세요")
+)
+
+// 에러 타입 체크 헬퍼 함수들
+func IsImageTooLarge(err error) bool {
+	return errors.Is(err, ErrImageTooLarge)
+}
+
+func IsUnsupportedImageType(err error) bool {
+	return errors.Is(err, ErrUnsupportedImageType)
+}
+
+func IsImageDimensionTooLarge(err error) bool {
+	return errors.Is(err, ErrImageDimensionTooLarge)
+}
+
+func IsInvalidImage(err error) bool {
+	return errors.Is(err, ErrInvalidImage)
+}
+
+func IsImageNotFound(err error) bool {
+	return errors.Is(err, ErrImageNotFound)
+}
+
+func IsAvatarChanged(err error) bool {
+	return errors.Is(err, ErrAvatarChanged)
+}
+
+// IsImageRejected - 업로드한 파일이 크기/형식/해상도 검사를 통과하지 못했는지 확인
+func IsImageRejected(err error) bool {
+	return IsImageTooLarge(err) ||
+		IsUnsupportedImageType(err) ||
+		IsImageDimensionTooLarge(err) ||
+		IsInvalidImage(err)
+}
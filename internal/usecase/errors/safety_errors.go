@@ -0,0 +1,48 @@
+package errors
+
+import "errors"
+
+// 차단/신고 관련 에러들
+var (
+	ErrCannotBlockSelf          = errors.New("자기 자신은 차단할 수 없습니다")
+	ErrUserAlreadyBlocked       = errors.New("이미 차단한 사용자입니다")
+	ErrUserNotBlocked           = errors.New("차단하지 않은 사용자입니다")
+	ErrUserBlocked              = errors.New("차단 관계인 사용자와는 1:1 채팅을 할 수 없습니다")
+	ErrCannotReportSelf         = errors.New("자기 자신은 신고할 수 없습니다")
+	ErrInvalidReportReason      = errors.New("올바르지 않은 신고 사유입니다 (spam, harassment, inappropriate, scam, fake_profile, other)")
+	ErrInvalidReportDescription = errors.New("신고 내용은 1000자 이내로 입력해주세요 (기타 사유는 필수)")
+	ErrMessageNotFound          = errors.New("메시지를 찾을 수 없습니다")
+)
+
+// 에러 타입 체크 헬퍼 함수들
+func IsCannotBlockSelf(err error) bool {
+	return errors.Is(err, ErrCannotBlockSelf)
+}
+
+func IsUserAlreadyBlocked(err error) bool {
+	return errors.Is(err, ErrUserAlreadyBlocked)
+}
+
+func IsUserNotBlocked(err error) bool {
+	return errors.Is(err, ErrUserNotBlocked)
+}
+
+func IsUserBlocked(err error) bool {
+	return errors.Is(err, ErrUserBlocked)
+}
+
+func IsCannotReportSelf(err error) bool {
+	return errors.Is(err, ErrCannotReportSelf)
+}
+
+func IsInvalidReportReason(err error) bool {
+	return errors.Is(err, ErrInvalidReportReason)
+}
+
+func IsInvalidReportDescription(err error) bool {
+	return errors.Is(err, ErrInvalidReportDescription)
+}
+
+func IsMessageNotFound(err error) bool {
+	return errors.Is(err, ErrMessageNotFound)
+}
@@ -1,18 +1,48 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // 사용자 관련 에러들
 var (
-	ErrUserNotFound       = errors.New("사용자를 찾을 수 없습니다")
-	ErrEmailAlreadyExists = errors.New("이미 사용 중인 이메일입니다")
-	ErrInvalidCredentials = errors.New("이메일 또는 비밀번호가 올바르지 않습니다")
-	ErrWeakPassword       = errors.New("비밀번호는 최소 6자 이상이어야 합니다")
-	ErrInvalidEmail       = errors.New("올바르지 않은 이메일 형식입니다")
-	ErrInvalidTravelDates = errors.New("여행 시작일은 종료일보다 빨라야 합니다")
-	ErrPastTravelDate     = errors.New("여행 시작일은 현재 날짜 이후여야 합니다")
-	ErrUnauthorized       = errors.New("인증이 필요합니다")
-	ErrForbidden          = errors.New("접근 권한이 없습니다")
+	ErrUserNotFound         = errors.New("사용자를 찾을 수 없습니다")
+	ErrEmailAlreadyExists   = errors.New("이미 사용 중인 이메일입니다")
+	ErrInvalidCredentials   = errors.New("이메일 또는 비밀번호가 올바르지 않습니다")
+	ErrWeakPassword         = errors.New("비밀번호는 최소 6자 이상이어야 합니다")
+	ErrInvalidEmail         = errors.New("올바르지 않은 이메일 형식입니다")
+	ErrInvalidTravelDates   = errors.New("여행 시작일은 종료일보다 빨라야 합니다")
+	ErrPastTravelDate       = errors.New("여행 시작일은 현재 날짜 이후여야 합니다")
+	ErrUnauthorized         = errors.New("인증이 필요합니다")
+	ErrForbidden            = errors.New("접근 권한이 없습니다")
+	ErrAccountLocked        = errors.New("로그인 시도가 너무 많아 계정이 잠겼습니다")
+	ErrIncorrectPassword    = errors.New("현재 비밀번호가 올바르지 않습니다")
+	ErrSamePassword         = errors.New("새 비밀번호는 현재 비밀번호와 달라야 합니다")
+	ErrEmailNotVerified     = errors.New("이메일 인증을 완료해야 이용할 수 있습니다")
+	ErrEmailAlreadyVerified = errors.New("이미 인증된 이메일입니다")
+)
+
+// AccountLockedError - 잠금 해제 시각을 포함한 ErrAccountLocked
+type AccountLockedError struct {
+	Until time.Time
+}
+
+func (e *AccountLockedError) Error() string {
+	return fmt.Sprintf("%s. %s 이후 다시 시도해주세요", ErrAccountLocked.Error(), e.Until.Format(time.RFC3339))
+}
+
+func (e *AccountLockedError) Unwrap() error {
+	return ErrAccountLocked
+}
+
+// 토큰 관련 에러들
+var (
+	ErrInvalidRefreshToken      = errors.New("리프레시 토큰이 유효하지 않거나 만료되었습니다")
+	ErrRefreshTokenReused       = errors.New("이미 사용된 리프레시 토큰입니다. 보안을 위해 해당 세션이 로그아웃되었습니다")
+	ErrInvalidResetToken        = errors.New("비밀번호 재설정 링크가 유효하지 않거나 만료되었습니다")
+	ErrInvalidVerificationToken = errors.New("이메일 인증 링크가 유효하지 않거나 만료되었습니다")
 )
 
 // 에러 타입 체크 헬퍼 함수들
@@ -51,3 +81,48 @@ func IsUnauthorized(err error) bool {
 func IsForbidden(err error) bool {
 	return errors.Is(err, ErrForbidden)
 }
+
+func IsAccountLocked(err error) bool {
+	return errors.Is(err, ErrAccountLocked)
+}
+
+// LockedUntil - 계정 잠금 에러의 잠금 해제 시각
+func LockedUntil(err error) (time.Time, bool) {
+	var lockedErr *AccountLockedError
+	if errors.As(err, &lockedErr) {
+		return lockedErr.Until, true
+	}
+	return time.Time{}, false
+}
+
+func IsIncorrectPassword(err error) bool {
+	return errors.Is(err, ErrIncorrectPassword)
+}
+
+func IsSamePassword(err error) bool {
+	return errors.Is(err, ErrSamePassword)
+}
+
+func IsEmailNotVerified(err error) bool {
+	return errors.Is(err, ErrEmailNotVerified)
+}
+
+func IsEmailAlreadyVerified(err error) bool {
+	return errors.Is(err, ErrEmailAlreadyVerified)
+}
+
+func IsInvalidRefreshToken(err error) bool {
+	return errors.Is(err, ErrInvalidRefreshToken)
+}
+
+func IsRefreshTokenReused(err error) bool {
+	return errors.Is(err, ErrRefreshTokenReused)
+}
+
+func IsInvalidResetToken(err error) bool {
+	return errors.Is(err, ErrInvalidResetToken)
+}
+
+func IsInvalidVerificationToken(err error) bool {
+	return errors.Is(err, ErrInvalidVerificationToken)
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// AdminUsecase 인터페이스 정의 (운영자/관리자 전용, 요청자의 역할은 저장된 사용자 정보로 다시 확인)
+type AdminUsecase interface {
+	// 사용자 관리
+	ListUsers(ctx context.Context, actorID uint, req *dto.AdminListUsersRequest) (*dto.AdminListUsersResponse, error)
+	SuspendUser(ctx context.Context, actorID, userID uint) (*dto.AdminUserResponse, error)
+	RestoreUser(ctx context.Context, actorID, userID uint) (*dto.AdminUserResponse, error)
+	UpdateUserRole(ctx context.Context, actorID, userID uint, req *dto.UpdateRoleRequest) (*dto.AdminUserResponse, error)
+
+	// 채팅 관리
+	DeleteRoom(ctx context.Context, actorID, roomID uint) error
+	PurgeMessages(ctx context.Context, actorID uint, req *dto.PurgeMessagesRequest) (*dto.PurgeMessagesResponse, error)
+
+	// 신고 관리
+	ListReports(ctx context.Context, actorID uint, req *dto.ListReportsRequest) (*dto.ListReportsResponse, error)
+}
+
+// RoomCloser - 삭제된 채팅방의 실시간 연결(WebSocket 등)을 끊는 인터페이스
+type RoomCloser interface {
+	CloseRoom(roomID uint)
+}
+
+// UserDisconnector - 정지된 사용자의 실시간 연결(WebSocket 등)을 끊는 인터페이스
+type UserDisconnector interface {
+	DisconnectUser(userID uint)
+}
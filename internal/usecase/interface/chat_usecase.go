@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// ChatUsecase 인터페이스 정의
+type ChatUsecase interface {
+	// 메시지
+	SendMessage(ctx context.Context, userID, roomID uint, req *dto.SendMessageRequest) (*dto.MessageResponse, error)
+	GetHistory(ctx context.Context, userID, roomID uint, req *dto.GetHistoryRequest) (*dto.MessageHistoryResponse, error)
+
+	// 채팅방
+	JoinPublicRoom(ctx context.Context, userID uint) (*dto.ChatRoomResponse, error)
+	GetRoom(ctx context.Context, userID, roomID uint) (*dto.ChatRoomResponse, error)
+	OpenPrivateRoom(ctx context.Context, userID, targetUserID uint) (*dto.ChatRoomResponse, error)
+	ListRooms(ctx context.Context, userID uint) ([]dto.ChatRoomResponse, error)
+
+	// 차단 (실시간 연결에서 메시지를 숨길 사용자)
+	GetBlockedUserIDs(ctx context.Context, userID uint) ([]uint, error)
+}
+
+// MessagePublisher - 저장된 메시지를 실시간 연결(WebSocket 등)로 전달하는 인터페이스
+type MessagePublisher interface {
+	PublishMessage(roomID uint, msg *dto.MessageResponse)
+}
@@ -0,0 +1,12 @@
+package usecase
+
+import (
+	"context"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// MatchUsecase 인터페이스 정의
+type MatchUsecase interface {
+	// 동행 추천
+	GetMatches(ctx context.Context, userID uint, req *dto.GetMatchesRequest) (*dto.GetMatchesResponse, error)
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// MediaUsecase 인터페이스 정의 (채팅 이미지/프로필 사진 업로드와 저장된 파일 제공)
+type MediaUsecase interface {
+	// 업로드 (r은 최대 크기까지만 읽음)
+	UploadImage(ctx context.Context, userID uint, r io.Reader) (*dto.ImageResponse, error)
+
+	// 프로필 사진 (새 사진을 올리면 이전 사진은 삭제)
+	UploadAvatar(ctx context.Context, userID uint, r io.Reader) (*dto.AvatarResponse, error)
+	DeleteAvatar(ctx context.Context, userID uint) error
+
+	// 조회 (없거나 만료되었으면 ErrImageNotFound)
+	GetBlob(ctx context.Context, id, variant string) (*dto.BlobContent, error)
+
+	// 정리 (만료된 파일을 저장소와 함께 최대 limit개 삭제하고 삭제한 개수 반환)
+	PurgeExpired(ctx context.Context, before time.Time, limit int) (int, error)
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// PresenceUsecase 인터페이스 정의
+type PresenceUsecase interface {
+	// 실시간 연결 및 활동
+	Connect(ctx context.Context, userID uint) error // 이메일 미인증 사용자는 ErrEmailNotVerified
+	Disconnect(ctx context.Context, userID uint)
+	RecordActivity(ctx context.Context, userID uint)  // Connect한 연결에서 받은 요청 (사용자를 다시 조회하지 않음)
+	Heartbeat(ctx context.Context, userID uint) error // 실시간 연결 없이 보내는 활동 알림, 이메일 미인증 사용자는 ErrEmailNotVerified
+
+	// 접속 상태 조회
+	GetStatus(ctx context.Context, userID uint) (*dto.PresenceResponse, error)
+	GetActiveUsers(ctx context.Context) ([]dto.UserResponse, error)
+
+	// 시간 경과에 따른 상태 변경 알림 및 활동 시간 DB 반영 (주기적으로 호출)
+	Sweep(ctx context.Context) error
+}
+
+// PresencePublisher - 접속 상태 변경을 채팅방 실시간 연결로 전달하는 인터페이스
+type PresencePublisher interface {
+	PublishPresence(roomIDs []uint, presence *dto.PresenceResponse)
+}
@@ -0,0 +1,22 @@
+package usecase
+
+import (
+	"context"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+// SafetyUsecase 인터페이스 정의 (사용자 차단/신고)
+type SafetyUsecase interface {
+	// 차단
+	BlockUser(ctx context.Context, userID, targetUserID uint) error
+	UnblockUser(ctx context.Context, userID, targetUserID uint) error
+	ListBlockedUsers(ctx context.Context, userID uint) ([]dto.BlockedUserResponse, error)
+
+	// 신고
+	ReportUser(ctx context.Context, userID, targetUserID uint, req *dto.ReportUserRequest) (*dto.ReportResponse, error)
+}
+
+// BlockPublisher - 차단 변경을 실시간 연결(WebSocket 등)에 반영하는 인터페이스
+type BlockPublisher interface {
+	PublishBlock(blockerID, blockedID uint, blocked bool)
+}
@@ -11,17 +11,29 @@ type UserUsecase interface {
 	Register(ctx context.Context, req *dto.CreateUserRequest) (*dto.UserResponse, error)
 	Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error)
 	RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error)
+	Logout(ctx context.Context, userID uint, req *dto.LogoutRequest) error
+	LogoutAll(ctx context.Context, userID uint) error
+	GetLoginHistory(ctx context.Context, userID uint, req *dto.GetLoginHistoryRequest) (*dto.GetLoginHistoryResponse, error)
+
+	// 비밀번호 관리
+	ChangePassword(ctx context.Context, userID uint, req *dto.ChangePasswordRequest) error
+	ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error
+
+	// 이메일 인증
+	ResendVerification(ctx context.Context, userID uint) error
+	VerifyEmail(ctx context.Context, req *dto.VerifyEmailRequest) (*dto.UserResponse, error)
 
 	// 사용자 조회
 	GetByID(ctx context.Context, id uint) (*dto.UserResponse, error)
 	GetByEmail(ctx context.Context, email string) (*dto.UserResponse, error)
-	GetUsers(ctx context.Context, req *dto.GetUsersRequest) (*dto.GetUsersResponse, error)
-	GetUsersByDestination(ctx context.Context, country, city string) ([]dto.UserResponse, error)
+	GetUsers(ctx context.Context, viewerID uint, req *dto.GetUsersRequest) (*dto.GetUsersResponse, error) // viewerID와 차단 관계인 사용자 제외 (0이면 비로그인)
+	GetUsersByDestination(ctx context.Context, viewerID uint, country, city string) ([]dto.UserResponse, error)
 
-	// 사용자 관리
-	UpdateProfile(ctx context.Context, userID uint, req *dto.UpdateUserRequest) (*dto.UserResponse, error)
-	UpdateLastActive(ctx context.Context, userID uint) error
-	DeleteUser(ctx context.Context, userID uint) error
+	// 사용자 관리 (actorID: 요청한 사용자, 본인 또는 관리자만 가능)
+	UpdateProfile(ctx context.Context, actorID, userID uint, req *dto.UpdateUserRequest) (*dto.UserResponse, error)
+	UpdateLastActive(ctx context.Context, actorID, userID uint) error
+	DeleteUser(ctx context.Context, actorID, userID uint) error
 
 	// 유틸리티
 	ValidateUserExists(ctx context.Context, userID uint) error
@@ -0,0 +1,288 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/shared"
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	"github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"gorm.io/gorm"
+)
+
+// 항목별 가중치 (합계 1)
+const (
+	weightDestination = 0.20
+	weightDateOverlap = 0.35
+	weightPurpose     = 0.20
+	weightStyle       = 0.15
+	weightBudget      = 0.10
+)
+
+const (
+	// 같은 국가의 다른 도시로 여행하는 경우의 목적지 점수
+	sameCountryScore = 0.4
+
+	// 동행 추천 기본/최대 페이지 크기
+	defaultMatchLimit = 10
+	maxMatchLimit     = 100
+)
+
+// 함께 여행하기 좋은 여행 목적 조합 (같은 목적은 항상 1점)
+var compatiblePurposes = map[[2]user.TravelPurpose]float64{
+	{user.TravelPurposeTourism, user.TravelPurposeCulture}:        0.7,
+	{user.TravelPurposeTourism, user.TravelPurposeFoodTour}:       0.7,
+	{user.TravelPurposeCulture, user.TravelPurposeFoodTour}:       0.6,
+	{user.TravelPurposeBackpacking, user.TravelPurposeActivity}:   0.7,
+	{user.TravelPurposeBackpacking, user.TravelPurposeTourism}:    0.5,
+	{user.TravelPurposeActivity, user.TravelPurposeTourism}:       0.5,
+	{user.TravelPurposeRelaxation, user.TravelPurposeFoodTour}:    0.5,
+	{user.TravelPurposeRelaxation, user.TravelPurposeCulture}:     0.4,
+	{user.TravelPurposeBusiness, user.TravelPurposeFoodTour}:      0.3,
+	{user.TravelPurposeRelaxation, user.TravelPurposeActivity}:    0.1,
+	{user.TravelPurposeBusiness, user.TravelPurposeBackpacking}:   0.1,
+	{user.TravelPurposeRelaxation, user.TravelPurposeBackpacking}: 0.2,
+	{user.TravelPurposeBusiness, user.TravelPurposeActivity}:      0.1,
+}
+
+// 여행 스타일 조합 점수 (같은 스타일은 항상 1점)
+var compatibleStyles = map[[2]user.TravelStyle]float64{
+	{user.TravelStyleSpontaneous, user.TravelStyleAdventure}: 0.8,
+	{user.TravelStyleBudget, user.TravelStyleAdventure}:      0.6,
+	{user.TravelStyleBudget, user.TravelStyleSpontaneous}:    0.5,
+	{user.TravelStyleLuxury, user.TravelStyleLeisurely}:      0.7,
+	{user.TravelStylePlanned, user.TravelStyleLeisurely}:     0.6,
+	{user.TravelStylePlanned, user.TravelStyleLuxury}:        0.5,
+	{user.TravelStylePlanned, user.TravelStyleSpontaneous}:   0.2,
+	{user.TravelStyleLuxury, user.TravelStyleBudget}:         0.0,
+	{user.TravelStyleLeisurely, user.TravelStyleAdventure}:   0.2,
+}
+
+// 목록에 없는 조합의 기본 점수
+const (
+	defaultPurposeScore = 0.3
+	defaultStyleScore   = 0.3
+)
+
+type matchUsecase struct {
+	userRepo  repository.UserRepository
+	blockRepo repository.UserBlockRepository
+}
+
+// NewMatchUsecase - Match Usecase 생성자
+func NewMatchUsecase(userRepo repository.UserRepository, blockRepo repository.UserBlockRepository) usecaseInterface.MatchUsecase {
+	return &matchUsecase{
+		userRepo:  userRepo,
+		blockRepo: blockRepo,
+	}
+}
+
+// GetMatches - 같은 국가로 여행하는 사용자 중 여행 기간이 겹치는 동행 추천 (점수 높은 순)
+func (u *matchUsecase) GetMatches(ctx context.Context, userID uint, req *dto.GetMatchesRequest) (*dto.GetMatchesResponse, error) {
+	ctx, span := tracing.Start(ctx, "MatchUsecase.GetMatches")
+	defer span.End()
+
+	// 기본값 설정
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultMatchLimit
+	}
+	if req.Limit > maxMatchLimit {
+		req.Limit = maxMatchLimit
+	}
+
+	// 1. 요청자 조회 및 목적지 확인
+	requester, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	if !shared.ValidateDestination(requester.Country, requester.City) {
+		return nil, errors.ErrDestinationNotSet
+	}
+
+	// 2. 같은 국가로 여행하면서 여행 기간이 겹치는 후보 조회 (본인, 차단 관계인 사용자, 이미 끝난 여행 제외)
+	excludeIDs, err := relatedBlockIDs(ctx, u.blockRepo, requester.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	country, _ := shared.NormalizeDestination(requester.Country, requester.City)
+	candidates, err := u.userRepo.GetByTrip(ctx, repository.TripFilter{
+		Country:   country,
+		Start:     requester.TravelStart,
+		End:       requester.TravelEnd,
+		EndsAfter: time.Now(),
+	}, append(excludeIDs, requester.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 후보별 점수 계산
+	matches := make([]dto.MatchResponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		breakdown, ok := scoreMatch(requester, candidate)
+		if !ok {
+			continue
+		}
+
+		matches = append(matches, dto.MatchResponse{
+			User:      *dto.FromUserEntity(candidate),
+			Score:     totalMatchScore(breakdown),
+			Breakdown: breakdown,
+		})
+	}
+
+	// 4. 점수 높은 순 정렬 (동점이면 겹치는 일수가 긴 순)
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		if matches[i].Breakdown.OverlapDays != matches[j].Breakdown.OverlapDays {
+			return matches[i].Breakdown.OverlapDays > matches[j].Breakdown.OverlapDays
+		}
+		return matches[i].User.ID < matches[j].User.ID
+	})
+
+	// 5. 메모리에서 페이징
+	totalCount := int64(len(matches))
+	offset := req.GetOffset()
+	end := offset + req.Limit
+	if offset >= len(matches) {
+		matches = []dto.MatchResponse{}
+	} else {
+		if end > len(matches) {
+			end = len(matches)
+		}
+		matches = matches[offset:end]
+	}
+
+	return &dto.GetMatchesResponse{
+		Matches:    matches,
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalCount: totalCount,
+		TotalPages: dto.CalculateTotalPages(totalCount, req.Limit),
+	}, nil
+}
+
+// 비공개 헬퍼 함수들
+
+// scoreMatch - 항목별 점수 계산 (여행 기간이 겹치지 않으면 false)
+func scoreMatch(requester, candidate *user.User) (dto.MatchScoreBreakdown, bool) {
+	dateScore, overlapDays := dateOverlapScore(requester, candidate)
+	if overlapDays == 0 {
+		return dto.MatchScoreBreakdown{}, false
+	}
+
+	return dto.MatchScoreBreakdown{
+		Destination: destinationScore(requester, candidate),
+		DateOverlap: dateScore,
+		Purpose:     purposeScore(requester.TravelPurpose, candidate.TravelPurpose),
+		Style:       styleScore(requester.TravelStyle, candidate.TravelStyle),
+		Budget:      budgetScore(requester.TravelBudget, candidate.TravelBudget),
+		OverlapDays: overlapDays,
+	}, true
+}
+
+// totalMatchScore - 가중 합산 점수 (0~100, 소수점 첫째 자리까지)
+func totalMatchScore(b dto.MatchScoreBreakdown) float64 {
+	total := b.Destination*weightDestination +
+		b.DateOverlap*weightDateOverlap +
+		b.Purpose*weightPurpose +
+		b.Style*weightStyle +
+		b.Budget*weightBudget
+	return math.Round(total*1000) / 10
+}
+
+// destinationScore - 같은 도시면 1, 같은 국가의 다른 도시면 일부 점수
+func destinationScore(a, b *user.User) float64 {
+	_, cityA := shared.NormalizeDestination(a.Country, a.City)
+	_, cityB := shared.NormalizeDestination(b.Country, b.City)
+	if strings.EqualFold(cityA, cityB) {
+		return 1
+	}
+	return sameCountryScore
+}
+
+// dateOverlapScore - 겹치는 기간이 더 짧은 여행 기간에서 차지하는 비율과 겹치는 일수
+func dateOverlapScore(a, b *user.User) (float64, int) {
+	start := a.TravelStart
+	if b.TravelStart.After(start) {
+		start = b.TravelStart
+	}
+	end := a.TravelEnd
+	if b.TravelEnd.Before(end) {
+		end = b.TravelEnd
+	}
+
+	overlap := end.Sub(start)
+	if overlap <= 0 {
+		return 0, 0
+	}
+
+	shorter := a.TravelEnd.Sub(a.TravelStart)
+	if d := b.TravelEnd.Sub(b.TravelStart); d < shorter {
+		shorter = d
+	}
+
+	days := int(math.Ceil(overlap.Hours() / 24))
+	if shorter <= 0 {
+		return 1, days
+	}
+	return roundScore(math.Min(1, overlap.Hours()/shorter.Hours())), days
+}
+
+// purposeScore - 여행 목적 호환성
+func purposeScore(a, b user.TravelPurpose) float64 {
+	if a == b {
+		return 1
+	}
+	if score, ok := compatiblePurposes[[2]user.TravelPurpose{a, b}]; ok {
+		return score
+	}
+	if score, ok := compatiblePurposes[[2]user.TravelPurpose{b, a}]; ok {
+		return score
+	}
+	return defaultPurposeScore
+}
+
+// styleScore - 여행 스타일 호환성
+func styleScore(a, b user.TravelStyle) float64 {
+	if a == b {
+		return 1
+	}
+	if score, ok := compatibleStyles[[2]user.TravelStyle{a, b}]; ok {
+		return score
+	}
+	if score, ok := compatibleStyles[[2]user.TravelStyle{b, a}]; ok {
+		return score
+	}
+	return defaultStyleScore
+}
+
+// budgetScore - 예산 근접도 (차이가 작을수록 1에 가까움, 예산 미입력 시 중간값)
+func budgetScore(a, b int) float64 {
+	if a <= 0 || b <= 0 {
+		return 0.5
+	}
+	diff := math.Abs(float64(a - b))
+	return roundScore(1 - diff/math.Max(float64(a), float64(b)))
+}
+
+// roundScore - 항목별 점수를 소수점 둘째 자리까지 반올림
+func roundScore(score float64) float64 {
+	return math.Round(score*100) / 100
+}
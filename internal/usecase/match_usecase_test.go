@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+)
+
+const day = 24 * time.Hour
+
+// traveler - base 기준 startDay~endDay 동안 city로 여행하는 사용자
+func traveler(base time.Time, city string, startDay, endDay float64) *user.User {
+	return &user.User{
+		Country:     "일본",
+		City:        city,
+		TravelStart: base.Add(time.Duration(startDay * float64(day))),
+		TravelEnd:   base.Add(time.Duration(endDay * float64(day))),
+	}
+}
+
+func TestDateOverlapScore(t *testing.T) {
+	base := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name      string
+		a, b      [2]float64
+		wantScore float64
+		wantDays  int
+	}{
+		{"no overlap", [2]float64{0, 5}, [2]float64{6, 10}, 0, 0},
+		{"touching", [2]float64{0, 5}, [2]float64{5, 10}, 0, 0},
+		{"partial relative to shorter trip", [2]float64{0, 10}, [2]float64{8, 12}, 0.5, 2},
+		{"contained", [2]float64{0, 10}, [2]float64{2, 5}, 1, 3},
+		{"rounded ratio", [2]float64{0, 3}, [2]float64{2, 5}, 0.33, 1},
+		{"partial day counts as a day", [2]float64{0, 2}, [2]float64{1.5, 4}, 0.25, 1},
+		{"zero-length trip", [2]float64{3, 3}, [2]float64{0, 10}, 0, 0},
+		{"both zero-length", [2]float64{3, 3}, [2]float64{3, 3}, 0, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := traveler(base, "도쿄", tc.a[0], tc.a[1])
+			b := traveler(base, "도쿄", tc.b[0], tc.b[1])
+			for _, pair := range [][2]*user.User{{a, b}, {b, a}} {
+				score, days := dateOverlapScore(pair[0], pair[1])
+				if score != tc.wantScore || days != tc.wantDays {
+					t.Errorf("dateOverlapScore = %v, %d; want %v, %d", score, days, tc.wantScore, tc.wantDays)
+				}
+				if _, ok := scoreMatch(pair[0], pair[1]); ok != (tc.wantDays > 0) {
+					t.Errorf("scoreMatch ok = %v; want %v", ok, tc.wantDays > 0)
+				}
+			}
+		})
+	}
+}
+
+func TestDestinationScore(t *testing.T) {
+	base := time.Now()
+	tokyo := traveler(base, "도쿄", 0, 1)
+	cases := map[string]float64{
+		"도쿄":   1,
+		" 도쿄 ": 1,
+		"오사카":  sameCountryScore,
+	}
+	for city, want := range cases {
+		if got := destinationScore(tokyo, traveler(base, city, 0, 1)); got != want {
+			t.Errorf("destinationScore(도쿄, %q) = %v; want %v", city, got, want)
+		}
+	}
+	if got := destinationScore(&user.User{City: "Tokyo"}, &user.User{City: "tokyo"}); got != 1 {
+		t.Errorf("expected city comparison to ignore case, got %v", got)
+	}
+}
+
+func TestPurposeAndStyleScore(t *testing.T) {
+	purposes := []struct {
+		a, b user.TravelPurpose
+		want float64
+	}{
+		{user.TravelPurposeCulture, user.TravelPurposeCulture, 1},
+		{user.TravelPurposeTourism, user.TravelPurposeCulture, 0.7},
+		{user.TravelPurposeCulture, user.TravelPurposeTourism, 0.7},
+		{user.TravelPurposeActivity, user.TravelPurposeRelaxation, 0.1},
+		{user.TravelPurposeBusiness, user.TravelPurposeRelaxation, defaultPurposeScore},
+	}
+	for _, tc := range purposes {
+		if got := purposeScore(tc.a, tc.b); got != tc.want {
+			t.Errorf("purposeScore(%d, %d) = %v; want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+
+	styles := []struct {
+		a, b user.TravelStyle
+		want float64
+	}{
+		{user.TravelStyleBudget, user.TravelStyleBudget, 1},
+		{user.TravelStyleSpontaneous, user.TravelStyleAdventure, 0.8},
+		{user.TravelStyleAdventure, user.TravelStyleSpontaneous, 0.8},
+		// 0점 조합도 기본 점수로 바뀌면 안 됨
+		{user.TravelStyleLuxury, user.TravelStyleBudget, 0},
+		{user.TravelStyleBudget, user.TravelStyleLuxury, 0},
+		{user.TravelStyleLuxury, user.TravelStyleAdventure, defaultStyleScore},
+	}
+	for _, tc := range styles {
+		if got := styleScore(tc.a, tc.b); got != tc.want {
+			t.Errorf("styleScore(%d, %d) = %v; want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestBudgetScore(t *testing.T) {
+	cases := []struct {
+		a, b int
+		want float64
+	}{
+		{0, 0, 0.5},
+		{1000, 0, 0.5},
+		{0, 1000, 0.5},
+		{1000, 1000, 1},
+		{100, 50, 0.5},
+		{200, 300, 0.67},
+		{300, 200, 0.67},
+	}
+	for _, tc := range cases {
+		if got := budgetScore(tc.a, tc.b); got != tc.want {
+			t.Errorf("budgetScore(%d, %d) = %v; want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestTotalMatchScore(t *testing.T) {
+	cases := []struct {
+		breakdown dto.MatchScoreBreakdown
+		want      float64
+	}{
+		{dto.MatchScoreBreakdown{Destination: 1, DateOverlap: 1, Purpose: 1, Style: 1, Budget: 1}, 100},
+		{dto.MatchScoreBreakdown{Destination: 0.4, DateOverlap: 0.5, Purpose: 0.7, Style: 0, Budget: 0.5}, 44.5},
+		{dto.MatchScoreBreakdown{}, 0},
+	}
+	for _, tc := range cases {
+		if got := totalMatchScore(tc.breakdown); got != tc.want {
+			t.Errorf("totalMatchScore(%+v) = %v; want %v", tc.breakdown, got, tc.want)
+		}
+	}
+}
+
+func TestGetMatchesOrdering(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	uc := NewMatchUsecase(userRepo, memory.NewUserBlockRepository())
+	base := time.Now().Add(day)
+
+	create := func(email, country, city string, startDay, endDay float64, budget int, verified bool) *user.User {
+		t.Helper()
+		u := traveler(base, city, startDay, endDay)
+		u.Email = email
+		u.Password = "hashed-password"
+		u.Name = email
+		u.Country = country
+		u.TravelBudget = budget
+		u.EmailVerified = verified
+		if err := userRepo.Create(ctx, u); err != nil {
+			t.Fatalf("Create(%s): %v", email, err)
+		}
+		return u
+	}
+
+	requester := create("requester@example.com", "일본", "도쿄", 0, 10, 1000, true)
+	short := create("short@example.com", "일본", "도쿄", 2, 4, 1000, true)
+	osaka := create("osaka@example.com", "일본", "오사카", 0, 10, 1000, true)
+	tieFirst := create("tie-first@example.com", "일본", "도쿄", 0, 10, 500, true)
+	full := create("full@example.com", "일본", "도쿄", 0, 10, 1000, true)
+	tieSecond := create("tie-second@example.com", "일본", "도쿄", 0, 10, 500, true)
+	long := create("long@example.com", "일본", "도쿄", 2, 8, 1000, true)
+	create("later@example.com", "일본", "도쿄", 11, 15, 1000, true)
+	create("paris@example.com", "프랑스", "파리", 0, 10, 1000, true)
+	create("unverified@example.com", "일본", "도쿄", 0, 10, 1000, false)
+
+	// 점수 높은 순, 동점이면 겹치는 일수가 긴 순, 그래도 같으면 ID 순
+	want := []uint{full.ID, long.ID, short.ID, tieFirst.ID, tieSecond.ID, osaka.ID}
+	resp, err := uc.GetMatches(ctx, requester.ID, &dto.GetMatchesRequest{Limit: maxMatchLimit})
+	if err != nil {
+		t.Fatalf("GetMatches: %v", err)
+	}
+	if resp.TotalCount != int64(len(want)) || len(resp.Matches) != len(want) {
+		t.Fatalf("expected %d matches, got %d of %d: %+v", len(want), len(resp.Matches), resp.TotalCount, resp.Matches)
+	}
+	for i, match := range resp.Matches {
+		if match.User.ID != want[i] {
+			t.Errorf("match %d: expected user %d, got %d (score %v, %+v)", i, want[i], match.User.ID, match.Score, match.Breakdown)
+		}
+	}
+	if resp.Matches[0].Score != 100 || resp.Matches[3].Score != resp.Matches[4].Score || resp.Matches[5].Score != 88 {
+		t.Errorf("unexpected scores %+v", resp.Matches)
+	}
+
+	page, err := uc.GetMatches(ctx, requester.ID, &dto.GetMatchesRequest{Page: 2, Limit: 4})
+	if err != nil {
+		t.Fatalf("GetMatches: %v", err)
+	}
+	if len(page.Matches) != 2 || page.Matches[0].User.ID != tieSecond.ID || page.TotalPages != 2 {
+		t.Errorf("expected second page with the last 2 matches, got %+v", page)
+	}
+}
@@ -0,0 +1,369 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/blob"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/imaging"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/storage"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	"github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"gorm.io/gorm"
+)
+
+// MediaConfig - 이미지 업로드 설정
+type MediaConfig struct {
+	UploadTTL time.Duration // 메시지에 첨부하지 않은 업로드의 보관 기간 (첨부하면 메시지 만료 시간까지 연장)
+}
+
+// DefaultMediaConfig - 기본 설정 (첨부하지 않은 업로드는 1시간 보관)
+func DefaultMediaConfig() MediaConfig {
+	return MediaConfig{
+		UploadTTL: time.Hour,
+	}
+}
+
+// avatarSizes - 프로필 사진 크기별 한 변 픽셀 (작은 크기부터)
+var avatarSizes = []struct {
+	variant string
+	size    int
+}{
+	{blob.VariantSmall, 64},
+	{blob.VariantMedium, 256},
+	{blob.VariantLarge, 512},
+}
+
+type mediaUsecase struct {
+	userRepo  repository.UserRepository
+	blobRepo  repository.BlobRepository
+	store     storage.Store
+	processor *imaging.Processor
+	config    MediaConfig
+}
+
+// NewMediaUsecase - Media Usecase 생성자 (설정 값이 0 이하이면 기본값)
+func NewMediaUsecase(
+	userRepo repository.UserRepository,
+	blobRepo repository.BlobRepository,
+	store storage.Store,
+	processor *imaging.Processor,
+	config MediaConfig,
+) usecaseInterface.MediaUsecase {
+	if config.UploadTTL <= 0 {
+		config.UploadTTL = DefaultMediaConfig().UploadTTL
+	}
+
+	return &mediaUsecase{
+		userRepo:  userRepo,
+		blobRepo:  blobRepo,
+		store:     store,
+		processor: processor,
+		config:    config,
+	}
+}
+
+// UploadImage - 채팅 이미지 업로드 (형식 확인, 방향 보정 후 메타데이터 없이 다시 인코딩, 썸네일 생성)
+func (u *mediaUsecase) UploadImage(ctx context.Context, userID uint, r io.Reader) (*dto.ImageResponse, error) {
+	ctx, span := tracing.Start(ctx, "MediaUsecase.UploadImage")
+	defer span.End()
+
+	// 1. 업로더 확인 (채팅과 같이 이메일 인증 필요)
+	uploader, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+	if !uploader.EmailVerified {
+		return nil, errors.ErrEmailNotVerified
+	}
+
+	// 2. 최대 크기까지 읽고 이미지 처리
+	data, err := u.processor.Read(r)
+	if err != nil {
+		return nil, u.rejectImage(ctx, err, len(data))
+	}
+	original, thumbnail, err := u.processor.Process(data)
+	if err != nil {
+		return nil, u.rejectImage(ctx, err, len(data))
+	}
+
+	// 3. 저장
+	return u.storeImage(ctx, uploader.ID, original, thumbnail)
+}
+
+// rejectImage - 이미지 처리 오류를 usecase 에러로 변환하고 검사에서 거부된 경우 기록
+func (u *mediaUsecase) rejectImage(ctx context.Context, err error, size int) error {
+	rejected := imageError(err)
+	if rejected != err {
+		metrics.ImageUploaded("rejected")
+		logger.FromContext(ctx).Info("image upload rejected", "size", size, "reason", err)
+	}
+	return rejected
+}
+
+// storeImage - 크기별 파일을 저장하고 응답 생성
+func (u *mediaUsecase) storeImage(ctx context.Context, ownerID uint, original, thumbnail *imaging.Image) (*dto.ImageResponse, error) {
+	expiresAt := time.Now().Add(u.config.UploadTTL)
+	b := &blob.Blob{
+		OwnerID:     ownerID,
+		Kind:        blob.KindImage,
+		ContentType: original.ContentType,
+		Size:        int64(len(original.Data)),
+		Width:       original.Width,
+		Height:      original.Height,
+		ExpiresAt:   &expiresAt,
+	}
+
+	files := map[string]*imaging.Image{blob.VariantOriginal: original, blob.VariantThumbnail: thumbnail}
+	if err := u.storeBlob(ctx, b, files); err != nil {
+		return nil, err
+	}
+	return dto.FromBlobEntity(b), nil
+}
+
+// storeBlob - ID를 발급해 크기별 파일을 저장소에 저장하고 메타데이터 기록 (실패하면 저장한 파일 삭제)
+func (u *mediaUsecase) storeBlob(ctx context.Context, b *blob.Blob, files map[string]*imaging.Image) error {
+	id, err := newBlobID()
+	if err != nil {
+		return err
+	}
+	b.ID = id
+
+	for variant, img := range files {
+		if err = u.store.Put(ctx, b.Key(variant), img.Data, img.ContentType); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = u.blobRepo.Create(ctx, b)
+	}
+	if err != nil {
+		u.deleteFiles(ctx, b)
+		metrics.ImageUploaded("failed")
+		return err
+	}
+
+	metrics.ImageUploaded("stored")
+	logger.FromContext(ctx).Info("image uploaded", "blob_id", b.ID, "kind", b.Kind, "size", b.Size, "width", b.Width, "height", b.Height)
+	return nil
+}
+
+// UploadAvatar - 프로필 사진 업로드 (가운데를 정사각형으로 잘라 크기별로 저장하고 이전 사진 삭제)
+func (u *mediaUsecase) UploadAvatar(ctx context.Context, userID uint, r io.Reader) (*dto.AvatarResponse, error) {
+	ctx, span := tracing.Start(ctx, "MediaUsecase.UploadAvatar")
+	defer span.End()
+
+	// 1. 사용자 확인
+	owner, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	// 2. 최대 크기까지 읽고 크기별 정사각형 이미지 생성
+	data, err := u.processor.Read(r)
+	if err != nil {
+		return nil, u.rejectImage(ctx, err, len(data))
+	}
+	sizes := make([]int, len(avatarSizes))
+	for i, s := range avatarSizes {
+		sizes[i] = s.size
+	}
+	images, err := u.processor.ProcessSquare(data, sizes)
+	if err != nil {
+		return nil, u.rejectImage(ctx, err, len(data))
+	}
+
+	// 3. 저장 (가장 큰 크기 기준으로 메타데이터 기록, 만료 없음)
+	largest := images[len(images)-1]
+	b := &blob.Blob{
+		OwnerID:     owner.ID,
+		Kind:        blob.KindAvatar,
+		ContentType: largest.ContentType,
+		Size:        int64(len(largest.Data)),
+		Width:       largest.Width,
+		Height:      largest.Height,
+	}
+	files := make(map[string]*imaging.Image, len(images))
+	for i, s := range avatarSizes {
+		files[s.variant] = images[i]
+	}
+	if err := u.storeBlob(ctx, b, files); err != nil {
+		return nil, err
+	}
+
+	// 4. 읽은 사진이 그대로일 때만 사용자 프로필에 연결 (실패하거나 다른 요청이 먼저 바꿨으면 저장한 사진 삭제)
+	swapped, err := u.userRepo.UpdateAvatarIfUnchanged(ctx, owner.ID, owner.AvatarID, &b.ID)
+	if err != nil || !swapped {
+		u.removeBlob(ctx, b.ID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrAvatarChanged
+	}
+
+	// 5. 교체된 이전 사진 삭제
+	if owner.AvatarID != nil {
+		u.removeBlob(ctx, *owner.AvatarID)
+	}
+
+	return &dto.AvatarResponse{
+		ProfilePic:  dto.AvatarURL(&b.ID, blob.VariantMedium),
+		ProfilePics: dto.AvatarURLs(&b.ID),
+	}, nil
+}
+
+// DeleteAvatar - 프로필 사진 삭제 (없으면 아무것도 하지 않음)
+func (u *mediaUsecase) DeleteAvatar(ctx context.Context, userID uint) error {
+	ctx, span := tracing.Start(ctx, "MediaUsecase.DeleteAvatar")
+	defer span.End()
+
+	owner, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrUserNotFound
+		}
+		return err
+	}
+	if owner.AvatarID == nil {
+		return nil
+	}
+
+	swapped, err := u.userRepo.UpdateAvatarIfUnchanged(ctx, owner.ID, owner.AvatarID, nil)
+	if err != nil {
+		return err
+	}
+	if !swapped {
+		return errors.ErrAvatarChanged
+	}
+	u.removeBlob(ctx, *owner.AvatarID)
+	return nil
+}
+
+// removeBlob - 더 이상 쓰지 않는 파일을 저장소와 DB에서 삭제 (실패는 기록만 하고 무시)
+func (u *mediaUsecase) removeBlob(ctx context.Context, id string) {
+	b, err := u.blobRepo.GetByID(ctx, id)
+	if err != nil {
+		if err != gorm.ErrRecordNotFound {
+			logger.FromContext(ctx).Warn("failed to load blob for removal", "blob_id", id, "error", err)
+		}
+		return
+	}
+	if err := u.deleteFiles(ctx, b); err != nil {
+		return
+	}
+	if err := u.blobRepo.Delete(ctx, b.ID); err != nil {
+		logger.FromContext(ctx).Warn("failed to delete blob", "blob_id", b.ID, "error", err)
+	}
+}
+
+// GetBlob - 저장된 파일 조회
+func (u *mediaUsecase) GetBlob(ctx context.Context, id, variant string) (*dto.BlobContent, error) {
+	ctx, span := tracing.Start(ctx, "MediaUsecase.GetBlob")
+	defer span.End()
+
+	b, err := u.blobRepo.GetByID(ctx, id)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrImageNotFound
+		}
+		return nil, err
+	}
+	if b.IsExpired() || !b.HasVariant(variant) {
+		return nil, errors.ErrImageNotFound
+	}
+
+	body, err := u.store.Get(ctx, b.Key(variant))
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, errors.ErrImageNotFound
+		}
+		return nil, err
+	}
+
+	return &dto.BlobContent{
+		ID:          b.ID,
+		Variant:     variant,
+		ContentType: b.ContentType,
+		Public:      b.Kind == blob.KindAvatar,
+		ExpiresAt:   b.ExpiresAt,
+		Body:        body,
+		CreatedAt:   b.CreatedAt,
+	}, nil
+}
+
+// PurgeExpired - 만료된 파일을 저장소와 DB에서 삭제 (저장소 삭제에 실패한 파일은 다음 주기에 다시 시도)
+func (u *mediaUsecase) PurgeExpired(ctx context.Context, before time.Time, limit int) (int, error) {
+	ctx, span := tracing.Start(ctx, "MediaUsecase.PurgeExpired")
+	defer span.End()
+
+	expired, err := u.blobRepo.ListExpired(ctx, before, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, b := range expired {
+		if err := u.deleteFiles(ctx, b); err != nil {
+			return purged, err
+		}
+		if err := u.blobRepo.Delete(ctx, b.ID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	metrics.ExpiredBlobsPurged(purged)
+	return purged, nil
+}
+
+// deleteFiles - 크기별 파일 삭제 (첫 오류 반환, 나머지도 계속 삭제)
+func (u *mediaUsecase) deleteFiles(ctx context.Context, b *blob.Blob) error {
+	var firstErr error
+	for _, variant := range b.Variants() {
+		if err := u.store.Delete(ctx, b.Key(variant)); err != nil {
+			logger.FromContext(ctx).Warn("failed to delete blob file", "blob_id", b.ID, "variant", variant, "error", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+// imageError - 이미지 처리 오류를 usecase 에러로 변환 (검사와 무관한 오류는 그대로)
+func imageError(err error) error {
+	switch err {
+	case imaging.ErrTooLarge:
+		return errors.ErrImageTooLarge
+	case imaging.ErrUnsupportedFormat:
+		return errors.ErrUnsupportedImageType
+	case imaging.ErrTooManyPixels:
+		return errors.ErrImageDimensionTooLarge
+	case imaging.ErrInvalidImage:
+		return errors.ErrInvalidImage
+	default:
+		return err
+	}
+}
+
+// newBlobID - 추측할 수 없는 128비트 파일 ID (URL에 그대로 노출)
+func newBlobID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
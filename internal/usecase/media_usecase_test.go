@@ -0,0 +1,244 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/pkg/imaging"
+	"github.com/chris910512/travel-chat/internal/pkg/storage"
+	"github.com/chris910512/travel-chat/internal/usecase"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+)
+
+func TestImageMessageLifecycle(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	blobRepo := memory.NewBlobRepository()
+	store, err := storage.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	mediaUC := usecase.NewMediaUsecase(userRepo, blobRepo, store,
+		imaging.NewProcessor(imaging.Config{MaxBytes: 1 << 20, MaxDimension: 200, ThumbnailSize: 50}), usecase.MediaConfig{})
+	chatUC := usecase.NewChatUsecase(userRepo, memory.NewChatRoomRepository(), memory.NewMessageRepository(), memory.NewUserBlockRepository(), blobRepo, nil, nil, usecase.ChatConfig{})
+
+	owner := createChatUser(t, userRepo, "owner@example.com", true)
+	other := createChatUser(t, userRepo, "other@example.com", true)
+	room, err := chatUC.JoinPublicRoom(ctx, owner.ID)
+	if err != nil {
+		t.Fatalf("JoinPublicRoom: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 400, 100))); err != nil {
+		t.Fatal(err)
+	}
+	uploaded, err := mediaUC.UploadImage(ctx, owner.ID, &buf)
+	if err != nil {
+		t.Fatalf("UploadImage: %v", err)
+	}
+	if uploaded.Width != 200 || uploaded.Height != 50 || uploaded.ThumbnailURL != dto.BlobURL(uploaded.ID, "thumbnail") {
+		t.Errorf("unexpected upload response %+v", uploaded)
+	}
+	if _, err := mediaUC.UploadImage(ctx, owner.ID, strings.NewReader("just text")); !usecaseErrors.IsUnsupportedImageType(err) {
+		t.Errorf("expected ErrUnsupportedImageType, got %v", err)
+	}
+
+	// 다른 사용자가 올린 이미지는 첨부할 수 없음
+	if _, err := chatUC.SendMessage(ctx, other.ID, room.ID, &dto.SendMessageRequest{ImageID: uploaded.ID}); !usecaseErrors.IsImageNotFound(err) {
+		t.Errorf("expected ErrImageNotFound for someone else's image, got %v", err)
+	}
+
+	msg, err := chatUC.SendMessage(ctx, owner.ID, room.ID, &dto.SendMessageRequest{ImageID: uploaded.ID})
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	if msg.MessageType != "image" || msg.Image == nil || msg.Image.ID != uploaded.ID || msg.Content != "" {
+		t.Errorf("unexpected image message %+v", msg)
+	}
+	if stored, err := blobRepo.GetByID(ctx, uploaded.ID); err != nil || !stored.ExpiresAt.Equal(*msg.ExpiresAt) {
+		t.Errorf("expected image to be kept until the message expires, got %+v (%v)", stored, err)
+	}
+
+	content, err := mediaUC.GetBlob(ctx, uploaded.ID, "thumbnail")
+	if err != nil {
+		t.Fatalf("GetBlob: %v", err)
+	}
+	thumbnail, err := png.DecodeConfig(content.Body)
+	content.Body.Close()
+	if err != nil || content.ContentType != imaging.TypePNG || thumbnail.Width != 50 {
+		t.Errorf("unexpected thumbnail %s %+v (%v)", content.ContentType, thumbnail, err)
+	}
+	if content.Public || content.ExpiresAt == nil || !content.ExpiresAt.Equal(*msg.ExpiresAt) {
+		t.Errorf("expected private image cached until the message expires, got public=%v expires=%v", content.Public, content.ExpiresAt)
+	}
+	if _, err := mediaUC.GetBlob(ctx, uploaded.ID, "huge"); !usecaseErrors.IsImageNotFound(err) {
+		t.Errorf("expected unknown variant to be not found, got %v", err)
+	}
+
+	purged, err := mediaUC.PurgeExpired(ctx, time.Now().Add(7*24*time.Hour), 10)
+	if err != nil || purged != 1 {
+		t.Fatalf("expected 1 purged image, got %d (%v)", purged, err)
+	}
+	if _, err := mediaUC.GetBlob(ctx, uploaded.ID, "original"); !usecaseErrors.IsImageNotFound(err) {
+		t.Errorf("expected purged image to be gone, got %v", err)
+	}
+	if _, err := store.Get(ctx, "image/"+uploaded.ID+"/original"); err != storage.ErrNotFound {
+		t.Errorf("expected stored file to be deleted, got %v", err)
+	}
+}
+
+func TestAvatarLifecycle(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	store, err := storage.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	mediaUC := usecase.NewMediaUsecase(userRepo, memory.NewBlobRepository(), store, imaging.NewProcessor(imaging.DefaultConfig()), usecase.MediaConfig{})
+	owner := createChatUser(t, userRepo, "avatar@example.com", false)
+
+	upload := func() *dto.AvatarResponse {
+		t.Helper()
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 600, 400))); err != nil {
+			t.Fatal(err)
+		}
+		avatar, err := mediaUC.UploadAvatar(ctx, owner.ID, &buf)
+		if err != nil {
+			t.Fatalf("UploadAvatar: %v", err)
+		}
+		return avatar
+	}
+
+	first := upload()
+	stored, err := userRepo.GetByID(ctx, owner.ID)
+	if err != nil || stored.AvatarID == nil {
+		t.Fatalf("expected avatar to be set, got %+v (%v)", stored, err)
+	}
+	if profilePic := dto.FromUserEntity(stored).ProfilePic; profilePic != first.ProfilePic || profilePic != dto.BlobURL(*stored.AvatarID, "medium") {
+		t.Errorf("expected profile_pic %s, got %s", first.ProfilePic, profilePic)
+	}
+	firstID := *stored.AvatarID
+
+	for variant, want := range map[string]int{"small": 64, "medium": 256, "large": 400} {
+		content, err := mediaUC.GetBlob(ctx, firstID, variant)
+		if err != nil {
+			t.Fatalf("GetBlob(%s): %v", variant, err)
+		}
+		config, err := png.DecodeConfig(content.Body)
+		content.Body.Close()
+		if err != nil || config.Width != want || config.Height != want || !content.Public {
+			t.Errorf("expected public %dx%d %s avatar, got %+v public=%v (%v)", want, want, variant, config, content.Public, err)
+		}
+	}
+	if _, err := mediaUC.GetBlob(ctx, firstID, "original"); !usecaseErrors.IsImageNotFound(err) {
+		t.Errorf("expected chat image variant to be missing for avatars, got %v", err)
+	}
+
+	// 새 사진을 올리면 이전 사진은 삭제
+	second := upload()
+	if second.ProfilePic == first.ProfilePic || len(second.ProfilePics) != 3 {
+		t.Errorf("expected new avatar URLs, got %+v", second)
+	}
+	if _, err := mediaUC.GetBlob(ctx, firstID, "small"); !usecaseErrors.IsImageNotFound(err) {
+		t.Errorf("expected previous avatar to be deleted, got %v", err)
+	}
+	stored, err = userRepo.GetByID(ctx, owner.ID)
+	if err != nil || stored.AvatarID == nil {
+		t.Fatalf("expected new avatar to be set, got %+v (%v)", stored, err)
+	}
+	secondID := *stored.AvatarID
+
+	if err := mediaUC.DeleteAvatar(ctx, owner.ID); err != nil {
+		t.Fatalf("DeleteAvatar: %v", err)
+	}
+	if stored, err := userRepo.GetByID(ctx, owner.ID); err != nil || stored.AvatarID != nil || dto.FromUserEntity(stored).ProfilePic != "" {
+		t.Errorf("expected avatar to be cleared, got %+v (%v)", stored, err)
+	}
+	if _, err := store.Get(ctx, "avatar/"+secondID+"/large"); err != storage.ErrNotFound {
+		t.Errorf("expected avatar file to be deleted, got %v", err)
+	}
+}
+
+// racingUserRepo - 사용자를 읽은 직후 한 번 onRead를 실행 (읽기와 교체 사이에 끼어드는 요청 흉내)
+type racingUserRepo struct {
+	repository.UserRepository
+	onRead func()
+}
+
+func (r *racingUserRepo) GetByID(ctx context.Context, id uint) (*user.User, error) {
+	u, err := r.UserRepository.GetByID(ctx, id)
+	if onRead := r.onRead; onRead != nil {
+		r.onRead = nil
+		onRead()
+	}
+	return u, err
+}
+
+func TestConcurrentAvatarUploads(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &racingUserRepo{UserRepository: memory.NewUserRepository()}
+	dir := t.TempDir()
+	store, err := storage.NewLocalStore(dir)
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	mediaUC := usecase.NewMediaUsecase(userRepo, memory.NewBlobRepository(), store, imaging.NewProcessor(imaging.DefaultConfig()), usecase.MediaConfig{})
+	owner := createChatUser(t, userRepo, "avatar@example.com", false)
+
+	pngReader := func() *bytes.Buffer {
+		t.Helper()
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 100, 100))); err != nil {
+			t.Fatal(err)
+		}
+		return &buf
+	}
+
+	// 먼저 읽은 요청이 사진을 저장하는 사이에 다른 요청이 먼저 교체
+	var winner *dto.AvatarResponse
+	userRepo.onRead = func() {
+		var err error
+		if winner, err = mediaUC.UploadAvatar(ctx, owner.ID, pngReader()); err != nil {
+			t.Fatalf("UploadAvatar (winner): %v", err)
+		}
+	}
+	if _, err := mediaUC.UploadAvatar(ctx, owner.ID, pngReader()); !usecaseErrors.IsAvatarChanged(err) {
+		t.Fatalf("expected ErrAvatarChanged for the losing upload, got %v", err)
+	}
+
+	stored, err := userRepo.GetByID(ctx, owner.ID)
+	if err != nil || stored.AvatarID == nil || dto.FromUserEntity(stored).ProfilePic != winner.ProfilePic {
+		t.Fatalf("expected the winning avatar to be kept, got %+v (%v)", stored, err)
+	}
+	// 진 요청이 저장한 사진은 남지 않음
+	entries, err := os.ReadDir(filepath.Join(dir, "avatar"))
+	if err != nil || len(entries) != 1 || entries[0].Name() != *stored.AvatarID {
+		t.Errorf("expected only the winning avatar files to remain, got %v (%v)", entries, err)
+	}
+
+	// 읽은 뒤 사진이 바뀌었으면 삭제도 하지 않음
+	userRepo.onRead = func() {
+		if _, err := mediaUC.UploadAvatar(ctx, owner.ID, pngReader()); err != nil {
+			t.Fatalf("UploadAvatar: %v", err)
+		}
+	}
+	if err := mediaUC.DeleteAvatar(ctx, owner.ID); !usecaseErrors.IsAvatarChanged(err) {
+		t.Fatalf("expected ErrAvatarChanged for a stale delete, got %v", err)
+	}
+	if stored, err := userRepo.GetByID(ctx, owner.ID); err != nil || stored.AvatarID == nil {
+		t.Errorf("expected the newer avatar to be kept, got %+v (%v)", stored, err)
+	}
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/presence"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	"github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"gorm.io/gorm"
+)
+
+type presenceUsecase struct {
+	registry     *presence.Registry
+	userRepo     repository.UserRepository
+	chatRoomRepo repository.ChatRoomRepository
+	publisher    usecaseInterface.PresencePublisher
+}
+
+// NewPresenceUsecase - Presence Usecase 생성자
+func NewPresenceUsecase(
+	registry *presence.Registry,
+	userRepo repository.UserRepository,
+	chatRoomRepo repository.ChatRoomRepository,
+	publisher usecaseInterface.PresencePublisher,
+) usecaseInterface.PresenceUsecase {
+	return &presenceUsecase{
+		registry:     registry,
+		userRepo:     userRepo,
+		chatRoomRepo: chatRoomRepo,
+		publisher:    publisher,
+	}
+}
+
+// Connect - 실시간 연결(WebSocket, gRPC 스트림) 시작 (이메일 인증은 연결할 때 한 번만 확인)
+func (u *presenceUsecase) Connect(ctx context.Context, userID uint) error {
+	ctx, span := tracing.Start(ctx, "PresenceUsecase.Connect")
+	defer span.End()
+
+	if err := u.ensureVerified(ctx, userID); err != nil {
+		return err
+	}
+
+	if change, ok := u.registry.Connect(userID); ok {
+		u.publishChange(ctx, change)
+	}
+	return nil
+}
+
+// Disconnect - 실시간 연결 종료
+func (u *presenceUsecase) Disconnect(ctx context.Context, userID uint) {
+	ctx, span := tracing.Start(ctx, "PresenceUsecase.Disconnect")
+	defer span.End()
+
+	if change, ok := u.registry.Disconnect(userID); ok {
+		u.publishChange(ctx, change)
+	}
+}
+
+// RecordActivity - 실시간 연결에서 받은 요청을 활동으로 기록 (Connect에서 확인했으므로 사용자를 다시 조회하지 않음)
+func (u *presenceUsecase) RecordActivity(ctx context.Context, userID uint) {
+	ctx, span := tracing.Start(ctx, "PresenceUsecase.RecordActivity")
+	defer span.End()
+
+	u.recordActivity(ctx, userID)
+}
+
+// Heartbeat - 실시간 연결 없이 보내는 활동 알림 (자리 비움 상태였다면 온라인으로 변경, 이메일 미인증 사용자는 거부)
+func (u *presenceUsecase) Heartbeat(ctx context.Context, userID uint) error {
+	ctx, span := tracing.Start(ctx, "PresenceUsecase.Heartbeat")
+	defer span.End()
+
+	if err := u.ensureVerified(ctx, userID); err != nil {
+		return err
+	}
+
+	u.recordActivity(ctx, userID)
+	return nil
+}
+
+// GetStatus - 사용자 접속 상태 조회
+func (u *presenceUsecase) GetStatus(ctx context.Context, userID uint) (*dto.PresenceResponse, error) {
+	ctx, span := tracing.Start(ctx, "PresenceUsecase.GetStatus")
+	defer span.End()
+
+	userEntity, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return &dto.PresenceResponse{
+		UserID:     userEntity.ID,
+		UserName:   userEntity.Name,
+		Status:     string(u.registry.Status(userEntity.ID)),
+		LastActive: userEntity.LastActive,
+	}, nil
+}
+
+// GetActiveUsers - 온라인/자리 비움 상태인 사용자 목록 (테이블 전체 조회 없이 Registry 기준, 이메일 미인증 사용자 제외)
+func (u *presenceUsecase) GetActiveUsers(ctx context.Context) ([]dto.UserResponse, error) {
+	ctx, span := tracing.Start(ctx, "PresenceUsecase.GetActiveUsers")
+	defer span.End()
+
+	users, err := u.userRepo.GetByIDs(ctx, u.registry.ActiveUserIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	discoverable := make([]*user.User, 0, len(users))
+	for _, userEntity := range users {
+		if userEntity.EmailVerified {
+			discoverable = append(discoverable, userEntity)
+		}
+	}
+	return dto.FromUserEntities(discoverable), nil
+}
+
+// Sweep - 시간 경과에 따른 상태 변경을 알리고 활동 시간을 DB에 반영
+func (u *presenceUsecase) Sweep(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "PresenceUsecase.Sweep")
+	defer span.End()
+
+	for _, change := range u.registry.Sweep() {
+		u.publishChange(ctx, change)
+	}
+
+	var firstErr error
+	for _, userID := range u.registry.DrainActivity() {
+		if err := u.userRepo.UpdateLastActive(ctx, userID); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// 비공개 헬퍼 메서드들
+
+// ensureVerified - 이메일 인증을 마친 사용자인지 확인 (미인증 사용자는 접속 상태에 나타나지 않음)
+func (u *presenceUsecase) ensureVerified(ctx context.Context, userID uint) error {
+	userEntity, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrUserNotFound
+		}
+		return err
+	}
+	if !userEntity.EmailVerified {
+		return errors.ErrEmailNotVerified
+	}
+	return nil
+}
+
+// recordActivity - Registry에 활동 기록 후 상태가 바뀌었으면 알림
+func (u *presenceUsecase) recordActivity(ctx context.Context, userID uint) {
+	if change, ok := u.registry.Heartbeat(userID); ok {
+		u.publishChange(ctx, change)
+	}
+}
+
+// publishChange - 사용자가 속한 채팅방들에 상태 변경 전달
+func (u *presenceUsecase) publishChange(ctx context.Context, change presence.Change) {
+	if u.publisher == nil {
+		return
+	}
+
+	// 정지되어 연결이 끊긴 사용자의 오프라인 전환도 알림
+	userEntity, err := u.userRepo.GetByIDIncludingSuspended(ctx, change.UserID)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to load user for presence update", "target_user_id", change.UserID, "error", err)
+		return
+	}
+
+	rooms, err := listUserRooms(ctx, u.chatRoomRepo, userEntity)
+	if err != nil {
+		logger.FromContext(ctx).Error("failed to list rooms for presence update", "target_user_id", change.UserID, "error", err)
+		return
+	}
+	if len(rooms) == 0 {
+		return
+	}
+
+	roomIDs := make([]uint, len(rooms))
+	for i, room := range rooms {
+		roomIDs[i] = room.ID
+	}
+
+	u.publisher.PublishPresence(roomIDs, &dto.PresenceResponse{
+		UserID:     userEntity.ID,
+		UserName:   userEntity.Name,
+		Status:     string(change.Status),
+		LastActive: userEntity.LastActive,
+	})
+}
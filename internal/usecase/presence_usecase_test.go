@@ -0,0 +1,59 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/pkg/presence"
+	"github.com/chris910512/travel-chat/internal/usecase"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+)
+
+func TestActiveUsersHideUnverified(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	registry := presence.NewRegistry(presence.DefaultConfig())
+	uc := usecase.NewPresenceUsecase(registry, userRepo, memory.NewChatRoomRepository(), nil)
+
+	verified := createChatUser(t, userRepo, "verified@example.com", true)
+	unverified := createChatUser(t, userRepo, "unverified@example.com", false)
+
+	if err := uc.Heartbeat(ctx, verified.ID); err != nil {
+		t.Fatalf("Heartbeat: %v", err)
+	}
+	if err := uc.Heartbeat(ctx, unverified.ID); !usecaseErrors.IsEmailNotVerified(err) {
+		t.Errorf("expected ErrEmailNotVerified for unverified heartbeat, got %v", err)
+	}
+	if status := registry.Status(unverified.ID); status != presence.StatusOffline {
+		t.Errorf("expected refused heartbeat to leave user offline, got %s", status)
+	}
+	if err := uc.Heartbeat(ctx, 9999); !usecaseErrors.IsUserNotFound(err) {
+		t.Errorf("expected ErrUserNotFound for missing user, got %v", err)
+	}
+
+	// 실시간 연결은 연결할 때 한 번만 확인
+	if err := uc.Connect(ctx, unverified.ID); !usecaseErrors.IsEmailNotVerified(err) {
+		t.Errorf("expected ErrEmailNotVerified for unverified connection, got %v", err)
+	}
+	if status := registry.Status(unverified.ID); status != presence.StatusOffline {
+		t.Errorf("expected refused connection to leave user offline, got %s", status)
+	}
+	if err := uc.Connect(ctx, verified.ID); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	uc.RecordActivity(ctx, verified.ID)
+	if status := registry.Status(verified.ID); status != presence.StatusOnline {
+		t.Errorf("expected connected user to be online, got %s", status)
+	}
+
+	// Registry에 들어간 미인증 사용자도 목록에서는 제외
+	registry.Heartbeat(unverified.ID)
+	active, err := uc.GetActiveUsers(ctx)
+	if err != nil {
+		t.Fatalf("GetActiveUsers: %v", err)
+	}
+	if len(active) != 1 || active[0].ID != verified.ID {
+		t.Errorf("expected only the verified user to be active, got %+v", active)
+	}
+}
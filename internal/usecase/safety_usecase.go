@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	"github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+	"gorm.io/gorm"
+)
+
+// 신고 설명 최대 길이 (문자 수)
+const maxReportDescriptionLength = 1000
+
+type safetyUsecase struct {
+	userRepo    repository.UserRepository
+	messageRepo repository.MessageRepository
+	blockRepo   repository.UserBlockRepository
+	reportRepo  repository.UserReportRepository
+	publisher   usecaseInterface.BlockPublisher
+}
+
+// NewSafetyUsecase - Safety Usecase 생성자
+func NewSafetyUsecase(
+	userRepo repository.UserRepository,
+	messageRepo repository.MessageRepository,
+	blockRepo repository.UserBlockRepository,
+	reportRepo repository.UserReportRepository,
+	publisher usecaseInterface.BlockPublisher,
+) usecaseInterface.SafetyUsecase {
+	return &safetyUsecase{
+		userRepo:    userRepo,
+		messageRepo: messageRepo,
+		blockRepo:   blockRepo,
+		reportRepo:  reportRepo,
+		publisher:   publisher,
+	}
+}
+
+// BlockUser - 사용자 차단 (차단한 사용자에게 상대가 탐색/매칭/메시지에서 보이지 않고, 서로 1:1 채팅 불가)
+func (u *safetyUsecase) BlockUser(ctx context.Context, userID, targetUserID uint) error {
+	ctx, span := tracing.Start(ctx, "SafetyUsecase.BlockUser")
+	defer span.End()
+
+	if userID == targetUserID {
+		return errors.ErrCannotBlockSelf
+	}
+	if err := u.ensureUserExists(ctx, targetUserID); err != nil {
+		return err
+	}
+
+	blocked, err := u.blockRepo.Exists(ctx, userID, targetUserID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errors.ErrUserAlreadyBlocked
+	}
+
+	if err := u.blockRepo.Create(ctx, &user.UserBlock{BlockerID: userID, BlockedID: targetUserID}); err != nil {
+		return err
+	}
+	if u.publisher != nil {
+		u.publisher.PublishBlock(userID, targetUserID, true)
+	}
+
+	logger.FromContext(ctx).Info("user blocked", "target_user_id", targetUserID)
+	return nil
+}
+
+// UnblockUser - 차단 해제
+func (u *safetyUsecase) UnblockUser(ctx context.Context, userID, targetUserID uint) error {
+	ctx, span := tracing.Start(ctx, "SafetyUsecase.UnblockUser")
+	defer span.End()
+
+	if err := u.blockRepo.Delete(ctx, userID, targetUserID); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrUserNotBlocked
+		}
+		return err
+	}
+	if u.publisher != nil {
+		u.publisher.PublishBlock(userID, targetUserID, false)
+	}
+
+	logger.FromContext(ctx).Info("user unblocked", "target_user_id", targetUserID)
+	return nil
+}
+
+// ListBlockedUsers - 내가 차단한 사용자 목록 (최근 차단 순, 탈퇴/정지된 사용자 제외)
+func (u *safetyUsecase) ListBlockedUsers(ctx context.Context, userID uint) ([]dto.BlockedUserResponse, error) {
+	ctx, span := tracing.Start(ctx, "SafetyUsecase.ListBlockedUsers")
+	defer span.End()
+
+	blocks, err := u.blockRepo.ListByBlocker(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint, len(blocks))
+	for i, block := range blocks {
+		ids[i] = block.BlockedID
+	}
+	users, err := u.userRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	usersByID := make(map[uint]*user.User, len(users))
+	for _, blockedUser := range users {
+		usersByID[blockedUser.ID] = blockedUser
+	}
+
+	responses := make([]dto.BlockedUserResponse, 0, len(blocks))
+	for _, block := range blocks {
+		blockedUser, ok := usersByID[block.BlockedID]
+		if !ok {
+			continue
+		}
+		responses = append(responses, dto.BlockedUserResponse{
+			User:      *dto.FromUserEntity(blockedUser),
+			BlockedAt: block.CreatedAt,
+		})
+	}
+	return responses, nil
+}
+
+// ReportUser - 사용자 신고 (메시지를 함께 신고하면 신고 대상이 작성한 메시지인지 확인)
+func (u *safetyUsecase) ReportUser(ctx context.Context, userID, targetUserID uint, req *dto.ReportUserRequest) (*dto.ReportResponse, error) {
+	ctx, span := tracing.Start(ctx, "SafetyUsecase.ReportUser")
+	defer span.End()
+
+	if userID == targetUserID {
+		return nil, errors.ErrCannotReportSelf
+	}
+
+	reason := user.ReportReason(req.Reason)
+	if !reason.IsValid() {
+		return nil, errors.ErrInvalidReportReason
+	}
+	description := strings.TrimSpace(req.Description)
+	if utf8.RuneCountInString(description) > maxReportDescriptionLength ||
+		(reason == user.ReportReasonOther && description == "") {
+		return nil, errors.ErrInvalidReportDescription
+	}
+
+	if err := u.ensureUserExists(ctx, targetUserID); err != nil {
+		return nil, err
+	}
+	if req.MessageID != nil {
+		msg, err := u.messageRepo.GetByID(ctx, *req.MessageID)
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, errors.ErrMessageNotFound
+			}
+			return nil, err
+		}
+		if msg.UserID != targetUserID {
+			return nil, errors.ErrMessageNotFound
+		}
+	}
+
+	report := &user.UserReport{
+		ReporterID:  userID,
+		ReportedID:  targetUserID,
+		Reason:      reason,
+		Description: description,
+		MessageID:   req.MessageID,
+	}
+	if err := u.reportRepo.Create(ctx, report); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Warn("user reported", "target_user_id", targetUserID, "reason", string(reason), "report_id", report.ID)
+	return dto.FromUserReportEntity(report), nil
+}
+
+// 비공개 헬퍼 메서드들
+
+// ensureUserExists - 대상 사용자가 있는지 확인 (없으면 ErrUserNotFound)
+func (u *safetyUsecase) ensureUserExists(ctx context.Context, userID uint) error {
+	if _, err := u.userRepo.GetByID(ctx, userID); err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// relatedBlockIDs - viewerID와 차단 관계(양방향)인 사용자 ID (viewerID가 0이면 비로그인이므로 없음)
+func relatedBlockIDs(ctx context.Context, blockRepo repository.UserBlockRepository, viewerID uint) ([]uint, error) {
+	if viewerID == 0 {
+		return nil, nil
+	}
+	return blockRepo.GetRelatedIDs(ctx, viewerID)
+}
+
+// excludeUsers - excludeIDs에 있는 사용자를 목록에서 제거
+func excludeUsers(users []*user.User, excludeIDs []uint) []*user.User {
+	if len(excludeIDs) == 0 {
+		return users
+	}
+
+	excluded := make(map[uint]struct{}, len(excludeIDs))
+	for _, id := range excludeIDs {
+		excluded[id] = struct{}{}
+	}
+	filtered := make([]*user.User, 0, len(users))
+	for _, candidate := range users {
+		if _, ok := excluded[candidate.ID]; !ok {
+			filtered = append(filtered, candidate)
+		}
+	}
+	return filtered
+}
+
+// isBlockedEitherWay - 두 사용자 중 한쪽이라도 상대를 차단했는지 확인
+func isBlockedEitherWay(ctx context.Context, blockRepo repository.UserBlockRepository, userID1, userID2 uint) (bool, error) {
+	blocked, err := blockRepo.Exists(ctx, userID1, userID2)
+	if err != nil || blocked {
+		return blocked, err
+	}
+	return blockRepo.Exists(ctx, userID2, userID1)
+}
@@ -0,0 +1,193 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/pkg/authz"
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/mailer"
+	"github.com/chris910512/travel-chat/internal/usecase"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+)
+
+// blockPublisherFunc - 차단 변경을 기록하는 BlockPublisher
+type blockPublisherFunc func(blockerID, blockedID uint, blocked bool)
+
+func (f blockPublisherFunc) PublishBlock(blockerID, blockedID uint, blocked bool) {
+	f(blockerID, blockedID, blocked)
+}
+
+func TestBlockHidesUserFromDiscoveryAndChat(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	messageRepo := memory.NewMessageRepository()
+	blockRepo := memory.NewUserBlockRepository()
+
+	var published []bool
+	safetyUC := usecase.NewSafetyUsecase(userRepo, messageRepo, blockRepo, memory.NewUserReportRepository(),
+		blockPublisherFunc(func(_, _ uint, blocked bool) { published = append(published, blocked) }))
+	chatUC := usecase.NewChatUsecase(userRepo, memory.NewChatRoomRepository(), messageRepo, blockRepo, memory.NewBlobRepository(), nil, nil, usecase.ChatConfig{})
+	matchUC := usecase.NewMatchUsecase(userRepo, blockRepo)
+	userUC := usecase.NewUserUsecase(userRepo, memory.NewRefreshTokenRepository(), memory.NewLoginHistoryRepository(),
+		memory.NewPasswordResetTokenRepository(), memory.NewEmailVerificationTokenRepository(), blockRepo,
+		jwt.NewJWTService("test-secret", "travel-chat-test", 0, 0), authz.NewAuthorizer(nil),
+		mailer.NewWriterMailer(io.Discard, "no-reply@example.com"), usecase.UserConfig{})
+
+	blocker := createChatUser(t, userRepo, "blocker@example.com", true)
+	blocked := createChatUser(t, userRepo, "blocked@example.com", true)
+	other := createChatUser(t, userRepo, "other@example.com", true)
+
+	room, err := chatUC.JoinPublicRoom(ctx, blocker.ID)
+	if err != nil {
+		t.Fatalf("JoinPublicRoom: %v", err)
+	}
+	for _, sender := range []uint{blocked.ID, other.ID} {
+		if _, err := chatUC.SendMessage(ctx, sender, room.ID, &dto.SendMessageRequest{Content: "안녕하세요"}); err != nil {
+			t.Fatalf("SendMessage(%d): %v", sender, err)
+		}
+	}
+
+	if err := safetyUC.BlockUser(ctx, blocker.ID, blocker.ID); !usecaseErrors.IsCannotBlockSelf(err) {
+		t.Errorf("expected ErrCannotBlockSelf, got %v", err)
+	}
+	if err := safetyUC.BlockUser(ctx, blocker.ID, blocked.ID); err != nil {
+		t.Fatalf("BlockUser: %v", err)
+	}
+	if err := safetyUC.BlockUser(ctx, blocker.ID, blocked.ID); !usecaseErrors.IsUserAlreadyBlocked(err) {
+		t.Errorf("expected ErrUserAlreadyBlocked, got %v", err)
+	}
+
+	// 탐색/매칭은 양쪽 모두에서 제외
+	for _, viewer := range []uint{blocker.ID, blocked.ID} {
+		users, err := userUC.GetUsersByDestination(ctx, viewer, "일본", "도쿄")
+		if err != nil {
+			t.Fatalf("GetUsersByDestination: %v", err)
+		}
+		for _, u := range users {
+			if u.ID == blocker.ID && viewer == blocked.ID || u.ID == blocked.ID && viewer == blocker.ID {
+				t.Errorf("viewer %d should not see user %d", viewer, u.ID)
+			}
+		}
+
+		matches, err := matchUC.GetMatches(ctx, viewer, &dto.GetMatchesRequest{})
+		if err != nil {
+			t.Fatalf("GetMatches: %v", err)
+		}
+		if len(matches.Matches) != 1 || matches.Matches[0].User.ID != other.ID {
+			t.Errorf("expected only the unrelated user as a match for %d, got %+v", viewer, matches.Matches)
+		}
+	}
+	if users, err := userUC.GetUsersByDestination(ctx, 0, "일본", "도쿄"); err != nil || len(users) != 3 {
+		t.Errorf("expected anonymous viewer to see all users, got %d (%v)", len(users), err)
+	}
+
+	// 메시지는 차단한 사용자에게만 숨김
+	history, err := chatUC.GetHistory(ctx, blocker.ID, room.ID, &dto.GetHistoryRequest{})
+	if err != nil {
+		t.Fatalf("GetHistory: %v", err)
+	}
+	if len(history.Messages) != 1 || history.Messages[0].UserID != other.ID {
+		t.Errorf("expected blocked user's message to be hidden, got %+v", history.Messages)
+	}
+	if history, err := chatUC.GetHistory(ctx, blocked.ID, room.ID, &dto.GetHistoryRequest{}); err != nil || len(history.Messages) != 2 {
+		t.Errorf("expected blocked user to still see all messages, got %v", err)
+	}
+
+	// 차단한 사용자가 많이 보내도 한 페이지는 다른 사용자의 메시지로 채워짐
+	for i := 0; i < 3; i++ {
+		if _, err := chatUC.SendMessage(ctx, blocked.ID, room.ID, &dto.SendMessageRequest{Content: "도배"}); err != nil {
+			t.Fatalf("SendMessage: %v", err)
+		}
+	}
+	history, err = chatUC.GetHistory(ctx, blocker.ID, room.ID, &dto.GetHistoryRequest{Limit: 1})
+	if err != nil {
+		t.Fatalf("GetHistory: %v", err)
+	}
+	if len(history.Messages) != 1 || history.Messages[0].UserID != other.ID {
+		t.Errorf("expected the page to be filled after excluding blocked messages, got %+v", history.Messages)
+	}
+
+	// 1:1 채팅은 어느 쪽에서도 열 수 없음
+	if _, err := chatUC.OpenPrivateRoom(ctx, blocker.ID, blocked.ID); !usecaseErrors.IsUserBlocked(err) {
+		t.Errorf("expected ErrUserBlocked for blocker, got %v", err)
+	}
+	if _, err := chatUC.OpenPrivateRoom(ctx, blocked.ID, blocker.ID); !usecaseErrors.IsUserBlocked(err) {
+		t.Errorf("expected ErrUserBlocked for blocked user, got %v", err)
+	}
+
+	list, err := safetyUC.ListBlockedUsers(ctx, blocker.ID)
+	if err != nil || len(list) != 1 || list[0].User.ID != blocked.ID {
+		t.Errorf("expected blocked user in list, got %+v (%v)", list, err)
+	}
+
+	if err := safetyUC.UnblockUser(ctx, blocker.ID, blocked.ID); err != nil {
+		t.Fatalf("UnblockUser: %v", err)
+	}
+	if err := safetyUC.UnblockUser(ctx, blocker.ID, blocked.ID); !usecaseErrors.IsUserNotBlocked(err) {
+		t.Errorf("expected ErrUserNotBlocked, got %v", err)
+	}
+	if _, err := chatUC.OpenPrivateRoom(ctx, blocker.ID, blocked.ID); err != nil {
+		t.Errorf("expected private room after unblock, got %v", err)
+	}
+	if len(published) != 2 || !published[0] || published[1] {
+		t.Errorf("expected block then unblock to be published, got %v", published)
+	}
+}
+
+func TestReportUser(t *testing.T) {
+	ctx := context.Background()
+	userRepo := memory.NewUserRepository()
+	messageRepo := memory.NewMessageRepository()
+	reportRepo := memory.NewUserReportRepository()
+	safetyUC := usecase.NewSafetyUsecase(userRepo, messageRepo, memory.NewUserBlockRepository(), reportRepo, nil)
+	chatUC := usecase.NewChatUsecase(userRepo, memory.NewChatRoomRepository(), messageRepo, memory.NewUserBlockRepository(), memory.NewBlobRepository(), nil, nil, usecase.ChatConfig{})
+
+	reporter := createChatUser(t, userRepo, "reporter@example.com", true)
+	reported := createChatUser(t, userRepo, "reported@example.com", true)
+
+	room, err := chatUC.JoinPublicRoom(ctx, reported.ID)
+	if err != nil {
+		t.Fatalf("JoinPublicRoom: %v", err)
+	}
+	reporterMsg, err := chatUC.SendMessage(ctx, reporter.ID, room.ID, &dto.SendMessageRequest{Content: "안녕하세요"})
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+	reportedMsg, err := chatUC.SendMessage(ctx, reported.ID, room.ID, &dto.SendMessageRequest{Content: "광고입니다"})
+	if err != nil {
+		t.Fatalf("SendMessage: %v", err)
+	}
+
+	invalid := []struct {
+		name     string
+		targetID uint
+		req      dto.ReportUserRequest
+		check    func(error) bool
+	}{
+		{"self", reporter.ID, dto.ReportUserRequest{Reason: "spam"}, usecaseErrors.IsCannotReportSelf},
+		{"unknown reason", reported.ID, dto.ReportUserRequest{Reason: "boring"}, usecaseErrors.IsInvalidReportReason},
+		{"other without description", reported.ID, dto.ReportUserRequest{Reason: "other", Description: "  "}, usecaseErrors.IsInvalidReportDescription},
+		{"unknown user", 9999, dto.ReportUserRequest{Reason: "spam"}, usecaseErrors.IsUserNotFound},
+		{"message by someone else", reported.ID, dto.ReportUserRequest{Reason: "spam", MessageID: &reporterMsg.ID}, usecaseErrors.IsMessageNotFound},
+	}
+	for _, tc := range invalid {
+		if _, err := safetyUC.ReportUser(ctx, reporter.ID, tc.targetID, &tc.req); !tc.check(err) {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		}
+	}
+
+	report, err := safetyUC.ReportUser(ctx, reporter.ID, reported.ID, &dto.ReportUserRequest{Reason: "spam", MessageID: &reportedMsg.ID})
+	if err != nil {
+		t.Fatalf("ReportUser: %v", err)
+	}
+	if report.ReporterID != reporter.ID || report.ReportedID != reported.ID || report.MessageID == nil || *report.MessageID != reportedMsg.ID {
+		t.Errorf("unexpected report %+v", report)
+	}
+	if count, err := reportRepo.Count(ctx, reported.ID); err != nil || count != 1 {
+		t.Errorf("expected 1 stored report, got %d (%v)", count, err)
+	}
+}
@@ -2,40 +2,152 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"github.com/chris910512/travel-chat/internal/domain/entity/token"
 	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/pkg/auth"
+	"github.com/chris910512/travel-chat/internal/pkg/authz"
 	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/logger"
+	"github.com/chris910512/travel-chat/internal/pkg/mailer"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+	"net/url"
+	"slices"
 	"time"
+	"unicode/utf8"
 
 	"github.com/chris910512/travel-chat/internal/domain/repository"
 	"github.com/chris910512/travel-chat/internal/usecase/dto"
 	"github.com/chris910512/travel-chat/internal/usecase/errors"
 	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
-	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
+const (
+	// 로그인 기록 조회 기본/최대 개수
+	defaultLoginHistoryLimit = 20
+	maxLoginHistoryLimit     = 100
+
+	// 로그인 기록에 저장하는 User-Agent 최대 길이 (문자 수)
+	maxUserAgentLength = 512
+
+	// 비밀번호 최소 길이
+	minPasswordLength = 6
+)
+
+// UserConfig - 사용자 인증 설정
+type UserConfig struct {
+	LockoutThreshold   int           // 잠금이 시작되는 연속 로그인 실패 횟수
+	LockoutDuration    time.Duration // 첫 잠금 시간 (이후 실패할 때마다 두 배)
+	MaxLockoutDuration time.Duration // 최대 잠금 시간
+	PasswordResetTTL   time.Duration // 비밀번호 재설정 링크 유효 시간
+	PasswordResetURL   string        // 재설정 메일 링크 (토큰을 token 쿼리 파라미터로 붙임)
+
+	EmailVerificationTTL time.Duration // 이메일 인증 링크 유효 시간
+	EmailVerificationURL string        // 인증 메일 링크 (토큰을 token 쿼리 파라미터로 붙임)
+}
+
+// DefaultUserConfig - 기본 설정 (5회 연속 실패 시 1분 잠금, 실패가 이어지면 최대 1시간까지 두 배씩)
+func DefaultUserConfig() UserConfig {
+	return UserConfig{
+		LockoutThreshold:   5,
+		LockoutDuration:    time.Minute,
+		MaxLockoutDuration: time.Hour,
+		PasswordResetTTL:   30 * time.Minute,
+		PasswordResetURL:   "http://localhost:8080/reset-password",
+
+		EmailVerificationTTL: 24 * time.Hour,
+		EmailVerificationURL: "http://localhost:8080/verify-email",
+	}
+}
+
+// LockoutDurationFor - 연속 실패 횟수에 따른 잠금 시간 (잠그지 않으면 0)
+func (c UserConfig) LockoutDurationFor(failures int) time.Duration {
+	if failures < c.LockoutThreshold {
+		return 0
+	}
+	duration := c.LockoutDuration
+	for i := c.LockoutThreshold; i < failures && duration < c.MaxLockoutDuration; i++ {
+		duration *= 2
+	}
+	return min(duration, c.MaxLockoutDuration)
+}
+
 type userUsecase struct {
-	userRepo   repository.UserRepository
-	jwtService *jwt.JWTService
+	userRepo          repository.UserRepository
+	refreshTokenRepo  repository.RefreshTokenRepository
+	loginHistoryRepo  repository.LoginHistoryRepository
+	passwordResetRepo repository.PasswordResetTokenRepository
+	verificationRepo  repository.EmailVerificationTokenRepository
+	blockRepo         repository.UserBlockRepository
+	jwtService        *jwt.JWTService
+	authorizer        *authz.Authorizer
+	mailer            mailer.Mailer
+	config            UserConfig
 }
 
-// NewUserUsecase - User Usecase 생성자
-func NewUserUsecase(userRepo repository.UserRepository, jwtService *jwt.JWTService) usecaseInterface.UserUsecase {
+// NewUserUsecase - User Usecase 생성자 (설정 값이 0 이하이거나 비어 있으면 기본값 사용)
+func NewUserUsecase(
+	userRepo repository.UserRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	loginHistoryRepo repository.LoginHistoryRepository,
+	passwordResetRepo repository.PasswordResetTokenRepository,
+	verificationRepo repository.EmailVerificationTokenRepository,
+	blockRepo repository.UserBlockRepository,
+	jwtService *jwt.JWTService,
+	authorizer *authz.Authorizer,
+	mailer mailer.Mailer,
+	config UserConfig,
+) usecaseInterface.UserUsecase {
+	defaults := DefaultUserConfig()
+	if config.LockoutThreshold <= 0 {
+		config.LockoutThreshold = defaults.LockoutThreshold
+	}
+	if config.LockoutDuration <= 0 {
+		config.LockoutDuration = defaults.LockoutDuration
+	}
+	if config.MaxLockoutDuration <= 0 {
+		config.MaxLockoutDuration = defaults.MaxLockoutDuration
+	}
+	if config.PasswordResetTTL <= 0 {
+		config.PasswordResetTTL = defaults.PasswordResetTTL
+	}
+	if config.PasswordResetURL == "" {
+		config.PasswordResetURL = defaults.PasswordResetURL
+	}
+	if config.EmailVerificationTTL <= 0 {
+		config.EmailVerificationTTL = defaults.EmailVerificationTTL
+	}
+	if config.EmailVerificationURL == "" {
+		config.EmailVerificationURL = defaults.EmailVerificationURL
+	}
+
 	return &userUsecase{
-		userRepo:   userRepo,
-		jwtService: jwtService,
+		userRepo:          userRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		loginHistoryRepo:  loginHistoryRepo,
+		passwordResetRepo: passwordResetRepo,
+		verificationRepo:  verificationRepo,
+		blockRepo:         blockRepo,
+		jwtService:        jwtService,
+		authorizer:        authorizer,
+		mailer:            mailer,
+		config:            config,
 	}
 }
 
 // Register - 사용자 등록
 func (u *userUsecase) Register(ctx context.Context, req *dto.CreateUserRequest) (*dto.UserResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserUsecase.Register")
+	defer span.End()
+
 	// 1. 요청 검증
 	if err := u.validateCreateUserRequest(req); err != nil {
 		return nil, err
 	}
 
 	// 2. 이메일 중복 체크
-	existingUser, err := u.userRepo.GetByEmail(req.Email)
+	existingUser, err := u.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil && err != gorm.ErrRecordNotFound {
 		return nil, err
 	}
@@ -50,75 +162,431 @@ func (u *userUsecase) Register(ctx context.Context, req *dto.CreateUserRequest)
 	}
 
 	// 4. 사용자 생성
-	if err := u.userRepo.Create(userEntity); err != nil {
+	if err := u.userRepo.Create(ctx, userEntity); err != nil {
 		return nil, err
 	}
+	logger.FromContext(ctx).Info("user registered", "target_user_id", userEntity.ID)
+
+	// 5. 인증 메일 발송 (실패해도 가입은 유지, 재발송 요청 가능)
+	if err := u.sendVerificationMail(ctx, userEntity); err != nil {
+		logger.FromContext(ctx).Error("failed to send verification mail", "target_user_id", userEntity.ID, "error", err)
+	}
 
-	// 5. 응답 반환
+	// 6. 응답 반환
 	return dto.FromUserEntity(userEntity), nil
 }
 
-// Login - 사용자 로그인
+// Login - 사용자 로그인 (연속으로 실패하면 점점 길게 잠금)
 func (u *userUsecase) Login(ctx context.Context, req *dto.LoginRequest) (*dto.LoginResponse, error) {
-	// 1. 이메일로 사용자 조회
-	userEntity, err := u.userRepo.GetByEmail(req.Email)
+	ctx, span := tracing.Start(ctx, "UserUsecase.Login")
+	defer span.End()
+
+	log := logger.FromContext(ctx)
+	now := time.Now()
+
+	// 1. 이메일로 사용자 조회 (없는 계정도 비밀번호 검증만큼 시간을 써서 가입 여부가 드러나지 않도록)
+	userEntity, err := u.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
+			auth.SimulatePasswordCheck(req.Password)
+			log.Warn("login failed", "reason", "unknown email")
 			return nil, errors.ErrInvalidCredentials
 		}
 		return nil, err
 	}
 
-	// 2. 비밀번호 검증
-	if err := bcrypt.CompareHashAndPassword([]byte(userEntity.Password), []byte(req.Password)); err != nil {
-		return nil, errors.ErrInvalidCredentials
+	// 2. 잠금 확인 (잠금 중에는 비밀번호를 확인하지 않고 실패 횟수도 늘리지 않음)
+	if userEntity.IsLocked(now) {
+		log.Warn("login failed", "reason", "account locked", "target_user_id", userEntity.ID, "locked_until", *userEntity.LockedUntil)
+		u.recordLogin(ctx, userEntity.ID, req, user.LoginFailureLocked, false)
+		return nil, &errors.AccountLockedError{Until: *userEntity.LockedUntil}
 	}
 
-	// 3. 마지막 활동 시간 업데이트
-	userEntity.UpdateLastActive()
-	if err := u.userRepo.Update(userEntity); err != nil {
-		return nil, err
+	// 3. 비밀번호 검증
+	if err := auth.CheckPassword(req.Password, userEntity.Password); err != nil {
+		log.Warn("login failed", "reason", "wrong password", "target_user_id", userEntity.ID)
+		u.recordLogin(ctx, userEntity.ID, req, user.LoginFailureWrongPassword, false)
+		return nil, u.registerLoginFailure(ctx, userEntity.ID, now)
 	}
 
-	// 4. JWT 토큰 생성 (임시로 더미 토큰 반환, 나중에 JWT 구현)
-	accessToken, err := u.jwtService.GenerateToken(userEntity.ID, userEntity.Email)
+	// 4. 처음 보는 IP인지 확인 (이전에 로그인한 IP가 있을 때만)
+	newIP, err := u.isNewLoginIP(ctx, userEntity.ID, req.IP)
 	if err != nil {
 		return nil, err
 	}
+	if newIP {
+		log.Warn("login from new ip", "target_user_id", userEntity.ID, "ip", req.IP, "user_agent", req.UserAgent)
+	}
+
+	// 5. 실패 기록 초기화 및 마지막 활동 시간 업데이트 (읽은 뒤 바뀐 다른 컬럼은 덮어쓰지 않음)
+	userEntity.ResetLoginFailures()
+	userEntity.UpdateLastActive()
+	if err := u.userRepo.ResetLoginFailures(ctx, userEntity.ID, userEntity.LastActive); err != nil {
+		return nil, err
+	}
 
-	refreshToken, err := u.jwtService.GenerateRefreshToken(userEntity.ID, userEntity.Email)
+	// 6. JWT 토큰 생성 (로그인마다 새 토큰 계열 시작)
+	accessToken, refreshToken, err := u.issueTokens(ctx, userEntity, "")
 	if err != nil {
 		return nil, err
 	}
 
-	// 5. 응답 반환
+	u.recordLogin(ctx, userEntity.ID, req, "", newIP)
+	log.Info("user logged in", "target_user_id", userEntity.ID)
+
+	// 7. 응답 반환
 	return &dto.LoginResponse{
 		User:         *dto.FromUserEntity(userEntity),
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
-		ExpiresIn:    86400, // 24시간
+		ExpiresIn:    int(u.jwtService.AccessTokenTTL().Seconds()),
 	}, nil
 }
 
-// RefreshToken - 토큰 갱신 메서드 추가
+// RefreshToken - 토큰 갱신 (리프레시 토큰은 1회용, 재사용이 감지되면 토큰 계열 전체 폐기)
 func (u *userUsecase) RefreshToken(ctx context.Context, req *dto.RefreshTokenRequest) (*dto.RefreshTokenResponse, error) {
-	accessToken, refreshToken, err := u.jwtService.RefreshToken(req.RefreshToken)
+	ctx, span := tracing.Start(ctx, "UserUsecase.RefreshToken")
+	defer span.End()
+
+	// 1. 리프레시 토큰 검증 및 저장된 기록 조회
+	stored, err := u.findRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 이미 갱신에 사용된 토큰 재사용 → 탈취로 간주하고 계열 전체 폐기
+	if stored.IsRotated() {
+		logger.FromContext(ctx).Warn("refresh token reuse detected, revoking family",
+			"target_user_id", stored.UserID, "family_id", stored.FamilyID)
+		if err := u.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrRefreshTokenReused
+	}
+	if stored.IsRevoked() || stored.IsExpired(time.Now()) {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	// 3. 사용자 조회 (삭제된 사용자는 갱신 불가)
+	userEntity, err := u.userRepo.GetByID(ctx, stored.UserID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	// 4. 같은 계열로 새 토큰 발급 후 기존 토큰 폐기
+	accessToken, refreshToken, refreshClaims, err := u.generateTokens(userEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	rotated, err := u.refreshTokenRepo.RevokeIfActive(ctx, stored.JTI, refreshClaims.ID)
 	if err != nil {
-		return nil, errors.ErrInvalidCredentials
+		return nil, err
+	}
+	if !rotated {
+		// 동시에 같은 토큰으로 갱신 요청 → 재사용으로 처리
+		logger.FromContext(ctx).Warn("concurrent refresh token use detected, revoking family",
+			"target_user_id", stored.UserID, "family_id", stored.FamilyID)
+		if err := u.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrRefreshTokenReused
+	}
+
+	if err := u.saveRefreshToken(ctx, refreshClaims, stored.FamilyID); err != nil {
+		return nil, err
 	}
 
 	return &dto.RefreshTokenResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
-		ExpiresIn:    86400,
+		ExpiresIn:    int(u.jwtService.AccessTokenTTL().Seconds()),
 	}, nil
 }
 
+// Logout - 현재 기기 로그아웃 (리프레시 토큰이 속한 계열 폐기)
+func (u *userUsecase) Logout(ctx context.Context, userID uint, req *dto.LogoutRequest) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.Logout")
+	defer span.End()
+
+	stored, err := u.findRefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	// 다른 사용자의 토큰은 폐기 불가
+	if stored.UserID != userID {
+		logger.FromContext(ctx).Warn("logout with another user's refresh token", "target_user_id", stored.UserID)
+		return errors.ErrForbidden
+	}
+
+	if err := u.refreshTokenRepo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("user logged out", "family_id", stored.FamilyID)
+	return nil
+}
+
+// LogoutAll - 모든 기기에서 로그아웃 (사용자의 리프레시 토큰 전체 폐기)
+func (u *userUsecase) LogoutAll(ctx context.Context, userID uint) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.LogoutAll")
+	defer span.End()
+
+	if err := u.refreshTokenRepo.RevokeAllByUser(ctx, userID); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("user logged out from all devices")
+	return nil
+}
+
+// GetLoginHistory - 내 로그인 시도 기록 조회 (최근 순)
+func (u *userUsecase) GetLoginHistory(ctx context.Context, userID uint, req *dto.GetLoginHistoryRequest) (*dto.GetLoginHistoryResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserUsecase.GetLoginHistory")
+	defer span.End()
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = defaultLoginHistoryLimit
+	}
+	if req.Limit > maxLoginHistoryLimit {
+		req.Limit = maxLoginHistoryLimit
+	}
+
+	totalCount, err := u.loginHistoryRepo.CountByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := u.loginHistoryRepo.ListByUser(ctx, userID, req.GetOffset(), req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetLoginHistoryResponse{
+		Logins:     dto.FromLoginHistories(histories),
+		Page:       req.Page,
+		Limit:      req.Limit,
+		TotalCount: totalCount,
+		TotalPages: dto.CalculateTotalPages(totalCount, req.Limit),
+	}, nil
+}
+
+// ChangePassword - 현재 비밀번호 확인 후 변경 (다른 기기의 세션은 모두 로그아웃)
+func (u *userUsecase) ChangePassword(ctx context.Context, userID uint, req *dto.ChangePasswordRequest) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.ChangePassword")
+	defer span.End()
+
+	// 1. 사용자 조회 및 현재 비밀번호 확인
+	userEntity, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrUserNotFound
+		}
+		return err
+	}
+	if err := auth.CheckPassword(req.CurrentPassword, userEntity.Password); err != nil {
+		logger.FromContext(ctx).Warn("password change failed", "reason", "wrong current password")
+		return errors.ErrIncorrectPassword
+	}
+
+	// 2. 새 비밀번호 검증
+	if err := validatePassword(req.NewPassword); err != nil {
+		return err
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return errors.ErrSamePassword
+	}
+
+	// 3. 저장 후 기존 세션과 남은 재설정 링크 무효화
+	if err := u.setPassword(ctx, userEntity, req.NewPassword); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("password changed")
+	return nil
+}
+
+// ForgotPassword - 비밀번호 재설정 메일 발송
+//
+// 가입 여부가 드러나지 않도록 없는 이메일이어도 성공으로 응답한다.
+func (u *userUsecase) ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.ForgotPassword")
+	defer span.End()
+
+	log := logger.FromContext(ctx)
+
+	// 1. 이메일로 사용자 조회
+	userEntity, err := u.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			log.Info("password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	// 2. 이전에 보낸 링크 무효화 후 새 토큰 저장 (원문은 메일로만 전달)
+	if err := u.passwordResetRepo.InvalidateAllByUser(ctx, userEntity.ID); err != nil {
+		return err
+	}
+	resetToken, tokenHash, err := auth.NewSecretToken()
+	if err != nil {
+		return err
+	}
+	if err := u.passwordResetRepo.Create(ctx, &token.PasswordResetToken{
+		UserID:    userEntity.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(u.config.PasswordResetTTL),
+	}); err != nil {
+		return err
+	}
+
+	// 3. 재설정 메일 발송
+	link, err := tokenLink(u.config.PasswordResetURL, resetToken)
+	if err != nil {
+		return err
+	}
+	if err := u.mailer.Send(ctx, mailer.Message{
+		To:      userEntity.Email,
+		Subject: "[Travel Chat] 비밀번호 재설정 안내",
+		Body: fmt.Sprintf("%s님, 아래 링크에서 비밀번호를 재설정할 수 있습니다.\n\n%s\n\n"+
+			"링크는 %s 동안 한 번만 사용할 수 있습니다. 직접 요청하지 않았다면 이 메일을 무시하세요.\n",
+			userEntity.Name, link, u.config.PasswordResetTTL),
+	}); err != nil {
+		return err
+	}
+
+	log.Info("password reset mail sent", "target_user_id", userEntity.ID)
+	return nil
+}
+
+// ResetPassword - 메일로 받은 토큰으로 비밀번호 재설정 (토큰은 1회용, 성공하면 모든 기기 로그아웃 및 잠금 해제)
+func (u *userUsecase) ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.ResetPassword")
+	defer span.End()
+
+	// 1. 새 비밀번호 검증 (토큰을 쓰기 전에 확인해야 다시 시도 가능)
+	if err := validatePassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	// 2. 토큰 조회 및 유효성 확인
+	stored, err := u.passwordResetRepo.GetByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrInvalidResetToken
+		}
+		return err
+	}
+	if stored.IsUsed() || stored.IsExpired(time.Now()) {
+		return errors.ErrInvalidResetToken
+	}
+
+	// 3. 토큰 사용 처리 (동시에 같은 토큰으로 요청하면 하나만 성공)
+	used, err := u.passwordResetRepo.MarkUsedIfActive(ctx, stored.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errors.ErrInvalidResetToken
+	}
+
+	// 4. 비밀번호 변경 (메일 소유가 확인되었으므로 로그인 잠금도 해제)
+	userEntity, err := u.userRepo.GetByID(ctx, stored.UserID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrInvalidResetToken
+		}
+		return err
+	}
+	userEntity.ResetLoginFailures()
+	if err := u.setPassword(ctx, userEntity, req.NewPassword); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("password reset", "target_user_id", userEntity.ID)
+	return nil
+}
+
+// ResendVerification - 이메일 인증 메일 재발송 (이전 링크는 무효)
+func (u *userUsecase) ResendVerification(ctx context.Context, userID uint) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.ResendVerification")
+	defer span.End()
+
+	userEntity, err := u.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return errors.ErrUserNotFound
+		}
+		return err
+	}
+	if userEntity.EmailVerified {
+		return errors.ErrEmailAlreadyVerified
+	}
+
+	return u.sendVerificationMail(ctx, userEntity)
+}
+
+// VerifyEmail - 메일로 받은 토큰으로 이메일 인증 (토큰은 1회용)
+func (u *userUsecase) VerifyEmail(ctx context.Context, req *dto.VerifyEmailRequest) (*dto.UserResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserUsecase.VerifyEmail")
+	defer span.End()
+
+	// 1. 토큰 조회 및 유효성 확인
+	stored, err := u.verificationRepo.GetByHash(ctx, auth.HashToken(req.Token))
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrInvalidVerificationToken
+		}
+		return nil, err
+	}
+	if stored.IsUsed() || stored.IsExpired(time.Now()) {
+		return nil, errors.ErrInvalidVerificationToken
+	}
+
+	// 2. 토큰 사용 처리 (동시에 같은 토큰으로 요청하면 하나만 성공)
+	used, err := u.verificationRepo.MarkUsedIfActive(ctx, stored.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		return nil, errors.ErrInvalidVerificationToken
+	}
+
+	// 3. 인증 완료 처리
+	userEntity, err := u.userRepo.GetByID(ctx, stored.UserID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrInvalidVerificationToken
+		}
+		return nil, err
+	}
+	if !userEntity.EmailVerified {
+		userEntity.MarkEmailVerified(time.Now())
+		if err := u.userRepo.Update(ctx, userEntity); err != nil {
+			return nil, err
+		}
+		logger.FromContext(ctx).Info("email verified", "target_user_id", userEntity.ID)
+	}
+	if err := u.verificationRepo.InvalidateAllByUser(ctx, userEntity.ID); err != nil {
+		return nil, err
+	}
+
+	return dto.FromUserEntity(userEntity), nil
+}
+
 // GetByID - ID로 사용자 조회
 func (u *userUsecase) GetByID(ctx context.Context, id uint) (*dto.UserResponse, error) {
-	userEntity, err := u.userRepo.GetByID(id)
+	ctx, span := tracing.Start(ctx, "UserUsecase.GetByID")
+	defer span.End()
+
+	userEntity, err := u.userRepo.GetByID(ctx, id)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.ErrUserNotFound
@@ -131,7 +599,10 @@ func (u *userUsecase) GetByID(ctx context.Context, id uint) (*dto.UserResponse,
 
 // GetByEmail - 이메일로 사용자 조회
 func (u *userUsecase) GetByEmail(ctx context.Context, email string) (*dto.UserResponse, error) {
-	userEntity, err := u.userRepo.GetByEmail(email)
+	ctx, span := tracing.Start(ctx, "UserUsecase.GetByEmail")
+	defer span.End()
+
+	userEntity, err := u.userRepo.GetByEmail(ctx, email)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.ErrUserNotFound
@@ -142,8 +613,11 @@ func (u *userUsecase) GetByEmail(ctx context.Context, email string) (*dto.UserRe
 	return dto.FromUserEntity(userEntity), nil
 }
 
-// GetUsers - 사용자 목록 조회 (페이징)
-func (u *userUsecase) GetUsers(ctx context.Context, req *dto.GetUsersRequest) (*dto.GetUsersResponse, error) {
+// GetUsers - 사용자 목록 조회 (페이징, 요청자와 차단 관계인 사용자 제외)
+func (u *userUsecase) GetUsers(ctx context.Context, viewerID uint, req *dto.GetUsersRequest) (*dto.GetUsersResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserUsecase.GetUsers")
+	defer span.End()
+
 	// 기본값 설정
 	if req.Page <= 0 {
 		req.Page = 1
@@ -155,16 +629,21 @@ func (u *userUsecase) GetUsers(ctx context.Context, req *dto.GetUsersRequest) (*
 		req.Limit = 100
 	}
 
+	excludeIDs, err := relatedBlockIDs(ctx, u.blockRepo, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
 	var users []*user.User
 	var totalCount int64
-	var err error
 
 	// 필터링 조건에 따라 조회
 	if req.Country != "" && req.City != "" {
-		users, err = u.userRepo.GetByDestination(req.Country, req.City)
+		users, err = u.userRepo.GetByDestination(ctx, req.Country, req.City)
 		if err != nil {
 			return nil, err
 		}
+		users = excludeUsers(users, excludeIDs)
 		totalCount = int64(len(users))
 
 		// 메모리에서 페이징
@@ -180,12 +659,12 @@ func (u *userUsecase) GetUsers(ctx context.Context, req *dto.GetUsersRequest) (*
 		}
 	} else {
 		// 전체 카운트 조회
-		totalCount, err = u.userRepo.Count()
+		totalCount, err = u.userRepo.Count(ctx, excludeIDs)
 		if err != nil {
 			return nil, err
 		}
 
-		users, err = u.userRepo.List(req.GetOffset(), req.Limit)
+		users, err = u.userRepo.List(ctx, excludeIDs, req.GetOffset(), req.Limit)
 		if err != nil {
 			return nil, err
 		}
@@ -200,20 +679,36 @@ func (u *userUsecase) GetUsers(ctx context.Context, req *dto.GetUsersRequest) (*
 	}, nil
 }
 
-// GetUsersByDestination - 목적지별 사용자 조회
-func (u *userUsecase) GetUsersByDestination(ctx context.Context, country, city string) ([]dto.UserResponse, error) {
-	users, err := u.userRepo.GetByDestination(country, city)
+// GetUsersByDestination - 목적지별 사용자 조회 (요청자와 차단 관계인 사용자 제외)
+func (u *userUsecase) GetUsersByDestination(ctx context.Context, viewerID uint, country, city string) ([]dto.UserResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserUsecase.GetUsersByDestination")
+	defer span.End()
+
+	excludeIDs, err := relatedBlockIDs(ctx, u.blockRepo, viewerID)
 	if err != nil {
 		return nil, err
 	}
 
-	return dto.FromUserEntities(users), nil
+	users, err := u.userRepo.GetByDestination(ctx, country, city)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.FromUserEntities(excludeUsers(users, excludeIDs)), nil
 }
 
-// UpdateProfile - 사용자 프로필 업데이트
-func (u *userUsecase) UpdateProfile(ctx context.Context, userID uint, req *dto.UpdateUserRequest) (*dto.UserResponse, error) {
+// UpdateProfile - 사용자 프로필 업데이트 (본인 또는 관리자만 가능)
+func (u *userUsecase) UpdateProfile(ctx context.Context, actorID, userID uint, req *dto.UpdateUserRequest) (*dto.UserResponse, error) {
+	ctx, span := tracing.Start(ctx, "UserUsecase.UpdateProfile")
+	defer span.End()
+
+	// 0. 권한 확인
+	if err := u.authorizeUserModification(ctx, actorID, userID); err != nil {
+		return nil, err
+	}
+
 	// 1. 기존 사용자 조회
-	userEntity, err := u.userRepo.GetByID(userID)
+	userEntity, err := u.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, errors.ErrUserNotFound
@@ -230,31 +725,53 @@ func (u *userUsecase) UpdateProfile(ctx context.Context, userID uint, req *dto.U
 	}
 
 	// 4. 사용자 업데이트
-	if err := u.userRepo.Update(userEntity); err != nil {
+	if err := u.userRepo.Update(ctx, userEntity); err != nil {
 		return nil, err
 	}
 
 	return dto.FromUserEntity(userEntity), nil
 }
 
-// UpdateLastActive - 마지막 활동 시간 업데이트
-func (u *userUsecase) UpdateLastActive(ctx context.Context, userID uint) error {
-	return u.userRepo.UpdateLastActive(userID)
+// UpdateLastActive - 마지막 활동 시간 업데이트 (본인 또는 관리자만 가능)
+func (u *userUsecase) UpdateLastActive(ctx context.Context, actorID, userID uint) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.UpdateLastActive")
+	defer span.End()
+
+	if err := u.authorizeUserModification(ctx, actorID, userID); err != nil {
+		return err
+	}
+
+	// 사용자 존재 여부 확인
+	if err := u.ValidateUserExists(ctx, userID); err != nil {
+		return err
+	}
+
+	return u.userRepo.UpdateLastActive(ctx, userID)
 }
 
-// DeleteUser - 사용자 삭제
-func (u *userUsecase) DeleteUser(ctx context.Context, userID uint) error {
+// DeleteUser - 사용자 삭제 (본인 또는 관리자만 가능)
+func (u *userUsecase) DeleteUser(ctx context.Context, actorID, userID uint) error {
+	ctx, span := tracing.Start(ctx, "UserUsecase.DeleteUser")
+	defer span.End()
+
+	if err := u.authorizeUserModification(ctx, actorID, userID); err != nil {
+		return err
+	}
+
 	// 사용자 존재 여부 확인
 	if err := u.ValidateUserExists(ctx, userID); err != nil {
 		return err
 	}
 
-	return u.userRepo.Delete(userID)
+	return u.userRepo.Delete(ctx, userID)
 }
 
 // ValidateUserExists - 사용자 존재 여부 검증
 func (u *userUsecase) ValidateUserExists(ctx context.Context, userID uint) error {
-	_, err := u.userRepo.GetByID(userID)
+	ctx, span := tracing.Start(ctx, "UserUsecase.ValidateUserExists")
+	defer span.End()
+
+	_, err := u.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return errors.ErrUserNotFound
@@ -266,11 +783,28 @@ func (u *userUsecase) ValidateUserExists(ctx context.Context, userID uint) error
 
 // 비공개 헬퍼 메서드들
 
+// authorizeUserModification - 본인이 아니면 요청자를 조회해 관리자인지 확인 (토큰의 역할 대신 저장된 역할 기준)
+func (u *userUsecase) authorizeUserModification(ctx context.Context, actorID, userID uint) error {
+	if actorID != 0 && actorID == userID {
+		return nil
+	}
+
+	actor, err := loadActor(ctx, u.userRepo, actorID)
+	if err != nil {
+		return err
+	}
+
+	if !u.authorizer.CanModifyUser(actor, userID) {
+		return errors.ErrForbidden
+	}
+	return nil
+}
+
 // validateCreateUserRequest - 사용자 생성 요청 검증
 func (u *userUsecase) validateCreateUserRequest(req *dto.CreateUserRequest) error {
 	// 비밀번호 길이 체크
-	if len(req.Password) < 6 {
-		return errors.ErrWeakPassword
+	if err := validatePassword(req.Password); err != nil {
+		return err
 	}
 
 	// 여행 날짜 검증
@@ -281,6 +815,14 @@ func (u *userUsecase) validateCreateUserRequest(req *dto.CreateUserRequest) erro
 	return nil
 }
 
+// validatePassword - 비밀번호 규칙 검증
+func validatePassword(password string) error {
+	if len(password) < minPasswordLength {
+		return errors.ErrWeakPassword
+	}
+	return nil
+}
+
 // validateTravelDates - 여행 날짜 검증
 func (u *userUsecase) validateTravelDates(start, end time.Time) error {
 	now := time.Now()
@@ -297,3 +839,191 @@ func (u *userUsecase) validateTravelDates(start, end time.Time) error {
 
 	return nil
 }
+
+// registerLoginFailure - 연속 실패 횟수를 늘리고 기준을 넘으면 잠금 (호출자에게 돌려줄 에러 반환)
+func (u *userUsecase) registerLoginFailure(ctx context.Context, userID uint, now time.Time) error {
+	failures, err := u.userRepo.IncrementFailedLogins(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	lockFor := u.config.LockoutDurationFor(failures)
+	if lockFor == 0 {
+		return errors.ErrInvalidCredentials
+	}
+
+	until := now.Add(lockFor)
+	if err := u.userRepo.LockUntil(ctx, userID, until); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Warn("account locked", "target_user_id", userID, "failures", failures, "locked_for", lockFor)
+	return &errors.AccountLockedError{Until: until}
+}
+
+// setPassword - 새 비밀번호 저장 후 모든 리프레시 토큰과 남은 재설정 토큰 무효화
+func (u *userUsecase) setPassword(ctx context.Context, userEntity *user.User, password string) error {
+	hashedPassword, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+	userEntity.Password = hashedPassword
+	if err := u.userRepo.Update(ctx, userEntity); err != nil {
+		return err
+	}
+
+	if err := u.refreshTokenRepo.RevokeAllByUser(ctx, userEntity.ID); err != nil {
+		return err
+	}
+	return u.passwordResetRepo.InvalidateAllByUser(ctx, userEntity.ID)
+}
+
+// sendVerificationMail - 이전 인증 링크를 무효화하고 새 인증 메일 발송
+func (u *userUsecase) sendVerificationMail(ctx context.Context, userEntity *user.User) error {
+	if err := u.verificationRepo.InvalidateAllByUser(ctx, userEntity.ID); err != nil {
+		return err
+	}
+	verificationToken, tokenHash, err := auth.NewSecretToken()
+	if err != nil {
+		return err
+	}
+	if err := u.verificationRepo.Create(ctx, &token.EmailVerificationToken{
+		UserID:    userEntity.ID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(u.config.EmailVerificationTTL),
+	}); err != nil {
+		return err
+	}
+
+	link, err := tokenLink(u.config.EmailVerificationURL, verificationToken)
+	if err != nil {
+		return err
+	}
+	if err := u.mailer.Send(ctx, mailer.Message{
+		To:      userEntity.Email,
+		Subject: "[Travel Chat] 이메일 인증 안내",
+		Body: fmt.Sprintf("%s님, 가입을 환영합니다. 아래 링크에서 이메일 인증을 완료하면 채팅에 참여할 수 있습니다.\n\n%s\n\n"+
+			"링크는 %s 동안 유효합니다. 직접 가입하지 않았다면 이 메일을 무시하세요.\n",
+			userEntity.Name, link, u.config.EmailVerificationTTL),
+	}); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("verification mail sent", "target_user_id", userEntity.ID)
+	return nil
+}
+
+// tokenLink - 메일에 넣을 링크 (설정된 URL에 token 쿼리 파라미터 추가)
+func tokenLink(baseURL, secretToken string) (string, error) {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	query := link.Query()
+	query.Set("token", secretToken)
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
+
+// isNewLoginIP - 이전에 로그인한 IP가 있는데 이번 IP는 처음인지 확인
+func (u *userUsecase) isNewLoginIP(ctx context.Context, userID uint, ip string) (bool, error) {
+	if ip == "" {
+		return false, nil
+	}
+	knownIPs, err := u.loginHistoryRepo.KnownIPs(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return len(knownIPs) > 0 && !slices.Contains(knownIPs, ip), nil
+}
+
+// recordLogin - 로그인 시도 기록 (기록 실패는 로그인 결과에 영향 주지 않음)
+func (u *userUsecase) recordLogin(ctx context.Context, userID uint, req *dto.LoginRequest, reason user.LoginFailureReason, newIP bool) {
+	history := &user.LoginHistory{
+		UserID:        userID,
+		IP:            req.IP,
+		UserAgent:     truncateString(req.UserAgent, maxUserAgentLength),
+		Success:       reason == "",
+		FailureReason: reason,
+		NewIP:         newIP,
+	}
+	if err := u.loginHistoryRepo.Create(ctx, history); err != nil {
+		logger.FromContext(ctx).Error("failed to record login history", "target_user_id", userID, "error", err)
+	}
+}
+
+// issueTokens - 액세스/리프레시 토큰 발급 후 리프레시 토큰 저장 (familyID가 비어 있으면 새 계열 시작)
+func (u *userUsecase) issueTokens(ctx context.Context, userEntity *user.User, familyID string) (string, string, error) {
+	accessToken, refreshToken, refreshClaims, err := u.generateTokens(userEntity)
+	if err != nil {
+		return "", "", err
+	}
+
+	if familyID == "" {
+		familyID, err = jwt.NewTokenID()
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	if err := u.saveRefreshToken(ctx, refreshClaims, familyID); err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// generateTokens - 액세스/리프레시 토큰 생성 (저장 전, 리프레시 토큰 클레임 함께 반환)
+func (u *userUsecase) generateTokens(userEntity *user.User) (string, string, *jwt.JWTClaims, error) {
+	role := u.authorizer.RoleOf(userEntity)
+	accessToken, err := u.jwtService.GenerateToken(userEntity.ID, userEntity.Email, role.String())
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	refreshToken, refreshClaims, err := u.jwtService.GenerateRefreshToken(userEntity.ID, userEntity.Email)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return accessToken, refreshToken, refreshClaims, nil
+}
+
+// saveRefreshToken - 발급한 리프레시 토큰을 저장소에 기록
+func (u *userUsecase) saveRefreshToken(ctx context.Context, claims *jwt.JWTClaims, familyID string) error {
+	return u.refreshTokenRepo.Create(ctx, &token.RefreshToken{
+		JTI:       claims.ID,
+		UserID:    claims.UserID,
+		FamilyID:  familyID,
+		ExpiresAt: claims.ExpiresAt.Time,
+	})
+}
+
+// findRefreshToken - 리프레시 토큰 서명/종류 검증 후 저장된 기록 조회
+func (u *userUsecase) findRefreshToken(ctx context.Context, refreshToken string) (*token.RefreshToken, error) {
+	claims, err := u.jwtService.ValidateRefreshToken(refreshToken)
+	if err != nil {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	stored, err := u.refreshTokenRepo.GetByJTI(ctx, claims.ID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, errors.ErrInvalidRefreshToken
+		}
+		return nil, err
+	}
+
+	if stored.UserID != claims.UserID {
+		return nil, errors.ErrInvalidRefreshToken
+	}
+
+	return stored, nil
+}
+
+// truncateString - 최대 문자 수까지 자르기
+func truncateString(value string, maxLength int) string {
+	if utf8.RuneCountInString(value) <= maxLength {
+		return value
+	}
+	return string([]rune(value)[:maxLength])
+}
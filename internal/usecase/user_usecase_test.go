@@ -0,0 +1,447 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/user"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/pkg/authz"
+	"github.com/chris910512/travel-chat/internal/pkg/jwt"
+	"github.com/chris910512/travel-chat/internal/pkg/mailer"
+	"github.com/chris910512/travel-chat/internal/usecase"
+	"github.com/chris910512/travel-chat/internal/usecase/dto"
+	usecaseErrors "github.com/chris910512/travel-chat/internal/usecase/errors"
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+)
+
+const adminID = 100
+
+func newTestUserUsecase() usecaseInterface.UserUsecase {
+	return newTestUserUsecaseWithMail(io.Discard)
+}
+
+// newTestUserUsecaseWithMail - 보낸 메일을 mail에 기록하는 UserUsecase
+func newTestUserUsecaseWithMail(mail io.Writer) usecaseInterface.UserUsecase {
+	// ADMIN_USER_IDS로 지정된 관리자도 저장된 사용자여야 권한이 있음 (빈 저장소라 실패하지 않음)
+	userRepo := memory.NewUserRepository()
+	userRepo.Create(context.Background(), &user.User{ID: adminID, Email: "config-admin@example.com", Password: "hashed-password", Name: "config-admin"})
+	return usecase.NewUserUsecase(
+		userRepo,
+		memory.NewRefreshTokenRepository(),
+		memory.NewLoginHistoryRepository(),
+		memory.NewPasswordResetTokenRepository(),
+		memory.NewEmailVerificationTokenRepository(),
+		memory.NewUserBlockRepository(),
+		jwt.NewJWTService("test-secret", "travel-chat-test", 0, 0),
+		authz.NewAuthorizer([]uint{adminID}),
+		mailer.NewWriterMailer(mail, "no-reply@example.com"),
+		usecase.UserConfig{LockoutThreshold: 3, LockoutDuration: time.Minute, MaxLockoutDuration: 10 * time.Minute},
+	)
+}
+
+func registerUser(t *testing.T, uc usecaseInterface.UserUsecase, email string) *dto.UserResponse {
+	t.Helper()
+	travelStart := time.Now().Add(24 * time.Hour)
+	user, err := uc.Register(context.Background(), &dto.CreateUserRequest{
+		Email:       email,
+		Password:    "password123",
+		Name:        "테스트",
+		Age:         28,
+		Gender:      "male",
+		Country:     "일본",
+		City:        "도쿄",
+		TravelStart: travelStart,
+		TravelEnd:   travelStart.Add(6 * 24 * time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Register(%s): %v", email, err)
+	}
+	return user
+}
+
+func login(t *testing.T, uc usecaseInterface.UserUsecase, email string) *dto.LoginResponse {
+	t.Helper()
+	resp, err := uc.Login(context.Background(), &dto.LoginRequest{Email: email, Password: "password123"})
+	if err != nil {
+		t.Fatalf("Login(%s): %v", email, err)
+	}
+	return resp
+}
+
+func TestUpdateProfileOwnership(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUserUsecase()
+	owner := registerUser(t, uc, "owner@example.com")
+	other := registerUser(t, uc, "other@example.com")
+
+	newName := "새 이름"
+	req := &dto.UpdateUserRequest{Name: &newName}
+
+	if _, err := uc.UpdateProfile(ctx, other.ID, owner.ID, req); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected ErrForbidden for another user, got %v", err)
+	}
+	if err := uc.DeleteUser(ctx, other.ID, owner.ID); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected ErrForbidden for another user, got %v", err)
+	}
+	if err := uc.UpdateLastActive(ctx, other.ID, owner.ID); !usecaseErrors.IsForbidden(err) {
+		t.Errorf("expected ErrForbidden for another user, got %v", err)
+	}
+
+	updated, err := uc.UpdateProfile(ctx, owner.ID, owner.ID, req)
+	if err != nil {
+		t.Fatalf("UpdateProfile by owner: %v", err)
+	}
+	if updated.Name != newName {
+		t.Errorf("expected name %q, got %q", newName, updated.Name)
+	}
+
+	if err := uc.DeleteUser(ctx, adminID, owner.ID); err != nil {
+		t.Fatalf("DeleteUser by admin: %v", err)
+	}
+	if _, err := uc.GetByID(ctx, owner.ID); !usecaseErrors.IsUserNotFound(err) {
+		t.Errorf("expected deleted user to be gone, got %v", err)
+	}
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUserUsecase()
+	registerUser(t, uc, "rotate@example.com")
+	session := login(t, uc, "rotate@example.com")
+
+	// 액세스 토큰은 리프레시 토큰으로 사용할 수 없음
+	if _, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: session.AccessToken}); !usecaseErrors.IsInvalidRefreshToken(err) {
+		t.Fatalf("expected access token to be rejected, got %v", err)
+	}
+
+	rotated, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: session.RefreshToken})
+	if err != nil {
+		t.Fatalf("RefreshToken: %v", err)
+	}
+	if rotated.RefreshToken == session.RefreshToken {
+		t.Fatal("expected a new refresh token")
+	}
+
+	// 이미 사용된 토큰 재사용 → 계열 전체 폐기
+	if _, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: session.RefreshToken}); !usecaseErrors.IsRefreshTokenReused(err) {
+		t.Fatalf("expected reuse to be detected, got %v", err)
+	}
+	if _, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: rotated.RefreshToken}); !usecaseErrors.IsInvalidRefreshToken(err) {
+		t.Fatalf("expected the whole family to be revoked, got %v", err)
+	}
+}
+
+func TestAccessTokenCarriesRole(t *testing.T) {
+	uc := newTestUserUsecase()
+	registerUser(t, uc, "role@example.com")
+	session := login(t, uc, "role@example.com")
+
+	claims, err := jwt.NewJWTService("test-secret", "travel-chat-test", 0, 0).ValidateAccessToken(session.AccessToken)
+	if err != nil {
+		t.Fatalf("ValidateAccessToken: %v", err)
+	}
+	if claims.Role != "user" {
+		t.Errorf("expected role user, got %q", claims.Role)
+	}
+	if session.User.Role != "user" {
+		t.Errorf("expected response role user, got %q", session.User.Role)
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUserUsecase()
+	user := registerUser(t, uc, "logout@example.com")
+	other := registerUser(t, uc, "intruder@example.com")
+	phone := login(t, uc, "logout@example.com")
+	laptop := login(t, uc, "logout@example.com")
+	tablet := login(t, uc, "logout@example.com")
+
+	if err := uc.Logout(ctx, other.ID, &dto.LogoutRequest{RefreshToken: phone.RefreshToken}); !usecaseErrors.IsForbidden(err) {
+		t.Fatalf("expected ErrForbidden for another user's token, got %v", err)
+	}
+
+	if err := uc.Logout(ctx, user.ID, &dto.LogoutRequest{RefreshToken: phone.RefreshToken}); err != nil {
+		t.Fatalf("Logout: %v", err)
+	}
+	if _, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: phone.RefreshToken}); !usecaseErrors.IsInvalidRefreshToken(err) {
+		t.Errorf("expected logged out session to be revoked, got %v", err)
+	}
+
+	laptop2, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: laptop.RefreshToken})
+	if err != nil {
+		t.Fatalf("expected other sessions to stay valid, got %v", err)
+	}
+
+	if err := uc.LogoutAll(ctx, user.ID); err != nil {
+		t.Fatalf("LogoutAll: %v", err)
+	}
+	for _, refreshToken := range []string{laptop2.RefreshToken, tablet.RefreshToken} {
+		if _, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: refreshToken}); !usecaseErrors.IsInvalidRefreshToken(err) {
+			t.Errorf("expected all sessions to be revoked, got %v", err)
+		}
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUserUsecase()
+	user := registerUser(t, uc, "locked@example.com")
+
+	wrong := &dto.LoginRequest{Email: "locked@example.com", Password: "wrong-password", IP: "203.0.113.7", UserAgent: "curl/8.0"}
+	for i := 0; i < 2; i++ {
+		if _, err := uc.Login(ctx, wrong); !usecaseErrors.IsInvalidCredentials(err) {
+			t.Fatalf("attempt %d: expected ErrInvalidCredentials, got %v", i+1, err)
+		}
+	}
+
+	// 세 번째 실패에서 잠금
+	_, err := uc.Login(ctx, wrong)
+	until, ok := usecaseErrors.LockedUntil(err)
+	if !usecaseErrors.IsAccountLocked(err) || !ok {
+		t.Fatalf("expected ErrAccountLocked, got %v", err)
+	}
+	if lockFor := time.Until(until); lockFor <= 0 || lockFor > time.Minute {
+		t.Errorf("expected a one minute lock, got %v", lockFor)
+	}
+
+	// 잠금 중에는 올바른 비밀번호도 거부
+	if _, err := uc.Login(ctx, &dto.LoginRequest{Email: "locked@example.com", Password: "password123"}); !usecaseErrors.IsAccountLocked(err) {
+		t.Fatalf("expected locked account to reject the right password, got %v", err)
+	}
+
+	history, err := uc.GetLoginHistory(ctx, user.ID, &dto.GetLoginHistoryRequest{})
+	if err != nil {
+		t.Fatalf("GetLoginHistory: %v", err)
+	}
+	if history.TotalCount != 4 || len(history.Logins) != 4 {
+		t.Fatalf("expected 4 recorded attempts, got %d", history.TotalCount)
+	}
+	latest, first := history.Logins[0], history.Logins[3]
+	if latest.Success || latest.FailureReason != "locked" {
+		t.Errorf("expected latest attempt to be rejected as locked, got %+v", latest)
+	}
+	if first.FailureReason != "wrong_password" || first.IP != "203.0.113.7" || first.UserAgent != "curl/8.0" {
+		t.Errorf("unexpected first attempt: %+v", first)
+	}
+}
+
+func TestLockoutDurationGrows(t *testing.T) {
+	config := usecase.UserConfig{LockoutThreshold: 5, LockoutDuration: time.Minute, MaxLockoutDuration: 10 * time.Minute}
+	want := map[int]time.Duration{
+		4:  0,
+		5:  time.Minute,
+		6:  2 * time.Minute,
+		8:  8 * time.Minute,
+		9:  10 * time.Minute,
+		50: 10 * time.Minute,
+	}
+	for failures, duration := range want {
+		if got := config.LockoutDurationFor(failures); got != duration {
+			t.Errorf("LockoutDurationFor(%d) = %v, want %v", failures, got, duration)
+		}
+	}
+}
+
+func TestLoginFromNewIP(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUserUsecase()
+	user := registerUser(t, uc, "traveler@example.com")
+
+	for _, ip := range []string{"198.51.100.1", "198.51.100.1", "192.0.2.44"} {
+		if _, err := uc.Login(ctx, &dto.LoginRequest{Email: "traveler@example.com", Password: "password123", IP: ip}); err != nil {
+			t.Fatalf("Login from %s: %v", ip, err)
+		}
+	}
+
+	history, err := uc.GetLoginHistory(ctx, user.ID, &dto.GetLoginHistoryRequest{Limit: 10})
+	if err != nil {
+		t.Fatalf("GetLoginHistory: %v", err)
+	}
+	var newIPs []bool
+	for _, login := range history.Logins {
+		newIPs = append(newIPs, login.NewIP)
+	}
+	// 최근 순: 새 IP, 같은 IP, 첫 로그인
+	if len(newIPs) != 3 || !newIPs[0] || newIPs[1] || newIPs[2] {
+		t.Errorf("expected only the login from the second IP to be flagged, got %v", newIPs)
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	ctx := context.Background()
+	uc := newTestUserUsecase()
+	user := registerUser(t, uc, "alice@example.com")
+	session := login(t, uc, "alice@example.com")
+
+	cases := []struct {
+		name string
+		req  dto.ChangePasswordRequest
+		want error
+	}{
+		{"wrong current password", dto.ChangePasswordRequest{CurrentPassword: "wrong", NewPassword: "newpass123"}, usecaseErrors.ErrIncorrectPassword},
+		{"weak new password", dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "123"}, usecaseErrors.ErrWeakPassword},
+		{"same password", dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "password123"}, usecaseErrors.ErrSamePassword},
+	}
+	for _, tc := range cases {
+		if err := uc.ChangePassword(ctx, user.ID, &tc.req); err != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	if err := uc.ChangePassword(ctx, user.ID, &dto.ChangePasswordRequest{CurrentPassword: "password123", NewPassword: "newpass123"}); err != nil {
+		t.Fatalf("ChangePassword: %v", err)
+	}
+
+	// 기존 세션은 로그아웃되고 새 비밀번호로만 로그인 가능
+	if _, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: session.RefreshToken}); !usecaseErrors.IsInvalidRefreshToken(err) {
+		t.Errorf("expected old refresh token to be revoked, got %v", err)
+	}
+	if _, err := uc.Login(ctx, &dto.LoginRequest{Email: "alice@example.com", Password: "password123"}); !usecaseErrors.IsInvalidCredentials(err) {
+		t.Errorf("expected old password to be rejected, got %v", err)
+	}
+	if _, err := uc.Login(ctx, &dto.LoginRequest{Email: "alice@example.com", Password: "newpass123"}); err != nil {
+		t.Errorf("expected new password to work, got %v", err)
+	}
+}
+
+var mailTokenPattern = regexp.MustCompile(`token=([A-Za-z0-9_-]+)`)
+
+// mailToken - 마지막으로 보낸 메일 링크의 토큰
+func mailToken(t *testing.T, mail *bytes.Buffer) string {
+	t.Helper()
+	matches := mailTokenPattern.FindAllStringSubmatch(mail.String(), -1)
+	if matches == nil {
+		t.Fatalf("expected token link in mail, got:\n%s", mail.String())
+	}
+	return matches[len(matches)-1][1]
+}
+
+// requestPasswordReset - 재설정 메일을 요청하고 메일에 담긴 토큰 반환
+func requestPasswordReset(t *testing.T, uc usecaseInterface.UserUsecase, mail *bytes.Buffer, email string) string {
+	t.Helper()
+	mail.Reset()
+	if err := uc.ForgotPassword(context.Background(), &dto.ForgotPasswordRequest{Email: email}); err != nil {
+		t.Fatalf("ForgotPassword(%s): %v", email, err)
+	}
+	return mailToken(t, mail)
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	var mail bytes.Buffer
+	uc := newTestUserUsecaseWithMail(&mail)
+	registerUser(t, uc, "alice@example.com")
+	session := login(t, uc, "alice@example.com")
+
+	// 가입하지 않은 이메일도 성공으로 응답하지만 메일은 보내지 않음
+	mail.Reset()
+	if err := uc.ForgotPassword(ctx, &dto.ForgotPasswordRequest{Email: "nobody@example.com"}); err != nil {
+		t.Fatalf("ForgotPassword(unknown): %v", err)
+	}
+	if mail.Len() != 0 {
+		t.Errorf("expected no mail for unknown email, got:\n%s", mail.String())
+	}
+
+	// 새 링크를 요청하면 이전 링크는 무효
+	oldToken := requestPasswordReset(t, uc, &mail, "alice@example.com")
+	resetToken := requestPasswordReset(t, uc, &mail, "alice@example.com")
+	if err := uc.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: oldToken, NewPassword: "newpass123"}); !usecaseErrors.IsInvalidResetToken(err) {
+		t.Errorf("expected superseded token to be rejected, got %v", err)
+	}
+
+	// 약한 비밀번호로 실패해도 토큰은 그대로 사용 가능
+	if err := uc.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: resetToken, NewPassword: "123"}); !usecaseErrors.IsWeakPassword(err) {
+		t.Errorf("expected weak password error, got %v", err)
+	}
+	if err := uc.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: resetToken, NewPassword: "newpass123"}); err != nil {
+		t.Fatalf("ResetPassword: %v", err)
+	}
+
+	// 토큰은 한 번만 사용 가능
+	if err := uc.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: resetToken, NewPassword: "another123"}); !usecaseErrors.IsInvalidResetToken(err) {
+		t.Errorf("expected used token to be rejected, got %v", err)
+	}
+	if err := uc.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: "not-a-token", NewPassword: "another123"}); !usecaseErrors.IsInvalidResetToken(err) {
+		t.Errorf("expected unknown token to be rejected, got %v", err)
+	}
+
+	if _, err := uc.RefreshToken(ctx, &dto.RefreshTokenRequest{RefreshToken: session.RefreshToken}); !usecaseErrors.IsInvalidRefreshToken(err) {
+		t.Errorf("expected sessions to be revoked after reset, got %v", err)
+	}
+	if _, err := uc.Login(ctx, &dto.LoginRequest{Email: "alice@example.com", Password: "newpass123"}); err != nil {
+		t.Errorf("expected new password to work, got %v", err)
+	}
+}
+
+func TestPasswordResetUnlocksAccount(t *testing.T) {
+	ctx := context.Background()
+	var mail bytes.Buffer
+	uc := newTestUserUsecaseWithMail(&mail)
+	registerUser(t, uc, "alice@example.com")
+
+	for i := 0; i < 3; i++ {
+		uc.Login(ctx, &dto.LoginRequest{Email: "alice@example.com", Password: "wrong"})
+	}
+	if _, err := uc.Login(ctx, &dto.LoginRequest{Email: "alice@example.com", Password: "password123"}); !usecaseErrors.IsAccountLocked(err) {
+		t.Fatalf("expected account to be locked, got %v", err)
+	}
+
+	resetToken := requestPasswordReset(t, uc, &mail, "alice@example.com")
+	if err := uc.ResetPassword(ctx, &dto.ResetPasswordRequest{Token: resetToken, NewPassword: "newpass123"}); err != nil {
+		t.Fatalf("ResetPassword: %v", err)
+	}
+	if _, err := uc.Login(ctx, &dto.LoginRequest{Email: "alice@example.com", Password: "newpass123"}); err != nil {
+		t.Errorf("expected reset to unlock the account, got %v", err)
+	}
+}
+
+func TestEmailVerification(t *testing.T) {
+	ctx := context.Background()
+	var mail bytes.Buffer
+	uc := newTestUserUsecaseWithMail(&mail)
+	user := registerUser(t, uc, "alice@example.com")
+	if user.EmailVerified {
+		t.Fatal("expected new user to be unverified")
+	}
+
+	// 가입 시 인증 메일 발송, 미인증 사용자는 탐색에서 제외
+	firstToken := mailToken(t, &mail)
+	if users, err := uc.GetUsersByDestination(ctx, 0, "일본", "도쿄"); err != nil || len(users) != 0 {
+		t.Errorf("expected unverified user to be hidden, got %d users (%v)", len(users), err)
+	}
+
+	// 재발송하면 이전 링크는 무효
+	if err := uc.ResendVerification(ctx, user.ID); err != nil {
+		t.Fatalf("ResendVerification: %v", err)
+	}
+	verificationToken := mailToken(t, &mail)
+	if _, err := uc.VerifyEmail(ctx, &dto.VerifyEmailRequest{Token: firstToken}); !usecaseErrors.IsInvalidVerificationToken(err) {
+		t.Errorf("expected superseded token to be rejected, got %v", err)
+	}
+
+	verified, err := uc.VerifyEmail(ctx, &dto.VerifyEmailRequest{Token: verificationToken})
+	if err != nil {
+		t.Fatalf("VerifyEmail: %v", err)
+	}
+	if !verified.EmailVerified {
+		t.Error("expected user to be verified")
+	}
+
+	// 토큰은 한 번만 사용 가능, 인증 후에는 재발송 불가
+	if _, err := uc.VerifyEmail(ctx, &dto.VerifyEmailRequest{Token: verificationToken}); !usecaseErrors.IsInvalidVerificationToken(err) {
+		t.Errorf("expected used token to be rejected, got %v", err)
+	}
+	if err := uc.ResendVerification(ctx, user.ID); !usecaseErrors.IsEmailAlreadyVerified(err) {
+		t.Errorf("expected already verified error, got %v", err)
+	}
+
+	if users, err := uc.GetUsersByDestination(ctx, 0, "일본", "도쿄"); err != nil || len(users) != 1 {
+		t.Errorf("expected verified user to be listed, got %d users (%v)", len(users), err)
+	}
+}
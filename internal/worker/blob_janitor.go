@@ -0,0 +1,115 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+)
+
+// BlobJanitorConfig - 만료 파일 정리 작업 설정
+type BlobJanitorConfig struct {
+	Interval         time.Duration // 정리 주기
+	BatchSize        int           // 한 번에 삭제할 최대 파일 수
+	MaxBatchesPerRun int           // 주기당 최대 배치 수 (저장소 부하 제한)
+}
+
+// DefaultBlobJanitorConfig - 기본 설정 (1분마다 100개씩 최대 10배치)
+func DefaultBlobJanitorConfig() BlobJanitorConfig {
+	return BlobJanitorConfig{
+		Interval:         time.Minute,
+		BatchSize:        100,
+		MaxBatchesPerRun: 10,
+	}
+}
+
+// BlobJanitor - 메시지에 첨부되지 않았거나 메시지와 함께 만료된 업로드 파일을 주기적으로 삭제
+type BlobJanitor struct {
+	mediaUsecase usecaseInterface.MediaUsecase
+	config       BlobJanitorConfig
+	done         chan struct{}
+}
+
+// NewBlobJanitor - BlobJanitor 생성자
+func NewBlobJanitor(mediaUsecase usecaseInterface.MediaUsecase, config BlobJanitorConfig) *BlobJanitor {
+	defaults := DefaultBlobJanitorConfig()
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.MaxBatchesPerRun <= 0 {
+		config.MaxBatchesPerRun = defaults.MaxBatchesPerRun
+	}
+
+	return &BlobJanitor{
+		mediaUsecase: mediaUsecase,
+		config:       config,
+		done:         make(chan struct{}),
+	}
+}
+
+// Start - 정리 작업을 백그라운드에서 시작 (ctx 취소 시 종료)
+func (j *BlobJanitor) Start(ctx context.Context) {
+	go func() {
+		defer close(j.done)
+		Supervise(ctx, "blob-janitor", j.run)
+	}()
+}
+
+// Done - 정리 작업이 완전히 종료되면 닫히는 채널
+func (j *BlobJanitor) Done() <-chan struct{} {
+	return j.done
+}
+
+// PurgeExpired - 만료된 파일을 배치 단위로 삭제하고 삭제된 총 개수 반환
+func (j *BlobJanitor) PurgeExpired(ctx context.Context) (int, error) {
+	now := time.Now()
+	total := 0
+
+	for batch := 0; batch < j.config.MaxBatchesPerRun; batch++ {
+		if ctx.Err() != nil {
+			return total, nil
+		}
+
+		purged, err := j.mediaUsecase.PurgeExpired(ctx, now, j.config.BatchSize)
+		total += purged
+		if err != nil {
+			return total, err
+		}
+
+		// 마지막 배치 (더 이상 만료된 파일 없음)
+		if purged < j.config.BatchSize {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+// run - 주기적으로 PurgeExpired 실행
+func (j *BlobJanitor) run(ctx context.Context) {
+	slog.Info("blob janitor started", "interval", j.config.Interval, "batch_size", j.config.BatchSize)
+	defer slog.Info("blob janitor stopped")
+
+	ticker := time.NewTicker(j.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := j.PurgeExpired(ctx)
+			if err != nil {
+				slog.Error("blob janitor failed", "purged_before_failure", purged, "error", err)
+				continue
+			}
+			if purged > 0 {
+				slog.Info("blob janitor purged expired blobs", "count", purged)
+			}
+		}
+	}
+}
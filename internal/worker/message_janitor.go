@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/pkg/metrics"
+	"github.com/chris910512/travel-chat/internal/pkg/tracing"
+)
+
+// MessageJanitorConfig - 만료 메시지 정리 작업 설정
+type MessageJanitorConfig struct {
+	Interval         time.Duration // 정리 주기
+	BatchSize        int           // 한 번에 삭제할 최대 메시지 수
+	MaxBatchesPerRun int           // 주기당 최대 배치 수 (DB 부하 제한)
+}
+
+// DefaultMessageJanitorConfig - 기본 설정 (1분마다 500개씩 최대 20배치)
+func DefaultMessageJanitorConfig() MessageJanitorConfig {
+	return MessageJanitorConfig{
+		Interval:         time.Minute,
+		BatchSize:        500,
+		MaxBatchesPerRun: 20,
+	}
+}
+
+// MessageJanitor - 보관 기간이 지나 만료된 메시지를 주기적으로 삭제
+type MessageJanitor struct {
+	messageRepo repository.MessageRepository
+	config      MessageJanitorConfig
+	done        chan struct{}
+}
+
+// NewMessageJanitor - MessageJanitor 생성자
+func NewMessageJanitor(messageRepo repository.MessageRepository, config MessageJanitorConfig) *MessageJanitor {
+	defaults := DefaultMessageJanitorConfig()
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaults.BatchSize
+	}
+	if config.MaxBatchesPerRun <= 0 {
+		config.MaxBatchesPerRun = defaults.MaxBatchesPerRun
+	}
+
+	return &MessageJanitor{
+		messageRepo: messageRepo,
+		config:      config,
+		done:        make(chan struct{}),
+	}
+}
+
+// Start - 정리 작업을 백그라운드에서 시작 (ctx 취소 시 종료)
+func (j *MessageJanitor) Start(ctx context.Context) {
+	go func() {
+		defer close(j.done)
+		Supervise(ctx, "message-janitor", j.run)
+	}()
+}
+
+// Done - 정리 작업이 완전히 종료되면 닫히는 채널
+func (j *MessageJanitor) Done() <-chan struct{} {
+	return j.done
+}
+
+// PurgeExpired - 만료된 메시지를 배치 단위로 삭제하고 삭제된 총 개수 반환
+func (j *MessageJanitor) PurgeExpired(ctx context.Context) (int64, error) {
+	ctx, span := tracing.Start(ctx, "MessageJanitor.PurgeExpired")
+	defer span.End()
+
+	now := time.Now()
+	var total int64
+
+	for batch := 0; batch < j.config.MaxBatchesPerRun; batch++ {
+		if ctx.Err() != nil {
+			return total, nil
+		}
+
+		deleted, err := j.messageRepo.DeleteExpiredBatch(ctx, now, j.config.BatchSize)
+		total += deleted
+		metrics.ExpiredMessagesPurged(deleted)
+		if err != nil {
+			return total, err
+		}
+
+		// 마지막 배치 (더 이상 만료된 메시지 없음)
+		if deleted < int64(j.config.BatchSize) {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+// run - 주기적으로 PurgeExpired 실행
+func (j *MessageJanitor) run(ctx context.Context) {
+	slog.Info("message janitor started", "interval", j.config.Interval, "batch_size", j.config.BatchSize)
+	defer slog.Info("message janitor stopped")
+
+	ticker := time.NewTicker(j.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := j.PurgeExpired(ctx)
+			if err != nil {
+				slog.Error("message janitor failed", "purged_before_failure", deleted, "error", err)
+				continue
+			}
+			if deleted > 0 {
+				slog.Info("message janitor purged expired messages", "count", deleted)
+			}
+		}
+	}
+}
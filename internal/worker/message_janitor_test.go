@@ -0,0 +1,105 @@
+package worker_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/domain/entity/message"
+	"github.com/chris910512/travel-chat/internal/domain/repository"
+	"github.com/chris910512/travel-chat/internal/infrastructure/repository/memory"
+	"github.com/chris910512/travel-chat/internal/worker"
+)
+
+// batchRecorder - 배치마다 삭제된 행 수를 기록하는 MessageRepository
+type batchRecorder struct {
+	repository.MessageRepository
+	batches []int64
+}
+
+func (r *batchRecorder) DeleteExpiredBatch(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	deleted, err := r.MessageRepository.DeleteExpiredBatch(ctx, before, batchSize)
+	r.batches = append(r.batches, deleted)
+	return deleted, err
+}
+
+// newMessageRepo - 만료된 메시지 expired개와 유효한 메시지 live개가 있는 저장소
+func newMessageRepo(t *testing.T, expired, live int) *batchRecorder {
+	t.Helper()
+	ctx := context.Background()
+	repo := memory.NewMessageRepository()
+	now := time.Now()
+	for i := 0; i < expired+live; i++ {
+		expiresAt := now.Add(-time.Duration(i+1) * time.Minute)
+		if i >= expired {
+			expiresAt = now.Add(time.Hour)
+		}
+		msg := &message.Message{ChatRoomID: 1, UserID: 1, Content: "안녕하세요", CreatedAt: now.Add(-2 * time.Hour), ExpiresAt: &expiresAt}
+		if err := repo.Create(ctx, msg); err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+	}
+	return &batchRecorder{MessageRepository: repo}
+}
+
+func TestMessageJanitorPurgesInBatches(t *testing.T) {
+	ctx := context.Background()
+	repo := newMessageRepo(t, 12, 2)
+	janitor := worker.NewMessageJanitor(repo, worker.MessageJanitorConfig{BatchSize: 5, MaxBatchesPerRun: 10})
+
+	deleted, err := janitor.PurgeExpired(ctx)
+	if err != nil {
+		t.Fatalf("PurgeExpired: %v", err)
+	}
+	if deleted != 12 {
+		t.Errorf("expected 12 deleted, got %d", deleted)
+	}
+	if want := []int64{5, 5, 2}; !slices.Equal(repo.batches, want) {
+		t.Errorf("expected batches %v, got %v", want, repo.batches)
+	}
+	if remaining, err := repo.Count(ctx); err != nil || remaining != 2 {
+		t.Errorf("expected 2 live messages to remain, got %d (%v)", remaining, err)
+	}
+}
+
+func TestMessageJanitorLimitsBatchesPerRun(t *testing.T) {
+	ctx := context.Background()
+	repo := newMessageRepo(t, 12, 0)
+	janitor := worker.NewMessageJanitor(repo, worker.MessageJanitorConfig{BatchSize: 5, MaxBatchesPerRun: 2})
+
+	deleted, err := janitor.PurgeExpired(ctx)
+	if err != nil || deleted != 10 {
+		t.Fatalf("expected 10 deleted in the first run, got %d (%v)", deleted, err)
+	}
+	if remaining, err := repo.Count(ctx); err != nil || remaining != 2 {
+		t.Errorf("expected 2 expired messages to be left for the next run, got %d (%v)", remaining, err)
+	}
+
+	deleted, err = janitor.PurgeExpired(ctx)
+	if err != nil || deleted != 2 {
+		t.Errorf("expected the rest to be deleted in the next run, got %d (%v)", deleted, err)
+	}
+	if want := []int64{5, 5, 2}; !slices.Equal(repo.batches, want) {
+		t.Errorf("expected batches %v, got %v", want, repo.batches)
+	}
+}
+
+func TestMessageJanitorStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	janitor := worker.NewMessageJanitor(newMessageRepo(t, 0, 0), worker.MessageJanitorConfig{Interval: time.Hour})
+	janitor.Start(ctx)
+
+	select {
+	case <-janitor.Done():
+		t.Fatal("janitor stopped before the context was canceled")
+	default:
+	}
+
+	cancel()
+	select {
+	case <-janitor.Done():
+	case <-time.After(time.Second):
+		t.Fatal("expected Done to be closed after the context is canceled")
+	}
+}
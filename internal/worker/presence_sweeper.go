@@ -0,0 +1,88 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	usecaseInterface "github.com/chris910512/travel-chat/internal/usecase/interface"
+)
+
+// 종료 시 마지막 활동 시간 반영에 허용되는 시간
+const presenceFinalFlushTimeout = 5 * time.Second
+
+// PresenceSweeperConfig - 접속 상태 정리 작업 설정
+type PresenceSweeperConfig struct {
+	Interval time.Duration // 상태 재계산 및 활동 시간 DB 반영 주기
+}
+
+// DefaultPresenceSweeperConfig - 기본 설정 (30초마다)
+func DefaultPresenceSweeperConfig() PresenceSweeperConfig {
+	return PresenceSweeperConfig{
+		Interval: 30 * time.Second,
+	}
+}
+
+// PresenceSweeper - 접속 상태 변경(자리 비움/오프라인) 알림과 활동 시간 DB 반영을 주기적으로 실행
+type PresenceSweeper struct {
+	presenceUsecase usecaseInterface.PresenceUsecase
+	config          PresenceSweeperConfig
+	done            chan struct{}
+}
+
+// NewPresenceSweeper - PresenceSweeper 생성자
+func NewPresenceSweeper(presenceUsecase usecaseInterface.PresenceUsecase, config PresenceSweeperConfig) *PresenceSweeper {
+	if config.Interval <= 0 {
+		config.Interval = DefaultPresenceSweeperConfig().Interval
+	}
+
+	return &PresenceSweeper{
+		presenceUsecase: presenceUsecase,
+		config:          config,
+		done:            make(chan struct{}),
+	}
+}
+
+// Start - 정리 작업을 백그라운드에서 시작 (ctx 취소 시 마지막으로 한 번 더 반영 후 종료)
+func (s *PresenceSweeper) Start(ctx context.Context) {
+	go func() {
+		defer close(s.done)
+		Supervise(ctx, "presence-sweeper", s.run)
+		s.flush()
+	}()
+}
+
+// Done - 정리 작업이 완전히 종료되면 닫히는 채널
+func (s *PresenceSweeper) Done() <-chan struct{} {
+	return s.done
+}
+
+// run - 주기적으로 Sweep 실행
+func (s *PresenceSweeper) run(ctx context.Context) {
+	slog.Info("presence sweeper started", "interval", s.config.Interval)
+	defer slog.Info("presence sweeper stopped")
+
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.presenceUsecase.Sweep(ctx); err != nil {
+				slog.Error("presence sweep failed", "error", err)
+			}
+		}
+	}
+}
+
+// flush - 종료 전 남은 활동 시간 반영
+func (s *PresenceSweeper) flush() {
+	ctx, cancel := context.WithTimeout(context.Background(), presenceFinalFlushTimeout)
+	defer cancel()
+
+	if err := s.presenceUsecase.Sweep(ctx); err != nil {
+		slog.Error("presence final flush failed", "error", err)
+	}
+}
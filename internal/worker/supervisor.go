@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+)
+
+const (
+	// 패닉 후 재시작 대기 시간 (지수 증가)
+	minRestartBackoff = time.Second
+	maxRestartBackoff = time.Minute
+)
+
+// Supervise - 백그라운드 작업을 실행하고 패닉이 발생하면 재시작
+// ctx가 취소되어 fn이 정상 종료되면 반환한다.
+func Supervise(ctx context.Context, name string, fn func(ctx context.Context)) {
+	backoff := minRestartBackoff
+
+	for {
+		if !runProtected(ctx, name, fn) {
+			return
+		}
+
+		slog.Warn("worker restarting", "worker", name, "backoff", backoff)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxRestartBackoff {
+			backoff = maxRestartBackoff
+		}
+	}
+}
+
+// runProtected - fn 실행 (패닉이 발생하면 true 반환)
+func runProtected(ctx context.Context, name string, fn func(ctx context.Context)) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			slog.Error("worker panicked", "worker", name, "panic", r, "stack", string(debug.Stack()))
+			panicked = true
+		}
+	}()
+
+	fn(ctx)
+	return false
+}
@@ -0,0 +1,69 @@
+package worker_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/chris910512/travel-chat/internal/worker"
+)
+
+func TestSuperviseRestartsAfterPanic(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var runs atomic.Int32
+	restarted := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		worker.Supervise(ctx, "test", func(ctx context.Context) {
+			if runs.Add(1) == 1 {
+				panic("boom")
+			}
+			close(restarted)
+			<-ctx.Done()
+		})
+	}()
+
+	select {
+	case <-restarted:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected worker to be restarted after panic, ran %d times", runs.Load())
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Supervise to return after the context is canceled")
+	}
+	if got := runs.Load(); got != 2 {
+		t.Errorf("expected 2 runs, got %d", got)
+	}
+}
+
+func TestSuperviseStopsDuringBackoff(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var runs atomic.Int32
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		worker.Supervise(ctx, "test", func(ctx context.Context) {
+			runs.Add(1)
+			cancel()
+			panic("boom")
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Supervise to stop instead of waiting to restart")
+	}
+	if got := runs.Load(); got != 1 {
+		t.Errorf("expected 1 run, got %d", got)
+	}
+}
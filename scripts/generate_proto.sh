@@ -23,7 +23,9 @@ protoc \
     --grpc-gateway_out=. \
     --grpc-gateway_opt=paths=source_relative \
     --openapiv2_out=./docs \
-    pkg/proto/user/user.proto
+    pkg/proto/user/user.proto \
+    pkg/proto/chat/chat.proto \
+    pkg/proto/admin/admin.proto
 
 echo "Protocol Buffer generation complete!"
 
@@ -33,4 +35,12 @@ echo "- pkg/proto/user/user.pb.go"
 echo "- pkg/proto/user/user_grpc.pb.go"
 echo "- pkg/proto/user/user.pb.gw.go"
 echo "- docs/user/user.swagger.json"
+echo "- pkg/proto/chat/chat.pb.go"
+echo "- pkg/proto/chat/chat_grpc.pb.go"
+echo "- pkg/proto/chat/chat.pb.gw.go"
+echo "- docs/chat/chat.swagger.json"
+echo "- pkg/proto/admin/admin.pb.go"
+echo "- pkg/proto/admin/admin_grpc.pb.go"
+echo "- pkg/proto/admin/admin.pb.gw.go"
+echo "- docs/admin/admin.swagger.json"
 